@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// docText returns the text of the comment lines after //, separated by \n.
+func docText(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+	lines := make([]string, len(group.List))
+	for i, comment := range group.List {
+		lines[i] = strings.TrimPrefix(comment.Text, "//")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func isQuobyteClient(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "QuobyteClient"
+}
+
+// sentMethod returns the JSON-RPC method name passed to sendRequest in body, either as string
+// literal or as Method* constant.
+func sentMethod(body *ast.BlockStmt, constants map[string]string) string {
+	name := ""
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || selector.Sel.Name != "sendRequest" || len(call.Args) < 2 {
+			return true
+		}
+		switch arg := call.Args[1].(type) {
+		case *ast.BasicLit:
+			name, _ = strconv.Unquote(arg.Value)
+		case *ast.Ident:
+			name = constants[arg.Name]
+		}
+		return false
+	})
+	return name
+}
+
+// extract reads the API description from a types.go file, either copied from a Quobyte build or
+// generated by this command. Domains and versions of methods and validation rules are taken from
+// previous.
+func extract(path string, source []byte, previous *Schema) (*Schema, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	schema := &Schema{}
+	known := map[string]Method{}
+	if previous != nil {
+		schema.Domains = previous.Domains
+		for _, method := range previous.Methods {
+			known[method.Name] = method
+		}
+	}
+
+	// generated files pass Method* constants to sendRequest
+	constants := map[string]string{}
+	if previous != nil {
+		for _, method := range previous.Methods {
+			constants[methodConstant(method)] = method.RPC
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			switch decl.Tok {
+			case token.TYPE:
+				for _, spec := range decl.Specs {
+					typ, err := extractType(fset, spec.(*ast.TypeSpec))
+					if err != nil {
+						return nil, err
+					}
+					if typ == nil {
+						continue
+					}
+					schema.Types = append(schema.Types, *typ)
+				}
+			case token.CONST:
+				if err := extractValues(fset, decl, schema); err != nil {
+					return nil, err
+				}
+			}
+		case *ast.FuncDecl:
+			if !isQuobyteClient(decl) || !strings.HasSuffix(decl.Name.Name, "Context") {
+				continue
+			}
+			method := Method{Name: strings.TrimSuffix(decl.Name.Name, "Context")}
+			method.RPC = sentMethod(decl.Body, constants)
+			method.Domain, method.Since = known[method.Name].Domain, known[method.Name].Since
+			if method.RPC == "" {
+				return nil, fmt.Errorf("%s: %s does not call sendRequest", fset.Position(decl.Pos()), decl.Name.Name)
+			}
+			schema.Methods = append(schema.Methods, method)
+		}
+	}
+	if previous != nil {
+		keepRules(schema, previous)
+	}
+	return schema, nil
+}
+
+// keepRules copies the validation rules of types and fields that still exist from previous.
+func keepRules(schema, previous *Schema) {
+	types := map[string]*Type{}
+	for i := range previous.Types {
+		types[previous.Types[i].Name] = &previous.Types[i]
+	}
+	for i := range schema.Types {
+		typ := &schema.Types[i]
+		old, ok := types[typ.Name]
+		if !ok {
+			continue
+		}
+		typ.OneOf = old.OneOf
+		fields := map[string]Field{}
+		for _, field := range old.Fields {
+			fields[field.Name] = field
+		}
+		for j := range typ.Fields {
+			field := &typ.Fields[j]
+			if old, ok := fields[field.Name]; ok {
+				field.Required, field.Format, field.Min, field.Max = old.Required, old.Format, old.Min, old.Max
+				field.Secret = old.Secret
+			}
+		}
+	}
+}
+
+// extractType returns the enum or message declared by spec, nil for other types.
+func extractType(fset *token.FileSet, spec *ast.TypeSpec) (*Type, error) {
+	name := spec.Name.Name
+	if name == "retryPolicy" {
+		return nil, nil
+	}
+	switch typeExpr := spec.Type.(type) {
+	case *ast.Ident:
+		if typeExpr.Name != "string" {
+			return nil, fmt.Errorf("%s: unsupported type %s", fset.Position(spec.Pos()), name)
+		}
+		return &Type{Name: name, Kind: kindEnum}, nil
+	case *ast.InterfaceType:
+		return nil, nil
+	case *ast.StructType:
+		typ := &Type{Name: name, Kind: kindMessage}
+		for _, field := range typeExpr.Fields.List {
+			if len(field.Names) == 0 {
+				if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "retryPolicy" {
+					typ.Retry = true
+					continue
+				}
+				// generated for requests by this command
+				if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "presence" && typ.Retry {
+					continue
+				}
+				return nil, fmt.Errorf("%s: unsupported embedded field", fset.Position(field.Pos()))
+			}
+			if typ.Retry {
+				return nil, fmt.Errorf("%s: retryPolicy must be the last field", fset.Position(field.Pos()))
+			}
+			var b bytes.Buffer
+			if err := printer.Fprint(&b, fset, field.Type); err != nil {
+				return nil, err
+			}
+			if field.Tag == nil {
+				return nil, fmt.Errorf("%s: field without json tag", fset.Position(field.Pos()))
+			}
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			jsonName, options, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+			if options != "omitempty" || len(field.Names) != 1 {
+				return nil, fmt.Errorf("%s: unsupported field %s", fset.Position(field.Pos()), tag)
+			}
+			typ.Fields = append(typ.Fields, Field{
+				Name: field.Names[0].Name,
+				Type: b.String(),
+				JSON: jsonName,
+				Doc:  docText(field.Doc),
+			})
+		}
+		return typ, nil
+	}
+	return nil, fmt.Errorf("%s: unsupported type %s", fset.Position(spec.Pos()), name)
+}
+
+// extractValues adds the values of an enum const block to the last enum of the schema.
+func extractValues(fset *token.FileSet, decl *ast.GenDecl, schema *Schema) error {
+	if len(schema.Types) == 0 || schema.Types[len(schema.Types)-1].Kind != kindEnum {
+		return fmt.Errorf("%s: constants must follow their enum type", fset.Position(decl.Pos()))
+	}
+	enum := &schema.Types[len(schema.Types)-1]
+	for _, spec := range decl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		typeIdent, ok := valueSpec.Type.(*ast.Ident)
+		if !ok || typeIdent.Name != enum.Name || len(valueSpec.Names) != 1 || len(valueSpec.Values) != 1 {
+			return fmt.Errorf("%s: unsupported constant of %s", fset.Position(valueSpec.Pos()), enum.Name)
+		}
+		literal, ok := valueSpec.Values[0].(*ast.BasicLit)
+		if !ok || literal.Kind != token.STRING {
+			return fmt.Errorf("%s: unsupported constant of %s", fset.Position(valueSpec.Pos()), enum.Name)
+		}
+		jsonValue, err := strconv.Unquote(literal.Value)
+		if err != nil {
+			return err
+		}
+		value := Value{
+			Name: strings.TrimPrefix(valueSpec.Names[0].Name, enum.Name+"_"),
+			Doc:  docText(valueSpec.Doc),
+		}
+		if jsonValue != value.Name {
+			value.Value = jsonValue
+		}
+		enum.Values = append(enum.Values, value)
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const generatedHeader = "// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.\n"
+
+// generate returns the content of all generated files by path relative to the repository root.
+func generate(schema *Schema) (map[string][]byte, error) {
+	if err := check(schema); err != nil {
+		return nil, err
+	}
+	files := map[string]func(*Schema) []byte{
+		"quobyte/types.go":            generateTypes,
+		"quobyte/interfaces.go":       generateInterfaces,
+		"quobyte/methods.go":          generateMethods,
+		"quobyte/secrets.go":          generateSecrets,
+		"quobyte/enums.go":            generateEnums,
+		"quobyte/validate.go":         generateValidation,
+		"quobyte/marshal.go":          generateMarshal,
+		"quobytetest/fake_methods.go": generateFake,
+	}
+	result := map[string][]byte{}
+	for path, generate := range files {
+		source, err := format.Source(generate(schema))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		result[path] = source
+	}
+	return result, nil
+}
+
+var versionPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+){0,2}$`)
+
+// check validates the references in the schema.
+func check(schema *Schema) error {
+	types := map[string]*Type{}
+	for i := range schema.Types {
+		types[schema.Types[i].Name] = &schema.Types[i]
+	}
+	domains := map[string]bool{}
+	for _, domain := range schema.Domains {
+		domains[domain.Name] = true
+	}
+	for _, method := range schema.Methods {
+		if !domains[method.Domain] {
+			return fmt.Errorf("method %s has no domain, assign one in schema/api.json", method.Name)
+		}
+		if method.Since != "" && !versionPattern.MatchString(method.Since) {
+			return fmt.Errorf("method %s: since %q is not a version like 3.4 or 3.4.1", method.Name, method.Since)
+		}
+		for _, name := range []string{method.Request(), method.Response()} {
+			if typ, ok := types[name]; !ok || typ.Kind != kindMessage {
+				return fmt.Errorf("method %s: message %s does not exist", method.Name, name)
+			}
+		}
+	}
+	return checkRules(types)
+}
+
+// comment writes the doc of a declaration as // comment lines.
+func comment(b *bytes.Buffer, doc string) {
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		b.WriteString("\t//" + line + "\n")
+	}
+}
+
+func methodConstant(method Method) string {
+	return "Method" + method.Name
+}
+
+func generateTypes(schema *Schema) []byte {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("// Copyright 2020 Quobyte Inc. See LICENSE for license terms.\n\n")
+	b.WriteString("package quobyte\n\n")
+	b.WriteString("import \"context\"\n\n")
+	b.WriteString("type retryPolicy struct {\n\tRetryPolicy string `json:\"retry,omitempty\"`\n}\n\n")
+	b.WriteString("type QuobyteApi interface {\n")
+	for _, domain := range schema.Domains {
+		b.WriteString("\t" + domain.Name + "\n")
+	}
+	b.WriteString("}\n")
+	requests := schema.requests()
+	for _, typ := range schema.Types {
+		if typ.Kind == kindEnum {
+			fmt.Fprintf(&b, "\ntype %s string\n\nconst (\n", typ.Name)
+			for _, value := range typ.Values {
+				comment(&b, value.Doc)
+				fmt.Fprintf(&b, "\t%s_%s %s = %q\n", typ.Name, value.Name, typ.Name, value.JSONValue())
+			}
+			b.WriteString(")\n")
+			continue
+		}
+		fmt.Fprintf(&b, "\ntype %s struct {\n", typ.Name)
+		for _, field := range typ.Fields {
+			comment(&b, field.Doc)
+			fmt.Fprintf(&b, "\t%s %s `json:\"%s,omitempty\"`\n", field.Name, field.Type, field.JSON)
+		}
+		if typ.Retry {
+			b.WriteString("\tretryPolicy\n")
+		}
+		if typ.Retry && requests[typ.Name] {
+			b.WriteString("\tpresence\n")
+		}
+		b.WriteString("}\n")
+	}
+	for _, method := range schema.Methods {
+		fmt.Fprintf(&b, `
+func (client *QuobyteClient) %[1]s(request *%[3]s) (result *%[4]s, err error) {
+	return client.%[1]sContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) %[1]sContext(ctx context.Context, request *%[3]s) (result *%[4]s, err error) {
+	var response %[4]s
+	if err = client.sendRequest(ctx, %[2]s, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+`, method.Name, methodConstant(method), method.Request(), method.Response())
+	}
+	return b.Bytes()
+}
+
+func generateInterfaces(schema *Schema) []byte {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "\n")
+	b.WriteString("package quobyte\n\n")
+	b.WriteString("import \"context\"\n")
+	for _, domain := range schema.Domains {
+		fmt.Fprintf(&b, "\n// %s %s\n", domain.Name, domain.Doc)
+		fmt.Fprintf(&b, "type %s interface {\n", domain.Name)
+		for _, method := range domainMethods(schema, domain.Name) {
+			fmt.Fprintf(&b, "\t%s(request *%s) (result *%s, err error)\n",
+				method.Name, method.Request(), method.Response())
+			fmt.Fprintf(&b, "\t%sContext(ctx context.Context, request *%s) (result *%s, err error)\n",
+				method.Name, method.Request(), method.Response())
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+// domainMethods returns the methods of the domain sorted by name.
+func domainMethods(schema *Schema, domain string) []Method {
+	var methods []Method
+	for _, method := range schema.Methods {
+		if method.Domain == domain {
+			methods = append(methods, method)
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+	return methods
+}
+
+func generateMethods(schema *Schema) []byte {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "\n")
+	b.WriteString("package quobyte\n\n")
+	b.WriteString("// JSON-RPC method names of the Quobyte API.\n")
+	b.WriteString("const (\n")
+	for _, method := range schema.Methods {
+		fmt.Fprintf(&b, "\t%s = %q\n", methodConstant(method), method.RPC)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString("// minimumVersions are the first Quobyte releases that have the methods, as far as they are known.\n")
+	b.WriteString("var minimumVersions = map[string]string{\n")
+	for _, method := range schema.Methods {
+		if method.Since != "" {
+			fmt.Fprintf(&b, "\t%s: %q,\n", methodConstant(method), method.Since)
+		}
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+// secretWords are the words of a field name that mark its value as secret.
+var secretWords = [][]string{
+	{"Password"},
+	{"Secret"},
+	{"Salt"},
+	{"Token"},
+	{"Private", "Key"},
+	{"Encrypted", "Key"},
+}
+
+// describingWords are last words of field names that describe a secret rather than contain it,
+// e.g. AccessKeySecretAttribute or PasswordHashMethod.
+var describingWords = map[string]bool{
+	"Attribute":  true,
+	"Endpoint":   true,
+	"Iterations": true,
+	"Method":     true,
+}
+
+var wordPattern = regexp.MustCompile(`[A-Z][a-z0-9]*|[a-z0-9]+`)
+
+func isSecret(fieldName string) bool {
+	words := wordPattern.FindAllString(fieldName, -1)
+	if len(words) == 0 || describingWords[words[len(words)-1]] {
+		return false
+	}
+	for _, secret := range secretWords {
+		for i := 0; i+len(secret) <= len(words); i++ {
+			if reflect.DeepEqual(words[i:i+len(secret)], secret) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// generateSecrets lists the JSON names of text fields that carry passwords, secrets or key
+// material, they are redacted in logs and cassettes.
+func generateSecrets(schema *Schema) []byte {
+	names := map[string]bool{}
+	for _, typ := range schema.Types {
+		for _, field := range typ.Fields {
+			if (field.Type == "string" || field.Type == "[]string") && (field.Secret || isSecret(field.Name)) {
+				names[field.JSON] = true
+			}
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "\n")
+	b.WriteString("package quobyte\n\n")
+	b.WriteString("// secretFields are the JSON names of fields that carry passwords, secrets or key material.\n")
+	b.WriteString("var secretFields = map[string]bool{\n")
+	for _, name := range sorted {
+		b.WriteString("\t" + strconv.Quote(name) + ": true,\n")
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+func generateFake(schema *Schema) []byte {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "\n")
+	b.WriteString("package quobytetest\n\n")
+	b.WriteString("import (\n\t\"context\"\n\n\tquobyte \"github.com/quobyte/api/quobyte\"\n)\n")
+	for _, method := range schema.Methods {
+		fmt.Fprintf(&b, `
+func (fake *Fake) %[1]s(request *quobyte.%[3]s) (result *quobyte.%[4]s, err error) {
+	return fake.%[1]sContext(context.Background(), request)
+}
+
+func (fake *Fake) %[1]sContext(ctx context.Context, request *quobyte.%[3]s) (result *quobyte.%[4]s, err error) {
+	var response quobyte.%[4]s
+	if err = fake.call(ctx, quobyte.%[2]s, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+`, method.Name, methodConstant(method), method.Request(), method.Response())
+	}
+	return b.Bytes()
+}
+
+// generateMarshal adds MarshalJSON to requests, which sends the fields marked with SetExplicit.
+func generateMarshal(schema *Schema) []byte {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "\n")
+	b.WriteString("package quobyte\n")
+	requests := schema.requests()
+	for _, typ := range schema.Types {
+		if !typ.Retry || !requests[typ.Name] {
+			continue
+		}
+		fmt.Fprintf(&b, `
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *%[1]s) MarshalJSON() ([]byte, error) {
+	type plain %[1]s
+	return request.presence.marshal((*plain)(request))
+}
+`, typ.Name)
+	}
+	return b.Bytes()
+}
+
+// generateEnums adds Values, IsValid and a Parse function to every enum.
+func generateEnums(schema *Schema) []byte {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "\n")
+	b.WriteString("package quobyte\n")
+	for _, typ := range schema.Types {
+		if typ.Kind != kindEnum {
+			continue
+		}
+		fmt.Fprintf(&b, "\n// Values returns all values of %s.\n", typ.Name)
+		fmt.Fprintf(&b, "func (%s) Values() []%s {\n\treturn []%s{\n", typ.Name, typ.Name, typ.Name)
+		for _, value := range typ.Values {
+			fmt.Fprintf(&b, "\t\t%s_%s,\n", typ.Name, value.Name)
+		}
+		b.WriteString("\t}\n}\n")
+
+		fmt.Fprintf(&b, "\n// IsValid returns true if value is a value of %s.\n", typ.Name)
+		fmt.Fprintf(&b, "func (value %s) IsValid() bool {\n\tswitch value {\n\tcase ", typ.Name)
+		for i, value := range typ.Values {
+			if i > 0 {
+				b.WriteString(",\n\t\t")
+			}
+			fmt.Fprintf(&b, "%s_%s", typ.Name, value.Name)
+		}
+		b.WriteString(":\n\t\treturn true\n\t}\n\treturn false\n}\n")
+
+		fmt.Fprintf(&b, "\n// Parse%[1]s returns the %[1]s with the value, an error matching ErrUnknownEnumValue\n", typ.Name)
+		b.WriteString("// if the value is unknown.\n")
+		fmt.Fprintf(&b, "func Parse%[1]s(value string) (%[1]s, error) {\n", typ.Name)
+		fmt.Fprintf(&b, "\tif parsed := %s(value); parsed.IsValid() {\n\t\treturn parsed, nil\n\t}\n", typ.Name)
+		fmt.Fprintf(&b, "\treturn \"\", &EnumValueError{Type: %q, Value: value}\n}\n", typ.Name)
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,62 @@
+// quobyte-gen generates the API types, interfaces, method name constants, enum helpers, request
+// validation and the methods of quobytetest.Fake from the API description in schema/api.json.
+//
+// Usage:
+//
+//	go run ./cmd/quobyte-gen [-root dir]
+//	go run ./cmd/quobyte-gen -extract types.go [-root dir]
+//
+// With -extract, schema/api.json is updated from a types.go of a Quobyte build first. Domains of
+// existing methods and validation rules are kept, new methods must be assigned to a domain before
+// generating.
+package main
+
+import (
+	"errors"
+	"flag"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const schemaPath = "schema/api.json"
+
+func main() {
+	root := flag.String("root", ".", "root directory of the repository")
+	extractPath := flag.String("extract", "", "update the schema from this types.go before generating")
+	flag.Parse()
+
+	schema, err := loadSchema(filepath.Join(*root, schemaPath))
+	if err != nil && (*extractPath == "" || !errors.Is(err, fs.ErrNotExist)) {
+		log.Fatal(err)
+	}
+	if *extractPath != "" {
+		source, err := os.ReadFile(*extractPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if schema, err = extract(*extractPath, source, schema); err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(*root, schemaPath), schema.encode(), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	files, err := generate(schema)
+	if err != nil {
+		log.Fatal(err)
+	}
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if err := os.WriteFile(filepath.Join(*root, path), files[path], 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const root = "../.."
+
+// firstDifference returns the first line that differs between got and want.
+func firstDifference(got, want []byte) string {
+	gotLines := strings.Split(string(got), "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := 0; i < len(gotLines) && i < len(wantLines); i++ {
+		if gotLines[i] != wantLines[i] {
+			return fmt.Sprintf("line %d: got %q, want %q", i+1, gotLines[i], wantLines[i])
+		}
+	}
+	return "files differ in length"
+}
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	schema, err := loadSchema(filepath.Join(root, schemaPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for path, generated := range files {
+		checkedIn, err := os.ReadFile(filepath.Join(root, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(generated, checkedIn) {
+			t.Errorf("%s is not up to date, run go generate ./...: %s", path, firstDifference(generated, checkedIn))
+		}
+	}
+}
+
+func TestDomainsHaveMocks(t *testing.T) {
+	schema, err := loadSchema(filepath.Join(root, schemaPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := os.ReadFile(filepath.Join(root, "quobyte", "quobyte.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, domain := range schema.Domains {
+		directive := "//go:generate mockgen -package=mocks -destination ../mocks/mock_" +
+			strings.ToLower(strings.TrimSuffix(domain.Name, "API")) + "_api.go github.com/quobyte/api/quobyte " + domain.Name + "\n"
+		if !strings.Contains(string(source), directive) {
+			t.Errorf("quobyte/quobyte.go has no mockgen directive for %s: %s", domain.Name, directive)
+		}
+	}
+}
+
+func TestExtractReproducesSchema(t *testing.T) {
+	schema, err := loadSchema(filepath.Join(root, schemaPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := os.ReadFile(filepath.Join(root, "quobyte", "types.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	extracted, err := extract("types.go", source, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := extracted.encode(), schema.encode(); !bytes.Equal(got, want) {
+		t.Errorf("Schema extracted from types.go differs: %s", firstDifference(got, want))
+	}
+	if data, err := os.ReadFile(filepath.Join(root, schemaPath)); err != nil || !bytes.Equal(data, schema.encode()) {
+		t.Errorf("%s is not formatted by quobyte-gen: %v", schemaPath, err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	schema := &Schema{
+		Domains: []Domain{{Name: "VolumeAPI"}},
+		Types:   []Type{{Name: "CreateVolumeRequest", Kind: kindMessage}},
+		Methods: []Method{{Name: "CreateVolume", RPC: "createVolume", Domain: "VolumeAPI"}},
+	}
+	if err := check(schema); err == nil || !strings.Contains(err.Error(), "CreateVolumeResponse") {
+		t.Errorf("Expected error for missing response, got %v", err)
+	}
+	schema.Types = append(schema.Types, Type{Name: "CreateVolumeResponse", Kind: kindMessage})
+	schema.Methods[0].Domain = ""
+	if err := check(schema); err == nil || !strings.Contains(err.Error(), "no domain") {
+		t.Errorf("Expected error for missing domain, got %v", err)
+	}
+	schema.Methods[0].Domain = "VolumeAPI"
+	schema.Types[0].Fields = []Field{{Name: "Name", Type: "string", JSON: "name", Format: "email"}}
+	if err := check(schema); err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected error for unsupported format, got %v", err)
+	}
+	schema.Types[0].Fields[0].Format = formatUUID
+	schema.Types[0].OneOf = [][]string{{"Name", "TenantId"}}
+	if err := check(schema); err == nil || !strings.Contains(err.Error(), "TenantId") {
+		t.Errorf("Expected error for unknown one_of field, got %v", err)
+	}
+}
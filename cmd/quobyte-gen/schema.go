@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Schema is the machine-readable description of the Quobyte API. Types and methods are kept in
+// the order of the generated code.
+type Schema struct {
+	// Domains group the methods into narrow interfaces embedded by QuobyteApi.
+	Domains []Domain `json:"domains"`
+	// Types are the enums and messages used by the methods.
+	Types   []Type   `json:"types"`
+	Methods []Method `json:"methods"`
+}
+
+// Domain is an interface with the methods of one area of the API.
+type Domain struct {
+	Name string `json:"name"`
+	Doc  string `json:"doc"`
+}
+
+const (
+	kindEnum    = "enum"
+	kindMessage = "message"
+)
+
+// Type is a string enum or a message.
+type Type struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// Retry is set for requests that carry the retry policy.
+	Retry bool `json:"retry,omitempty"`
+	// OneOf are groups of fields of which exactly one must be set in requests.
+	OneOf  [][]string `json:"one_of,omitempty"`
+	Values []Value    `json:"values,omitempty"`
+	Fields []Field    `json:"fields,omitempty"`
+}
+
+// Value is a value of an enum. The constant is named <enum>_<name>.
+type Value struct {
+	Name string `json:"name"`
+	// Value is the JSON value if it differs from Name.
+	Value string `json:"value,omitempty"`
+	// Doc is the comment of the value, lines are separated by \n and keep the text after //.
+	Doc string `json:"doc,omitempty"`
+}
+
+// JSONValue returns the value used on the wire.
+func (value Value) JSONValue() string {
+	if value.Value != "" {
+		return value.Value
+	}
+	return value.Name
+}
+
+// Field is a field of a message.
+type Field struct {
+	Name string `json:"name"`
+	// Type is the Go type of the field, e.g. "[]*Volume".
+	Type string `json:"type"`
+	JSON string `json:"json"`
+	Doc  string `json:"doc,omitempty"`
+	// Required, Format, Min and Max are checked by the Validate methods of requests. They are
+	// maintained in the schema, the types.go of Quobyte does not carry them.
+	Required bool `json:"required,omitempty"`
+	// Format "uuid" requires values that match quobyte.UUIDValidator.
+	Format string `json:"format,omitempty"`
+	// Min and Max limit numbers that are set and all elements of number lists.
+	Min *int64 `json:"min,omitempty"`
+	Max *int64 `json:"max,omitempty"`
+	// Secret marks fields with key material that the name does not tell, e.g. license keys. They
+	// are redacted like the fields found by isSecret.
+	Secret bool `json:"secret,omitempty"`
+}
+
+const formatUUID = "uuid"
+
+// Method is a JSON-RPC method with <name>Request params and a <name>Response result.
+type Method struct {
+	Name   string `json:"name"`
+	RPC    string `json:"rpc"`
+	Domain string `json:"domain"`
+	// Since is the first Quobyte release that has the method, empty if unknown.
+	Since string `json:"since,omitempty"`
+}
+
+// Request returns the name of the params type.
+func (method Method) Request() string {
+	return method.Name + "Request"
+}
+
+// Response returns the name of the result type.
+func (method Method) Response() string {
+	return method.Name + "Response"
+}
+
+// requests returns the names of the params types of the methods.
+func (schema *Schema) requests() map[string]bool {
+	requests := map[string]bool{}
+	for _, method := range schema.Methods {
+		requests[method.Request()] = true
+	}
+	return requests
+}
+
+func loadSchema(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var schema Schema
+	if err := decoder.Decode(&schema); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &schema, nil
+}
+
+func compactJSON(v interface{}) []byte {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		panic(err)
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n"))
+}
+
+// writeList writes the items with one item per line.
+func writeList[T any](b *bytes.Buffer, indent string, items []T) {
+	b.WriteString("[")
+	for i, item := range items {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n" + indent + "  ")
+		b.Write(compactJSON(item))
+	}
+	if len(items) > 0 {
+		b.WriteString("\n" + indent)
+	}
+	b.WriteString("]")
+}
+
+// encode formats the schema with one value, field or method per line, which keeps diffs of API
+// updates readable.
+func (schema *Schema) encode() []byte {
+	var b bytes.Buffer
+	b.WriteString("{\n  \"domains\": ")
+	writeList(&b, "  ", schema.Domains)
+	b.WriteString(",\n  \"types\": [")
+	for i, typ := range schema.Types {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		header := compactJSON(Type{Name: typ.Name, Kind: typ.Kind, Retry: typ.Retry, OneOf: typ.OneOf})
+		b.WriteString("\n    ")
+		b.Write(header[:len(header)-1])
+		if typ.Kind == kindEnum {
+			b.WriteString(",\"values\":")
+			writeList(&b, "    ", typ.Values)
+		} else if len(typ.Fields) > 0 {
+			b.WriteString(",\"fields\":")
+			writeList(&b, "    ", typ.Fields)
+		}
+		b.WriteString("}")
+	}
+	b.WriteString("\n  ],\n  \"methods\": ")
+	writeList(&b, "  ", schema.Methods)
+	b.WriteString("\n}\n")
+	return b.Bytes()
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// fieldKind describes the Go type of a field: the element type without list and pointer, and
+// whether it is a list or pointer.
+type fieldKind struct {
+	elem    string
+	list    bool
+	pointer bool
+}
+
+func kindOf(goType string) fieldKind {
+	var kind fieldKind
+	if strings.HasPrefix(goType, "[]") {
+		kind.list = true
+		goType = goType[2:]
+	}
+	if strings.HasPrefix(goType, "*") {
+		kind.pointer = true
+		goType = goType[1:]
+	}
+	kind.elem = goType
+	return kind
+}
+
+var integerTypes = map[string]bool{"int32": true, "int64": true, "uint32": true, "uint64": true}
+
+// checkRules validates the validation rules of the schema.
+func checkRules(types map[string]*Type) error {
+	for _, typ := range types {
+		fields := map[string]Field{}
+		for _, field := range typ.Fields {
+			fields[field.Name] = field
+			kind := kindOf(field.Type)
+			if field.Required && field.Type == "bool" {
+				return fmt.Errorf("%s.%s: bool fields cannot be required", typ.Name, field.Name)
+			}
+			if field.Format != "" && (field.Format != formatUUID || kind.elem != "string" || kind.pointer) {
+				return fmt.Errorf("%s.%s: unsupported format %q", typ.Name, field.Name, field.Format)
+			}
+			if (field.Min != nil || field.Max != nil) && (!integerTypes[kind.elem] || kind.pointer) {
+				return fmt.Errorf("%s.%s: min and max require integers", typ.Name, field.Name)
+			}
+		}
+		for _, group := range typ.OneOf {
+			if len(group) < 2 {
+				return fmt.Errorf("%s: one_of needs at least two fields", typ.Name)
+			}
+			for _, name := range group {
+				if field, ok := fields[name]; !ok || field.Type == "bool" {
+					return fmt.Errorf("%s: one_of field %s does not exist or is a bool", typ.Name, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validation generates the validate methods of messages.
+type validation struct {
+	types map[string]*Type
+	// needed are the messages that have rules, directly or in nested messages. Enum values are not
+	// checked, a newer API service may accept values that are unknown to types.go.
+	needed map[string]bool
+}
+
+func newValidation(schema *Schema) *validation {
+	gen := &validation{types: map[string]*Type{}, needed: map[string]bool{}}
+	for i := range schema.Types {
+		gen.types[schema.Types[i].Name] = &schema.Types[i]
+	}
+	// messages can be recursive, so propagate until nothing changes
+	for changed := true; changed; {
+		changed = false
+		for _, typ := range schema.Types {
+			if typ.Kind != kindMessage || gen.needed[typ.Name] {
+				continue
+			}
+			needed := len(typ.OneOf) > 0
+			for _, field := range typ.Fields {
+				elem := kindOf(field.Type).elem
+				if field.Required || field.Format != "" || field.Min != nil || field.Max != nil ||
+					gen.needed[elem] {
+					needed = true
+				}
+			}
+			if needed {
+				gen.needed[typ.Name] = true
+				changed = true
+			}
+		}
+	}
+	return gen
+}
+
+func (gen *validation) isKind(name, kind string) bool {
+	typ, ok := gen.types[name]
+	return ok && typ.Kind == kind
+}
+
+// isSet returns the expression that tests if the field with value is set.
+func (gen *validation) isSet(goType, value string) string {
+	kind := kindOf(goType)
+	switch {
+	case kind.list:
+		return "len(" + value + ") > 0"
+	case kind.pointer:
+		return value + " != nil"
+	case kind.elem == "string" || gen.isKind(kind.elem, kindEnum):
+		return value + ` != ""`
+	case integerTypes[kind.elem] || strings.HasPrefix(kind.elem, "float"):
+		return value + " != 0"
+	}
+	return "!isZero(" + value + ")"
+}
+
+// forElements writes a loop over the elements of a list field that calls check with the element
+// and its path, or calls check with the field itself.
+func forElements(b *bytes.Buffer, kind fieldKind, value, path string, check func(elem, path string)) {
+	if !kind.list {
+		check(value, path)
+		return
+	}
+	fmt.Fprintf(b, "\tfor i, elem := range %s {\n", value)
+	check("elem", "element("+path+", i)")
+	b.WriteString("\t}\n")
+}
+
+// messagePath returns the path prefix for the fields of the message at path.
+func messagePath(path string) string {
+	if strings.HasSuffix(path, `"`) {
+		return path[:len(path)-1] + `."`
+	}
+	return path + `+"."`
+}
+
+func (gen *validation) writeField(b *bytes.Buffer, field Field) {
+	kind := kindOf(field.Type)
+	value := "message." + field.Name
+	path := `path+"` + field.JSON + `"`
+	if field.Required {
+		fmt.Fprintf(b, "\tv.required(%s, %s)\n", path, gen.isSet(field.Type, value))
+	}
+	if field.Format == formatUUID {
+		forElements(b, kind, value, path, func(elem, path string) {
+			fmt.Fprintf(b, "\tv.uuid(%s, %s)\n", path, elem)
+		})
+	}
+	if field.Min != nil || field.Max != nil {
+		forElements(b, kind, value, path, func(elem, path string) {
+			if !kind.list {
+				// numbers that are not set are omitted
+				fmt.Fprintf(b, "\tif %s != 0 {\n", elem)
+			}
+			if field.Min != nil {
+				fmt.Fprintf(b, "\tv.atLeast(%s, int64(%s), %d)\n", path, elem, *field.Min)
+			}
+			if field.Max != nil {
+				fmt.Fprintf(b, "\tv.atMost(%s, int64(%s), %d)\n", path, elem, *field.Max)
+			}
+			if !kind.list {
+				b.WriteString("\t}\n")
+			}
+		})
+	}
+	if gen.needed[kind.elem] {
+		forElements(b, kind, value, path, func(elem, path string) {
+			if kind.pointer {
+				fmt.Fprintf(b, "\tif %s != nil {\n", elem)
+			}
+			fmt.Fprintf(b, "\t%s.validate(v, %s)\n", elem, messagePath(path))
+			if kind.pointer {
+				b.WriteString("\t}\n")
+			}
+		})
+	}
+}
+
+func (gen *validation) writeMessage(b *bytes.Buffer, typ *Type) {
+	fmt.Fprintf(b, "\nfunc (message *%s) validate(v *validator, path string) {\n", typ.Name)
+	for _, field := range typ.Fields {
+		gen.writeField(b, field)
+	}
+	fields := map[string]Field{}
+	for _, field := range typ.Fields {
+		fields[field.Name] = field
+	}
+	for _, group := range typ.OneOf {
+		var names, set []string
+		for _, name := range group {
+			names = append(names, fmt.Sprintf("%q", fields[name].JSON))
+			set = append(set, gen.isSet(fields[name].Type, "message."+name))
+		}
+		fmt.Fprintf(b, "\tv.oneOf(path, []string{%s}, %s)\n", strings.Join(names, ", "), strings.Join(set, ", "))
+	}
+	b.WriteString("}\n")
+}
+
+// generateValidation adds Validate to all requests. Requests and the messages in them check
+// their fields with validate.
+func generateValidation(schema *Schema) []byte {
+	gen := newValidation(schema)
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "\n")
+	b.WriteString("package quobyte\n")
+	for _, method := range schema.Methods {
+		b.WriteString("\n// Validate checks the request against the rules of the API schema. The error lists all\n")
+		b.WriteString("// violations as *ValidationError and matches ErrInvalidParams.\n")
+		fmt.Fprintf(&b, "func (request *%s) Validate() error {\n", method.Request())
+		if !gen.needed[method.Request()] {
+			b.WriteString("\treturn nil\n}\n")
+			continue
+		}
+		b.WriteString("\tvar v validator\n\trequest.validate(&v, \"\")\n\treturn v.err()\n}\n")
+	}
+	reachable := gen.reachable(schema.Methods)
+	for i := range schema.Types {
+		typ := &schema.Types[i]
+		if reachable[typ.Name] && gen.needed[typ.Name] {
+			gen.writeMessage(&b, typ)
+		}
+	}
+	return b.Bytes()
+}
+
+// reachable returns the messages that are part of requests.
+func (gen *validation) reachable(methods []Method) map[string]bool {
+	reachable := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		if reachable[name] || !gen.isKind(name, kindMessage) {
+			return
+		}
+		reachable[name] = true
+		for _, field := range gen.types[name].Fields {
+			visit(kindOf(field.Type).elem)
+		}
+	}
+	for _, method := range methods {
+		visit(method.Request())
+	}
+	return reachable
+}
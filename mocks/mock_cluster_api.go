@@ -0,0 +1,401 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: ClusterAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_cluster_api.go github.com/quobyte/api/quobyte ClusterAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClusterAPI is a mock of ClusterAPI interface.
+type MockClusterAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterAPIMockRecorder
+}
+
+// MockClusterAPIMockRecorder is the mock recorder for MockClusterAPI.
+type MockClusterAPIMockRecorder struct {
+	mock *MockClusterAPI
+}
+
+// NewMockClusterAPI creates a new mock instance.
+func NewMockClusterAPI(ctrl *gomock.Controller) *MockClusterAPI {
+	mock := &MockClusterAPI{ctrl: ctrl}
+	mock.recorder = &MockClusterAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterAPI) EXPECT() *MockClusterAPIMockRecorder {
+	return m.recorder
+}
+
+// AddRegistryReplica mocks base method.
+func (m *MockClusterAPI) AddRegistryReplica(arg0 *quobyte.AddRegistryReplicaRequest) (*quobyte.AddRegistryReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRegistryReplica", arg0)
+	ret0, _ := ret[0].(*quobyte.AddRegistryReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRegistryReplica indicates an expected call of AddRegistryReplica.
+func (mr *MockClusterAPIMockRecorder) AddRegistryReplica(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegistryReplica", reflect.TypeOf((*MockClusterAPI)(nil).AddRegistryReplica), arg0)
+}
+
+// AddRegistryReplicaContext mocks base method.
+func (m *MockClusterAPI) AddRegistryReplicaContext(arg0 context.Context, arg1 *quobyte.AddRegistryReplicaRequest) (*quobyte.AddRegistryReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRegistryReplicaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddRegistryReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRegistryReplicaContext indicates an expected call of AddRegistryReplicaContext.
+func (mr *MockClusterAPIMockRecorder) AddRegistryReplicaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegistryReplicaContext", reflect.TypeOf((*MockClusterAPI)(nil).AddRegistryReplicaContext), arg0, arg1)
+}
+
+// CancelNetworkTest mocks base method.
+func (m *MockClusterAPI) CancelNetworkTest(arg0 *quobyte.CancelNetworkTestRequest) (*quobyte.CancelNetworkTestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNetworkTest", arg0)
+	ret0, _ := ret[0].(*quobyte.CancelNetworkTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelNetworkTest indicates an expected call of CancelNetworkTest.
+func (mr *MockClusterAPIMockRecorder) CancelNetworkTest(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNetworkTest", reflect.TypeOf((*MockClusterAPI)(nil).CancelNetworkTest), arg0)
+}
+
+// CancelNetworkTestContext mocks base method.
+func (m *MockClusterAPI) CancelNetworkTestContext(arg0 context.Context, arg1 *quobyte.CancelNetworkTestRequest) (*quobyte.CancelNetworkTestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNetworkTestContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelNetworkTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelNetworkTestContext indicates an expected call of CancelNetworkTestContext.
+func (mr *MockClusterAPIMockRecorder) CancelNetworkTestContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNetworkTestContext", reflect.TypeOf((*MockClusterAPI)(nil).CancelNetworkTestContext), arg0, arg1)
+}
+
+// DeregisterService mocks base method.
+func (m *MockClusterAPI) DeregisterService(arg0 *quobyte.DeregisterServiceRequest) (*quobyte.DeregisterServiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterService", arg0)
+	ret0, _ := ret[0].(*quobyte.DeregisterServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterService indicates an expected call of DeregisterService.
+func (mr *MockClusterAPIMockRecorder) DeregisterService(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterService", reflect.TypeOf((*MockClusterAPI)(nil).DeregisterService), arg0)
+}
+
+// DeregisterServiceContext mocks base method.
+func (m *MockClusterAPI) DeregisterServiceContext(arg0 context.Context, arg1 *quobyte.DeregisterServiceRequest) (*quobyte.DeregisterServiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterServiceContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeregisterServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterServiceContext indicates an expected call of DeregisterServiceContext.
+func (mr *MockClusterAPIMockRecorder) DeregisterServiceContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterServiceContext", reflect.TypeOf((*MockClusterAPI)(nil).DeregisterServiceContext), arg0, arg1)
+}
+
+// GetClientList mocks base method.
+func (m *MockClusterAPI) GetClientList(arg0 *quobyte.GetClientListRequest) (*quobyte.GetClientListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientList", arg0)
+	ret0, _ := ret[0].(*quobyte.GetClientListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientList indicates an expected call of GetClientList.
+func (mr *MockClusterAPIMockRecorder) GetClientList(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientList", reflect.TypeOf((*MockClusterAPI)(nil).GetClientList), arg0)
+}
+
+// GetClientListContext mocks base method.
+func (m *MockClusterAPI) GetClientListContext(arg0 context.Context, arg1 *quobyte.GetClientListRequest) (*quobyte.GetClientListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetClientListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientListContext indicates an expected call of GetClientListContext.
+func (mr *MockClusterAPIMockRecorder) GetClientListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientListContext", reflect.TypeOf((*MockClusterAPI)(nil).GetClientListContext), arg0, arg1)
+}
+
+// GetHealthManagerStatus mocks base method.
+func (m *MockClusterAPI) GetHealthManagerStatus(arg0 *quobyte.GetHealthManagerStatusRequest) (*quobyte.GetHealthManagerStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthManagerStatus", arg0)
+	ret0, _ := ret[0].(*quobyte.GetHealthManagerStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthManagerStatus indicates an expected call of GetHealthManagerStatus.
+func (mr *MockClusterAPIMockRecorder) GetHealthManagerStatus(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthManagerStatus", reflect.TypeOf((*MockClusterAPI)(nil).GetHealthManagerStatus), arg0)
+}
+
+// GetHealthManagerStatusContext mocks base method.
+func (m *MockClusterAPI) GetHealthManagerStatusContext(arg0 context.Context, arg1 *quobyte.GetHealthManagerStatusRequest) (*quobyte.GetHealthManagerStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthManagerStatusContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetHealthManagerStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthManagerStatusContext indicates an expected call of GetHealthManagerStatusContext.
+func (mr *MockClusterAPIMockRecorder) GetHealthManagerStatusContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthManagerStatusContext", reflect.TypeOf((*MockClusterAPI)(nil).GetHealthManagerStatusContext), arg0, arg1)
+}
+
+// GetInformation mocks base method.
+func (m *MockClusterAPI) GetInformation(arg0 *quobyte.GetInformationRequest) (*quobyte.GetInformationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInformation", arg0)
+	ret0, _ := ret[0].(*quobyte.GetInformationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInformation indicates an expected call of GetInformation.
+func (mr *MockClusterAPIMockRecorder) GetInformation(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInformation", reflect.TypeOf((*MockClusterAPI)(nil).GetInformation), arg0)
+}
+
+// GetInformationContext mocks base method.
+func (m *MockClusterAPI) GetInformationContext(arg0 context.Context, arg1 *quobyte.GetInformationRequest) (*quobyte.GetInformationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInformationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetInformationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInformationContext indicates an expected call of GetInformationContext.
+func (mr *MockClusterAPIMockRecorder) GetInformationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInformationContext", reflect.TypeOf((*MockClusterAPI)(nil).GetInformationContext), arg0, arg1)
+}
+
+// GetNetworkTestResult mocks base method.
+func (m *MockClusterAPI) GetNetworkTestResult(arg0 *quobyte.GetNetworkTestResultRequest) (*quobyte.GetNetworkTestResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkTestResult", arg0)
+	ret0, _ := ret[0].(*quobyte.GetNetworkTestResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkTestResult indicates an expected call of GetNetworkTestResult.
+func (mr *MockClusterAPIMockRecorder) GetNetworkTestResult(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkTestResult", reflect.TypeOf((*MockClusterAPI)(nil).GetNetworkTestResult), arg0)
+}
+
+// GetNetworkTestResultContext mocks base method.
+func (m *MockClusterAPI) GetNetworkTestResultContext(arg0 context.Context, arg1 *quobyte.GetNetworkTestResultRequest) (*quobyte.GetNetworkTestResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkTestResultContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetNetworkTestResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkTestResultContext indicates an expected call of GetNetworkTestResultContext.
+func (mr *MockClusterAPIMockRecorder) GetNetworkTestResultContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkTestResultContext", reflect.TypeOf((*MockClusterAPI)(nil).GetNetworkTestResultContext), arg0, arg1)
+}
+
+// GetServices mocks base method.
+func (m *MockClusterAPI) GetServices(arg0 *quobyte.GetServicesRequest) (*quobyte.GetServicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServices", arg0)
+	ret0, _ := ret[0].(*quobyte.GetServicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServices indicates an expected call of GetServices.
+func (mr *MockClusterAPIMockRecorder) GetServices(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServices", reflect.TypeOf((*MockClusterAPI)(nil).GetServices), arg0)
+}
+
+// GetServicesContext mocks base method.
+func (m *MockClusterAPI) GetServicesContext(arg0 context.Context, arg1 *quobyte.GetServicesRequest) (*quobyte.GetServicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServicesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetServicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServicesContext indicates an expected call of GetServicesContext.
+func (mr *MockClusterAPIMockRecorder) GetServicesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServicesContext", reflect.TypeOf((*MockClusterAPI)(nil).GetServicesContext), arg0, arg1)
+}
+
+// GetSystemStatistics mocks base method.
+func (m *MockClusterAPI) GetSystemStatistics(arg0 *quobyte.GetSystemStatisticsRequest) (*quobyte.GetSystemStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSystemStatistics", arg0)
+	ret0, _ := ret[0].(*quobyte.GetSystemStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemStatistics indicates an expected call of GetSystemStatistics.
+func (mr *MockClusterAPIMockRecorder) GetSystemStatistics(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemStatistics", reflect.TypeOf((*MockClusterAPI)(nil).GetSystemStatistics), arg0)
+}
+
+// GetSystemStatisticsContext mocks base method.
+func (m *MockClusterAPI) GetSystemStatisticsContext(arg0 context.Context, arg1 *quobyte.GetSystemStatisticsRequest) (*quobyte.GetSystemStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSystemStatisticsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetSystemStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemStatisticsContext indicates an expected call of GetSystemStatisticsContext.
+func (mr *MockClusterAPIMockRecorder) GetSystemStatisticsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemStatisticsContext", reflect.TypeOf((*MockClusterAPI)(nil).GetSystemStatisticsContext), arg0, arg1)
+}
+
+// ListRegistryReplicas mocks base method.
+func (m *MockClusterAPI) ListRegistryReplicas(arg0 *quobyte.ListRegistryReplicasRequest) (*quobyte.ListRegistryReplicasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistryReplicas", arg0)
+	ret0, _ := ret[0].(*quobyte.ListRegistryReplicasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistryReplicas indicates an expected call of ListRegistryReplicas.
+func (mr *MockClusterAPIMockRecorder) ListRegistryReplicas(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistryReplicas", reflect.TypeOf((*MockClusterAPI)(nil).ListRegistryReplicas), arg0)
+}
+
+// ListRegistryReplicasContext mocks base method.
+func (m *MockClusterAPI) ListRegistryReplicasContext(arg0 context.Context, arg1 *quobyte.ListRegistryReplicasRequest) (*quobyte.ListRegistryReplicasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistryReplicasContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListRegistryReplicasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistryReplicasContext indicates an expected call of ListRegistryReplicasContext.
+func (mr *MockClusterAPIMockRecorder) ListRegistryReplicasContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistryReplicasContext", reflect.TypeOf((*MockClusterAPI)(nil).ListRegistryReplicasContext), arg0, arg1)
+}
+
+// RemoveRegistryReplica mocks base method.
+func (m *MockClusterAPI) RemoveRegistryReplica(arg0 *quobyte.RemoveRegistryReplicaRequest) (*quobyte.RemoveRegistryReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRegistryReplica", arg0)
+	ret0, _ := ret[0].(*quobyte.RemoveRegistryReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRegistryReplica indicates an expected call of RemoveRegistryReplica.
+func (mr *MockClusterAPIMockRecorder) RemoveRegistryReplica(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegistryReplica", reflect.TypeOf((*MockClusterAPI)(nil).RemoveRegistryReplica), arg0)
+}
+
+// RemoveRegistryReplicaContext mocks base method.
+func (m *MockClusterAPI) RemoveRegistryReplicaContext(arg0 context.Context, arg1 *quobyte.RemoveRegistryReplicaRequest) (*quobyte.RemoveRegistryReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRegistryReplicaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RemoveRegistryReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRegistryReplicaContext indicates an expected call of RemoveRegistryReplicaContext.
+func (mr *MockClusterAPIMockRecorder) RemoveRegistryReplicaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegistryReplicaContext", reflect.TypeOf((*MockClusterAPI)(nil).RemoveRegistryReplicaContext), arg0, arg1)
+}
+
+// StartNetworkTest mocks base method.
+func (m *MockClusterAPI) StartNetworkTest(arg0 *quobyte.StartNetworkTestRequest) (*quobyte.StartNetworkTestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNetworkTest", arg0)
+	ret0, _ := ret[0].(*quobyte.StartNetworkTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartNetworkTest indicates an expected call of StartNetworkTest.
+func (mr *MockClusterAPIMockRecorder) StartNetworkTest(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNetworkTest", reflect.TypeOf((*MockClusterAPI)(nil).StartNetworkTest), arg0)
+}
+
+// StartNetworkTestContext mocks base method.
+func (m *MockClusterAPI) StartNetworkTestContext(arg0 context.Context, arg1 *quobyte.StartNetworkTestRequest) (*quobyte.StartNetworkTestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNetworkTestContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.StartNetworkTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartNetworkTestContext indicates an expected call of StartNetworkTestContext.
+func (mr *MockClusterAPIMockRecorder) StartNetworkTestContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNetworkTestContext", reflect.TypeOf((*MockClusterAPI)(nil).StartNetworkTestContext), arg0, arg1)
+}
@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: ConfigurationAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_configuration_api.go github.com/quobyte/api/quobyte ConfigurationAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigurationAPI is a mock of ConfigurationAPI interface.
+type MockConfigurationAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigurationAPIMockRecorder
+}
+
+// MockConfigurationAPIMockRecorder is the mock recorder for MockConfigurationAPI.
+type MockConfigurationAPIMockRecorder struct {
+	mock *MockConfigurationAPI
+}
+
+// NewMockConfigurationAPI creates a new mock instance.
+func NewMockConfigurationAPI(ctrl *gomock.Controller) *MockConfigurationAPI {
+	mock := &MockConfigurationAPI{ctrl: ctrl}
+	mock.recorder = &MockConfigurationAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigurationAPI) EXPECT() *MockConfigurationAPIMockRecorder {
+	return m.recorder
+}
+
+// DeleteConfiguration mocks base method.
+func (m *MockConfigurationAPI) DeleteConfiguration(arg0 *quobyte.DeleteConfigurationRequest) (*quobyte.DeleteConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfiguration", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfiguration indicates an expected call of DeleteConfiguration.
+func (mr *MockConfigurationAPIMockRecorder) DeleteConfiguration(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfiguration", reflect.TypeOf((*MockConfigurationAPI)(nil).DeleteConfiguration), arg0)
+}
+
+// DeleteConfigurationContext mocks base method.
+func (m *MockConfigurationAPI) DeleteConfigurationContext(arg0 context.Context, arg1 *quobyte.DeleteConfigurationRequest) (*quobyte.DeleteConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigurationContext indicates an expected call of DeleteConfigurationContext.
+func (mr *MockConfigurationAPIMockRecorder) DeleteConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationContext", reflect.TypeOf((*MockConfigurationAPI)(nil).DeleteConfigurationContext), arg0, arg1)
+}
+
+// ExportConfiguration mocks base method.
+func (m *MockConfigurationAPI) ExportConfiguration(arg0 *quobyte.ExportConfigurationRequest) (*quobyte.ExportConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportConfiguration", arg0)
+	ret0, _ := ret[0].(*quobyte.ExportConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportConfiguration indicates an expected call of ExportConfiguration.
+func (mr *MockConfigurationAPIMockRecorder) ExportConfiguration(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportConfiguration", reflect.TypeOf((*MockConfigurationAPI)(nil).ExportConfiguration), arg0)
+}
+
+// ExportConfigurationContext mocks base method.
+func (m *MockConfigurationAPI) ExportConfigurationContext(arg0 context.Context, arg1 *quobyte.ExportConfigurationRequest) (*quobyte.ExportConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportConfigurationContext indicates an expected call of ExportConfigurationContext.
+func (mr *MockConfigurationAPIMockRecorder) ExportConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportConfigurationContext", reflect.TypeOf((*MockConfigurationAPI)(nil).ExportConfigurationContext), arg0, arg1)
+}
+
+// GetConfiguration mocks base method.
+func (m *MockConfigurationAPI) GetConfiguration(arg0 *quobyte.GetConfigurationRequest) (*quobyte.GetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfiguration", arg0)
+	ret0, _ := ret[0].(*quobyte.GetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfiguration indicates an expected call of GetConfiguration.
+func (mr *MockConfigurationAPIMockRecorder) GetConfiguration(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguration", reflect.TypeOf((*MockConfigurationAPI)(nil).GetConfiguration), arg0)
+}
+
+// GetConfigurationContext mocks base method.
+func (m *MockConfigurationAPI) GetConfigurationContext(arg0 context.Context, arg1 *quobyte.GetConfigurationRequest) (*quobyte.GetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigurationContext indicates an expected call of GetConfigurationContext.
+func (mr *MockConfigurationAPIMockRecorder) GetConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigurationContext", reflect.TypeOf((*MockConfigurationAPI)(nil).GetConfigurationContext), arg0, arg1)
+}
+
+// ImportConfiguration mocks base method.
+func (m *MockConfigurationAPI) ImportConfiguration(arg0 *quobyte.ImportConfigurationRequest) (*quobyte.ImportConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportConfiguration", arg0)
+	ret0, _ := ret[0].(*quobyte.ImportConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportConfiguration indicates an expected call of ImportConfiguration.
+func (mr *MockConfigurationAPIMockRecorder) ImportConfiguration(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportConfiguration", reflect.TypeOf((*MockConfigurationAPI)(nil).ImportConfiguration), arg0)
+}
+
+// ImportConfigurationContext mocks base method.
+func (m *MockConfigurationAPI) ImportConfigurationContext(arg0 context.Context, arg1 *quobyte.ImportConfigurationRequest) (*quobyte.ImportConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ImportConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportConfigurationContext indicates an expected call of ImportConfigurationContext.
+func (mr *MockConfigurationAPIMockRecorder) ImportConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportConfigurationContext", reflect.TypeOf((*MockConfigurationAPI)(nil).ImportConfigurationContext), arg0, arg1)
+}
+
+// SetConfiguration mocks base method.
+func (m *MockConfigurationAPI) SetConfiguration(arg0 *quobyte.SetConfigurationRequest) (*quobyte.SetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfiguration", arg0)
+	ret0, _ := ret[0].(*quobyte.SetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfiguration indicates an expected call of SetConfiguration.
+func (mr *MockConfigurationAPIMockRecorder) SetConfiguration(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockConfigurationAPI)(nil).SetConfiguration), arg0)
+}
+
+// SetConfigurationContext mocks base method.
+func (m *MockConfigurationAPI) SetConfigurationContext(arg0 context.Context, arg1 *quobyte.SetConfigurationRequest) (*quobyte.SetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfigurationContext indicates an expected call of SetConfigurationContext.
+func (mr *MockConfigurationAPIMockRecorder) SetConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfigurationContext", reflect.TypeOf((*MockConfigurationAPI)(nil).SetConfigurationContext), arg0, arg1)
+}
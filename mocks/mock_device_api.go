@@ -0,0 +1,311 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: DeviceAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_device_api.go github.com/quobyte/api/quobyte DeviceAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeviceAPI is a mock of DeviceAPI interface.
+type MockDeviceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceAPIMockRecorder
+}
+
+// MockDeviceAPIMockRecorder is the mock recorder for MockDeviceAPI.
+type MockDeviceAPIMockRecorder struct {
+	mock *MockDeviceAPI
+}
+
+// NewMockDeviceAPI creates a new mock instance.
+func NewMockDeviceAPI(ctrl *gomock.Controller) *MockDeviceAPI {
+	mock := &MockDeviceAPI{ctrl: ctrl}
+	mock.recorder = &MockDeviceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceAPI) EXPECT() *MockDeviceAPIMockRecorder {
+	return m.recorder
+}
+
+// GetDeviceGroups mocks base method.
+func (m *MockDeviceAPI) GetDeviceGroups(arg0 *quobyte.GetDeviceGroupsRequest) (*quobyte.GetDeviceGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceGroups", arg0)
+	ret0, _ := ret[0].(*quobyte.GetDeviceGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceGroups indicates an expected call of GetDeviceGroups.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceGroups(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceGroups", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceGroups), arg0)
+}
+
+// GetDeviceGroupsContext mocks base method.
+func (m *MockDeviceAPI) GetDeviceGroupsContext(arg0 context.Context, arg1 *quobyte.GetDeviceGroupsRequest) (*quobyte.GetDeviceGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceGroupsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceGroupsContext indicates an expected call of GetDeviceGroupsContext.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceGroupsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceGroupsContext", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceGroupsContext), arg0, arg1)
+}
+
+// GetDeviceIds mocks base method.
+func (m *MockDeviceAPI) GetDeviceIds(arg0 *quobyte.GetDeviceIdsRequest) (*quobyte.GetDeviceIdsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceIds", arg0)
+	ret0, _ := ret[0].(*quobyte.GetDeviceIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceIds indicates an expected call of GetDeviceIds.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceIds(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceIds", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceIds), arg0)
+}
+
+// GetDeviceIdsContext mocks base method.
+func (m *MockDeviceAPI) GetDeviceIdsContext(arg0 context.Context, arg1 *quobyte.GetDeviceIdsRequest) (*quobyte.GetDeviceIdsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceIdsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceIdsContext indicates an expected call of GetDeviceIdsContext.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceIdsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceIdsContext", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceIdsContext), arg0, arg1)
+}
+
+// GetDeviceList mocks base method.
+func (m *MockDeviceAPI) GetDeviceList(arg0 *quobyte.GetDeviceListRequest) (*quobyte.GetDeviceListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceList", arg0)
+	ret0, _ := ret[0].(*quobyte.GetDeviceListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceList indicates an expected call of GetDeviceList.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceList(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceList", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceList), arg0)
+}
+
+// GetDeviceListContext mocks base method.
+func (m *MockDeviceAPI) GetDeviceListContext(arg0 context.Context, arg1 *quobyte.GetDeviceListRequest) (*quobyte.GetDeviceListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceListContext indicates an expected call of GetDeviceListContext.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceListContext", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceListContext), arg0, arg1)
+}
+
+// GetDeviceNetworkEndpoints mocks base method.
+func (m *MockDeviceAPI) GetDeviceNetworkEndpoints(arg0 *quobyte.GetDeviceNetworkEndpointsRequest) (*quobyte.GetDeviceNetworkEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceNetworkEndpoints", arg0)
+	ret0, _ := ret[0].(*quobyte.GetDeviceNetworkEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceNetworkEndpoints indicates an expected call of GetDeviceNetworkEndpoints.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceNetworkEndpoints(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceNetworkEndpoints", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceNetworkEndpoints), arg0)
+}
+
+// GetDeviceNetworkEndpointsContext mocks base method.
+func (m *MockDeviceAPI) GetDeviceNetworkEndpointsContext(arg0 context.Context, arg1 *quobyte.GetDeviceNetworkEndpointsRequest) (*quobyte.GetDeviceNetworkEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceNetworkEndpointsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceNetworkEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceNetworkEndpointsContext indicates an expected call of GetDeviceNetworkEndpointsContext.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceNetworkEndpointsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceNetworkEndpointsContext", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceNetworkEndpointsContext), arg0, arg1)
+}
+
+// GetDeviceTags mocks base method.
+func (m *MockDeviceAPI) GetDeviceTags(arg0 *quobyte.GetDeviceTagsRequest) (*quobyte.GetDeviceTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceTags", arg0)
+	ret0, _ := ret[0].(*quobyte.GetDeviceTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceTags indicates an expected call of GetDeviceTags.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceTags(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceTags", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceTags), arg0)
+}
+
+// GetDeviceTagsContext mocks base method.
+func (m *MockDeviceAPI) GetDeviceTagsContext(arg0 context.Context, arg1 *quobyte.GetDeviceTagsRequest) (*quobyte.GetDeviceTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceTagsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceTagsContext indicates an expected call of GetDeviceTagsContext.
+func (mr *MockDeviceAPIMockRecorder) GetDeviceTagsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceTagsContext", reflect.TypeOf((*MockDeviceAPI)(nil).GetDeviceTagsContext), arg0, arg1)
+}
+
+// GetUnformattedDevices mocks base method.
+func (m *MockDeviceAPI) GetUnformattedDevices(arg0 *quobyte.GetUnformattedDevicesRequest) (*quobyte.GetUnformattedDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnformattedDevices", arg0)
+	ret0, _ := ret[0].(*quobyte.GetUnformattedDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnformattedDevices indicates an expected call of GetUnformattedDevices.
+func (mr *MockDeviceAPIMockRecorder) GetUnformattedDevices(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnformattedDevices", reflect.TypeOf((*MockDeviceAPI)(nil).GetUnformattedDevices), arg0)
+}
+
+// GetUnformattedDevicesContext mocks base method.
+func (m *MockDeviceAPI) GetUnformattedDevicesContext(arg0 context.Context, arg1 *quobyte.GetUnformattedDevicesRequest) (*quobyte.GetUnformattedDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnformattedDevicesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetUnformattedDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnformattedDevicesContext indicates an expected call of GetUnformattedDevicesContext.
+func (mr *MockDeviceAPIMockRecorder) GetUnformattedDevicesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnformattedDevicesContext", reflect.TypeOf((*MockDeviceAPI)(nil).GetUnformattedDevicesContext), arg0, arg1)
+}
+
+// MakeDevice mocks base method.
+func (m *MockDeviceAPI) MakeDevice(arg0 *quobyte.MakeDeviceRequest) (*quobyte.MakeDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeDevice", arg0)
+	ret0, _ := ret[0].(*quobyte.MakeDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeDevice indicates an expected call of MakeDevice.
+func (mr *MockDeviceAPIMockRecorder) MakeDevice(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeDevice", reflect.TypeOf((*MockDeviceAPI)(nil).MakeDevice), arg0)
+}
+
+// MakeDeviceContext mocks base method.
+func (m *MockDeviceAPI) MakeDeviceContext(arg0 context.Context, arg1 *quobyte.MakeDeviceRequest) (*quobyte.MakeDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeDeviceContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.MakeDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeDeviceContext indicates an expected call of MakeDeviceContext.
+func (mr *MockDeviceAPIMockRecorder) MakeDeviceContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeDeviceContext", reflect.TypeOf((*MockDeviceAPI)(nil).MakeDeviceContext), arg0, arg1)
+}
+
+// RegenerateDatabase mocks base method.
+func (m *MockDeviceAPI) RegenerateDatabase(arg0 *quobyte.RegenerateDatabaseRequest) (*quobyte.RegenerateDatabaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateDatabase", arg0)
+	ret0, _ := ret[0].(*quobyte.RegenerateDatabaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateDatabase indicates an expected call of RegenerateDatabase.
+func (mr *MockDeviceAPIMockRecorder) RegenerateDatabase(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateDatabase", reflect.TypeOf((*MockDeviceAPI)(nil).RegenerateDatabase), arg0)
+}
+
+// RegenerateDatabaseContext mocks base method.
+func (m *MockDeviceAPI) RegenerateDatabaseContext(arg0 context.Context, arg1 *quobyte.RegenerateDatabaseRequest) (*quobyte.RegenerateDatabaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateDatabaseContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RegenerateDatabaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateDatabaseContext indicates an expected call of RegenerateDatabaseContext.
+func (mr *MockDeviceAPIMockRecorder) RegenerateDatabaseContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateDatabaseContext", reflect.TypeOf((*MockDeviceAPI)(nil).RegenerateDatabaseContext), arg0, arg1)
+}
+
+// UpdateDevice mocks base method.
+func (m *MockDeviceAPI) UpdateDevice(arg0 *quobyte.UpdateDeviceRequest) (*quobyte.UpdateDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDevice", arg0)
+	ret0, _ := ret[0].(*quobyte.UpdateDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDevice indicates an expected call of UpdateDevice.
+func (mr *MockDeviceAPIMockRecorder) UpdateDevice(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDevice", reflect.TypeOf((*MockDeviceAPI)(nil).UpdateDevice), arg0)
+}
+
+// UpdateDeviceContext mocks base method.
+func (m *MockDeviceAPI) UpdateDeviceContext(arg0 context.Context, arg1 *quobyte.UpdateDeviceRequest) (*quobyte.UpdateDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeviceContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdateDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeviceContext indicates an expected call of UpdateDeviceContext.
+func (mr *MockDeviceAPIMockRecorder) UpdateDeviceContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeviceContext", reflect.TypeOf((*MockDeviceAPI)(nil).UpdateDeviceContext), arg0, arg1)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: LabelAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_label_api.go github.com/quobyte/api/quobyte LabelAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLabelAPI is a mock of LabelAPI interface.
+type MockLabelAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelAPIMockRecorder
+}
+
+// MockLabelAPIMockRecorder is the mock recorder for MockLabelAPI.
+type MockLabelAPIMockRecorder struct {
+	mock *MockLabelAPI
+}
+
+// NewMockLabelAPI creates a new mock instance.
+func NewMockLabelAPI(ctrl *gomock.Controller) *MockLabelAPI {
+	mock := &MockLabelAPI{ctrl: ctrl}
+	mock.recorder = &MockLabelAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelAPI) EXPECT() *MockLabelAPIMockRecorder {
+	return m.recorder
+}
+
+// DeleteLabels mocks base method.
+func (m *MockLabelAPI) DeleteLabels(arg0 *quobyte.DeleteLabelsRequest) (*quobyte.DeleteLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabels", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLabels indicates an expected call of DeleteLabels.
+func (mr *MockLabelAPIMockRecorder) DeleteLabels(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabels", reflect.TypeOf((*MockLabelAPI)(nil).DeleteLabels), arg0)
+}
+
+// DeleteLabelsContext mocks base method.
+func (m *MockLabelAPI) DeleteLabelsContext(arg0 context.Context, arg1 *quobyte.DeleteLabelsRequest) (*quobyte.DeleteLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabelsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLabelsContext indicates an expected call of DeleteLabelsContext.
+func (mr *MockLabelAPIMockRecorder) DeleteLabelsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabelsContext", reflect.TypeOf((*MockLabelAPI)(nil).DeleteLabelsContext), arg0, arg1)
+}
+
+// GetLabels mocks base method.
+func (m *MockLabelAPI) GetLabels(arg0 *quobyte.GetLabelsRequest) (*quobyte.GetLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabels", arg0)
+	ret0, _ := ret[0].(*quobyte.GetLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabels indicates an expected call of GetLabels.
+func (mr *MockLabelAPIMockRecorder) GetLabels(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabels", reflect.TypeOf((*MockLabelAPI)(nil).GetLabels), arg0)
+}
+
+// GetLabelsContext mocks base method.
+func (m *MockLabelAPI) GetLabelsContext(arg0 context.Context, arg1 *quobyte.GetLabelsRequest) (*quobyte.GetLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelsContext indicates an expected call of GetLabelsContext.
+func (mr *MockLabelAPIMockRecorder) GetLabelsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelsContext", reflect.TypeOf((*MockLabelAPI)(nil).GetLabelsContext), arg0, arg1)
+}
+
+// SetLabels mocks base method.
+func (m *MockLabelAPI) SetLabels(arg0 *quobyte.SetLabelsRequest) (*quobyte.SetLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLabels", arg0)
+	ret0, _ := ret[0].(*quobyte.SetLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLabels indicates an expected call of SetLabels.
+func (mr *MockLabelAPIMockRecorder) SetLabels(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabels", reflect.TypeOf((*MockLabelAPI)(nil).SetLabels), arg0)
+}
+
+// SetLabelsContext mocks base method.
+func (m *MockLabelAPI) SetLabelsContext(arg0 context.Context, arg1 *quobyte.SetLabelsRequest) (*quobyte.SetLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLabelsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLabelsContext indicates an expected call of SetLabelsContext.
+func (mr *MockLabelAPIMockRecorder) SetLabelsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabelsContext", reflect.TypeOf((*MockLabelAPI)(nil).SetLabelsContext), arg0, arg1)
+}
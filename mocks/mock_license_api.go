@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: LicenseAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_license_api.go github.com/quobyte/api/quobyte LicenseAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLicenseAPI is a mock of LicenseAPI interface.
+type MockLicenseAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockLicenseAPIMockRecorder
+}
+
+// MockLicenseAPIMockRecorder is the mock recorder for MockLicenseAPI.
+type MockLicenseAPIMockRecorder struct {
+	mock *MockLicenseAPI
+}
+
+// NewMockLicenseAPI creates a new mock instance.
+func NewMockLicenseAPI(ctrl *gomock.Controller) *MockLicenseAPI {
+	mock := &MockLicenseAPI{ctrl: ctrl}
+	mock.recorder = &MockLicenseAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLicenseAPI) EXPECT() *MockLicenseAPIMockRecorder {
+	return m.recorder
+}
+
+// AcceptTermsAndConditions mocks base method.
+func (m *MockLicenseAPI) AcceptTermsAndConditions(arg0 *quobyte.AcceptTermsAndConditionsRequest) (*quobyte.AcceptTermsAndConditionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptTermsAndConditions", arg0)
+	ret0, _ := ret[0].(*quobyte.AcceptTermsAndConditionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptTermsAndConditions indicates an expected call of AcceptTermsAndConditions.
+func (mr *MockLicenseAPIMockRecorder) AcceptTermsAndConditions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTermsAndConditions", reflect.TypeOf((*MockLicenseAPI)(nil).AcceptTermsAndConditions), arg0)
+}
+
+// AcceptTermsAndConditionsContext mocks base method.
+func (m *MockLicenseAPI) AcceptTermsAndConditionsContext(arg0 context.Context, arg1 *quobyte.AcceptTermsAndConditionsRequest) (*quobyte.AcceptTermsAndConditionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptTermsAndConditionsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AcceptTermsAndConditionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptTermsAndConditionsContext indicates an expected call of AcceptTermsAndConditionsContext.
+func (mr *MockLicenseAPIMockRecorder) AcceptTermsAndConditionsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTermsAndConditionsContext", reflect.TypeOf((*MockLicenseAPI)(nil).AcceptTermsAndConditionsContext), arg0, arg1)
+}
+
+// GetLicense mocks base method.
+func (m *MockLicenseAPI) GetLicense(arg0 *quobyte.GetLicenseRequest) (*quobyte.GetLicenseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLicense", arg0)
+	ret0, _ := ret[0].(*quobyte.GetLicenseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLicense indicates an expected call of GetLicense.
+func (mr *MockLicenseAPIMockRecorder) GetLicense(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicense", reflect.TypeOf((*MockLicenseAPI)(nil).GetLicense), arg0)
+}
+
+// GetLicenseContext mocks base method.
+func (m *MockLicenseAPI) GetLicenseContext(arg0 context.Context, arg1 *quobyte.GetLicenseRequest) (*quobyte.GetLicenseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLicenseContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetLicenseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLicenseContext indicates an expected call of GetLicenseContext.
+func (mr *MockLicenseAPIMockRecorder) GetLicenseContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicenseContext", reflect.TypeOf((*MockLicenseAPI)(nil).GetLicenseContext), arg0, arg1)
+}
+
+// SetLicenseKey mocks base method.
+func (m *MockLicenseAPI) SetLicenseKey(arg0 *quobyte.SetLicenseKeyRequest) (*quobyte.SetLicenseKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLicenseKey", arg0)
+	ret0, _ := ret[0].(*quobyte.SetLicenseKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLicenseKey indicates an expected call of SetLicenseKey.
+func (mr *MockLicenseAPIMockRecorder) SetLicenseKey(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLicenseKey", reflect.TypeOf((*MockLicenseAPI)(nil).SetLicenseKey), arg0)
+}
+
+// SetLicenseKeyContext mocks base method.
+func (m *MockLicenseAPI) SetLicenseKeyContext(arg0 context.Context, arg1 *quobyte.SetLicenseKeyRequest) (*quobyte.SetLicenseKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLicenseKeyContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetLicenseKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLicenseKeyContext indicates an expected call of SetLicenseKeyContext.
+func (mr *MockLicenseAPIMockRecorder) SetLicenseKeyContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLicenseKeyContext", reflect.TypeOf((*MockLicenseAPI)(nil).SetLicenseKeyContext), arg0, arg1)
+}
+
+// VerifyLicense mocks base method.
+func (m *MockLicenseAPI) VerifyLicense(arg0 *quobyte.VerifyLicenseRequest) (*quobyte.VerifyLicenseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLicense", arg0)
+	ret0, _ := ret[0].(*quobyte.VerifyLicenseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLicense indicates an expected call of VerifyLicense.
+func (mr *MockLicenseAPIMockRecorder) VerifyLicense(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLicense", reflect.TypeOf((*MockLicenseAPI)(nil).VerifyLicense), arg0)
+}
+
+// VerifyLicenseContext mocks base method.
+func (m *MockLicenseAPI) VerifyLicenseContext(arg0 context.Context, arg1 *quobyte.VerifyLicenseRequest) (*quobyte.VerifyLicenseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLicenseContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.VerifyLicenseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLicenseContext indicates an expected call of VerifyLicenseContext.
+func (mr *MockLicenseAPIMockRecorder) VerifyLicenseContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLicenseContext", reflect.TypeOf((*MockLicenseAPI)(nil).VerifyLicenseContext), arg0, arg1)
+}
@@ -0,0 +1,371 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: MonitoringAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_monitoring_api.go github.com/quobyte/api/quobyte MonitoringAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMonitoringAPI is a mock of MonitoringAPI interface.
+type MockMonitoringAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockMonitoringAPIMockRecorder
+}
+
+// MockMonitoringAPIMockRecorder is the mock recorder for MockMonitoringAPI.
+type MockMonitoringAPIMockRecorder struct {
+	mock *MockMonitoringAPI
+}
+
+// NewMockMonitoringAPI creates a new mock instance.
+func NewMockMonitoringAPI(ctrl *gomock.Controller) *MockMonitoringAPI {
+	mock := &MockMonitoringAPI{ctrl: ctrl}
+	mock.recorder = &MockMonitoringAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMonitoringAPI) EXPECT() *MockMonitoringAPIMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeAlert mocks base method.
+func (m *MockMonitoringAPI) AcknowledgeAlert(arg0 *quobyte.AcknowledgeAlertRequest) (*quobyte.AcknowledgeAlertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeAlert", arg0)
+	ret0, _ := ret[0].(*quobyte.AcknowledgeAlertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeAlert indicates an expected call of AcknowledgeAlert.
+func (mr *MockMonitoringAPIMockRecorder) AcknowledgeAlert(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeAlert", reflect.TypeOf((*MockMonitoringAPI)(nil).AcknowledgeAlert), arg0)
+}
+
+// AcknowledgeAlertContext mocks base method.
+func (m *MockMonitoringAPI) AcknowledgeAlertContext(arg0 context.Context, arg1 *quobyte.AcknowledgeAlertRequest) (*quobyte.AcknowledgeAlertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeAlertContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AcknowledgeAlertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeAlertContext indicates an expected call of AcknowledgeAlertContext.
+func (mr *MockMonitoringAPIMockRecorder) AcknowledgeAlertContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeAlertContext", reflect.TypeOf((*MockMonitoringAPI)(nil).AcknowledgeAlertContext), arg0, arg1)
+}
+
+// ConfigureRule mocks base method.
+func (m *MockMonitoringAPI) ConfigureRule(arg0 *quobyte.ConfigureRuleRequest) (*quobyte.ConfigureRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureRule", arg0)
+	ret0, _ := ret[0].(*quobyte.ConfigureRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureRule indicates an expected call of ConfigureRule.
+func (mr *MockMonitoringAPIMockRecorder) ConfigureRule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureRule", reflect.TypeOf((*MockMonitoringAPI)(nil).ConfigureRule), arg0)
+}
+
+// ConfigureRuleContext mocks base method.
+func (m *MockMonitoringAPI) ConfigureRuleContext(arg0 context.Context, arg1 *quobyte.ConfigureRuleRequest) (*quobyte.ConfigureRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ConfigureRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureRuleContext indicates an expected call of ConfigureRuleContext.
+func (mr *MockMonitoringAPIMockRecorder) ConfigureRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureRuleContext", reflect.TypeOf((*MockMonitoringAPI)(nil).ConfigureRuleContext), arg0, arg1)
+}
+
+// CreateNotificationRule mocks base method.
+func (m *MockMonitoringAPI) CreateNotificationRule(arg0 *quobyte.CreateNotificationRuleRequest) (*quobyte.CreateNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationRule", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationRule indicates an expected call of CreateNotificationRule.
+func (mr *MockMonitoringAPIMockRecorder) CreateNotificationRule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationRule", reflect.TypeOf((*MockMonitoringAPI)(nil).CreateNotificationRule), arg0)
+}
+
+// CreateNotificationRuleContext mocks base method.
+func (m *MockMonitoringAPI) CreateNotificationRuleContext(arg0 context.Context, arg1 *quobyte.CreateNotificationRuleRequest) (*quobyte.CreateNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationRuleContext indicates an expected call of CreateNotificationRuleContext.
+func (mr *MockMonitoringAPIMockRecorder) CreateNotificationRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationRuleContext", reflect.TypeOf((*MockMonitoringAPI)(nil).CreateNotificationRuleContext), arg0, arg1)
+}
+
+// DeleteNotificationRule mocks base method.
+func (m *MockMonitoringAPI) DeleteNotificationRule(arg0 *quobyte.DeleteNotificationRuleRequest) (*quobyte.DeleteNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationRule", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotificationRule indicates an expected call of DeleteNotificationRule.
+func (mr *MockMonitoringAPIMockRecorder) DeleteNotificationRule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationRule", reflect.TypeOf((*MockMonitoringAPI)(nil).DeleteNotificationRule), arg0)
+}
+
+// DeleteNotificationRuleContext mocks base method.
+func (m *MockMonitoringAPI) DeleteNotificationRuleContext(arg0 context.Context, arg1 *quobyte.DeleteNotificationRuleRequest) (*quobyte.DeleteNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotificationRuleContext indicates an expected call of DeleteNotificationRuleContext.
+func (mr *MockMonitoringAPIMockRecorder) DeleteNotificationRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationRuleContext", reflect.TypeOf((*MockMonitoringAPI)(nil).DeleteNotificationRuleContext), arg0, arg1)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockMonitoringAPI) GetAuditLog(arg0 *quobyte.GetAuditLogRequest) (*quobyte.GetAuditLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", arg0)
+	ret0, _ := ret[0].(*quobyte.GetAuditLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockMonitoringAPIMockRecorder) GetAuditLog(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockMonitoringAPI)(nil).GetAuditLog), arg0)
+}
+
+// GetAuditLogContext mocks base method.
+func (m *MockMonitoringAPI) GetAuditLogContext(arg0 context.Context, arg1 *quobyte.GetAuditLogRequest) (*quobyte.GetAuditLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAuditLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogContext indicates an expected call of GetAuditLogContext.
+func (mr *MockMonitoringAPIMockRecorder) GetAuditLogContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogContext", reflect.TypeOf((*MockMonitoringAPI)(nil).GetAuditLogContext), arg0, arg1)
+}
+
+// GetFiringRules mocks base method.
+func (m *MockMonitoringAPI) GetFiringRules(arg0 *quobyte.GetFiringRulesRequest) (*quobyte.GetFiringRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiringRules", arg0)
+	ret0, _ := ret[0].(*quobyte.GetFiringRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiringRules indicates an expected call of GetFiringRules.
+func (mr *MockMonitoringAPIMockRecorder) GetFiringRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiringRules", reflect.TypeOf((*MockMonitoringAPI)(nil).GetFiringRules), arg0)
+}
+
+// GetFiringRulesContext mocks base method.
+func (m *MockMonitoringAPI) GetFiringRulesContext(arg0 context.Context, arg1 *quobyte.GetFiringRulesRequest) (*quobyte.GetFiringRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiringRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetFiringRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiringRulesContext indicates an expected call of GetFiringRulesContext.
+func (mr *MockMonitoringAPIMockRecorder) GetFiringRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiringRulesContext", reflect.TypeOf((*MockMonitoringAPI)(nil).GetFiringRulesContext), arg0, arg1)
+}
+
+// GetLatestEvent mocks base method.
+func (m *MockMonitoringAPI) GetLatestEvent(arg0 *quobyte.GetLatestEventRequest) (*quobyte.GetLatestEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEvent", arg0)
+	ret0, _ := ret[0].(*quobyte.GetLatestEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEvent indicates an expected call of GetLatestEvent.
+func (mr *MockMonitoringAPIMockRecorder) GetLatestEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEvent", reflect.TypeOf((*MockMonitoringAPI)(nil).GetLatestEvent), arg0)
+}
+
+// GetLatestEventContext mocks base method.
+func (m *MockMonitoringAPI) GetLatestEventContext(arg0 context.Context, arg1 *quobyte.GetLatestEventRequest) (*quobyte.GetLatestEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEventContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetLatestEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEventContext indicates an expected call of GetLatestEventContext.
+func (mr *MockMonitoringAPIMockRecorder) GetLatestEventContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEventContext", reflect.TypeOf((*MockMonitoringAPI)(nil).GetLatestEventContext), arg0, arg1)
+}
+
+// GetNotificationRules mocks base method.
+func (m *MockMonitoringAPI) GetNotificationRules(arg0 *quobyte.GetNotificationRulesRequest) (*quobyte.GetNotificationRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationRules", arg0)
+	ret0, _ := ret[0].(*quobyte.GetNotificationRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationRules indicates an expected call of GetNotificationRules.
+func (mr *MockMonitoringAPIMockRecorder) GetNotificationRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationRules", reflect.TypeOf((*MockMonitoringAPI)(nil).GetNotificationRules), arg0)
+}
+
+// GetNotificationRulesContext mocks base method.
+func (m *MockMonitoringAPI) GetNotificationRulesContext(arg0 context.Context, arg1 *quobyte.GetNotificationRulesRequest) (*quobyte.GetNotificationRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetNotificationRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationRulesContext indicates an expected call of GetNotificationRulesContext.
+func (mr *MockMonitoringAPIMockRecorder) GetNotificationRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationRulesContext", reflect.TypeOf((*MockMonitoringAPI)(nil).GetNotificationRulesContext), arg0, arg1)
+}
+
+// GetRules mocks base method.
+func (m *MockMonitoringAPI) GetRules(arg0 *quobyte.GetRulesRequest) (*quobyte.GetRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRules", arg0)
+	ret0, _ := ret[0].(*quobyte.GetRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRules indicates an expected call of GetRules.
+func (mr *MockMonitoringAPIMockRecorder) GetRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRules", reflect.TypeOf((*MockMonitoringAPI)(nil).GetRules), arg0)
+}
+
+// GetRulesContext mocks base method.
+func (m *MockMonitoringAPI) GetRulesContext(arg0 context.Context, arg1 *quobyte.GetRulesRequest) (*quobyte.GetRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRulesContext indicates an expected call of GetRulesContext.
+func (mr *MockMonitoringAPIMockRecorder) GetRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRulesContext", reflect.TypeOf((*MockMonitoringAPI)(nil).GetRulesContext), arg0, arg1)
+}
+
+// SetNotificationRule mocks base method.
+func (m *MockMonitoringAPI) SetNotificationRule(arg0 *quobyte.SetNotificationRuleRequest) (*quobyte.SetNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationRule", arg0)
+	ret0, _ := ret[0].(*quobyte.SetNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNotificationRule indicates an expected call of SetNotificationRule.
+func (mr *MockMonitoringAPIMockRecorder) SetNotificationRule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationRule", reflect.TypeOf((*MockMonitoringAPI)(nil).SetNotificationRule), arg0)
+}
+
+// SetNotificationRuleContext mocks base method.
+func (m *MockMonitoringAPI) SetNotificationRuleContext(arg0 context.Context, arg1 *quobyte.SetNotificationRuleRequest) (*quobyte.SetNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNotificationRuleContext indicates an expected call of SetNotificationRuleContext.
+func (mr *MockMonitoringAPIMockRecorder) SetNotificationRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationRuleContext", reflect.TypeOf((*MockMonitoringAPI)(nil).SetNotificationRuleContext), arg0, arg1)
+}
+
+// SilenceAlert mocks base method.
+func (m *MockMonitoringAPI) SilenceAlert(arg0 *quobyte.SilenceAlertRequest) (*quobyte.SilenceAlertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SilenceAlert", arg0)
+	ret0, _ := ret[0].(*quobyte.SilenceAlertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SilenceAlert indicates an expected call of SilenceAlert.
+func (mr *MockMonitoringAPIMockRecorder) SilenceAlert(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SilenceAlert", reflect.TypeOf((*MockMonitoringAPI)(nil).SilenceAlert), arg0)
+}
+
+// SilenceAlertContext mocks base method.
+func (m *MockMonitoringAPI) SilenceAlertContext(arg0 context.Context, arg1 *quobyte.SilenceAlertRequest) (*quobyte.SilenceAlertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SilenceAlertContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SilenceAlertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SilenceAlertContext indicates an expected call of SilenceAlertContext.
+func (mr *MockMonitoringAPIMockRecorder) SilenceAlertContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SilenceAlertContext", reflect.TypeOf((*MockMonitoringAPI)(nil).SilenceAlertContext), arg0, arg1)
+}
@@ -0,0 +1,461 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: PolicyAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_policy_api.go github.com/quobyte/api/quobyte PolicyAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicyAPI is a mock of PolicyAPI interface.
+type MockPolicyAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyAPIMockRecorder
+}
+
+// MockPolicyAPIMockRecorder is the mock recorder for MockPolicyAPI.
+type MockPolicyAPIMockRecorder struct {
+	mock *MockPolicyAPI
+}
+
+// NewMockPolicyAPI creates a new mock instance.
+func NewMockPolicyAPI(ctrl *gomock.Controller) *MockPolicyAPI {
+	mock := &MockPolicyAPI{ctrl: ctrl}
+	mock.recorder = &MockPolicyAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyAPI) EXPECT() *MockPolicyAPIMockRecorder {
+	return m.recorder
+}
+
+// ChangePolicyRulePriority mocks base method.
+func (m *MockPolicyAPI) ChangePolicyRulePriority(arg0 *quobyte.ChangePolicyRulePriorityRequest) (*quobyte.ChangePolicyRulePriorityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePolicyRulePriority", arg0)
+	ret0, _ := ret[0].(*quobyte.ChangePolicyRulePriorityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePolicyRulePriority indicates an expected call of ChangePolicyRulePriority.
+func (mr *MockPolicyAPIMockRecorder) ChangePolicyRulePriority(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePolicyRulePriority", reflect.TypeOf((*MockPolicyAPI)(nil).ChangePolicyRulePriority), arg0)
+}
+
+// ChangePolicyRulePriorityContext mocks base method.
+func (m *MockPolicyAPI) ChangePolicyRulePriorityContext(arg0 context.Context, arg1 *quobyte.ChangePolicyRulePriorityRequest) (*quobyte.ChangePolicyRulePriorityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePolicyRulePriorityContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ChangePolicyRulePriorityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePolicyRulePriorityContext indicates an expected call of ChangePolicyRulePriorityContext.
+func (mr *MockPolicyAPIMockRecorder) ChangePolicyRulePriorityContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePolicyRulePriorityContext", reflect.TypeOf((*MockPolicyAPI)(nil).ChangePolicyRulePriorityContext), arg0, arg1)
+}
+
+// CreatePolicyRule mocks base method.
+func (m *MockPolicyAPI) CreatePolicyRule(arg0 *quobyte.CreatePolicyRuleRequest) (*quobyte.CreatePolicyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicyRule", arg0)
+	ret0, _ := ret[0].(*quobyte.CreatePolicyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicyRule indicates an expected call of CreatePolicyRule.
+func (mr *MockPolicyAPIMockRecorder) CreatePolicyRule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRule", reflect.TypeOf((*MockPolicyAPI)(nil).CreatePolicyRule), arg0)
+}
+
+// CreatePolicyRuleContext mocks base method.
+func (m *MockPolicyAPI) CreatePolicyRuleContext(arg0 context.Context, arg1 *quobyte.CreatePolicyRuleRequest) (*quobyte.CreatePolicyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicyRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreatePolicyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicyRuleContext indicates an expected call of CreatePolicyRuleContext.
+func (mr *MockPolicyAPIMockRecorder) CreatePolicyRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRuleContext", reflect.TypeOf((*MockPolicyAPI)(nil).CreatePolicyRuleContext), arg0, arg1)
+}
+
+// CreatePolicyRuleSet mocks base method.
+func (m *MockPolicyAPI) CreatePolicyRuleSet(arg0 *quobyte.CreatePolicyRuleSetRequest) (*quobyte.CreatePolicyRuleSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicyRuleSet", arg0)
+	ret0, _ := ret[0].(*quobyte.CreatePolicyRuleSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicyRuleSet indicates an expected call of CreatePolicyRuleSet.
+func (mr *MockPolicyAPIMockRecorder) CreatePolicyRuleSet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRuleSet", reflect.TypeOf((*MockPolicyAPI)(nil).CreatePolicyRuleSet), arg0)
+}
+
+// CreatePolicyRuleSetContext mocks base method.
+func (m *MockPolicyAPI) CreatePolicyRuleSetContext(arg0 context.Context, arg1 *quobyte.CreatePolicyRuleSetRequest) (*quobyte.CreatePolicyRuleSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicyRuleSetContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreatePolicyRuleSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicyRuleSetContext indicates an expected call of CreatePolicyRuleSetContext.
+func (mr *MockPolicyAPIMockRecorder) CreatePolicyRuleSetContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRuleSetContext", reflect.TypeOf((*MockPolicyAPI)(nil).CreatePolicyRuleSetContext), arg0, arg1)
+}
+
+// DeletePolicyRules mocks base method.
+func (m *MockPolicyAPI) DeletePolicyRules(arg0 *quobyte.DeletePolicyRulesRequest) (*quobyte.DeletePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicyRules", arg0)
+	ret0, _ := ret[0].(*quobyte.DeletePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePolicyRules indicates an expected call of DeletePolicyRules.
+func (mr *MockPolicyAPIMockRecorder) DeletePolicyRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyRules", reflect.TypeOf((*MockPolicyAPI)(nil).DeletePolicyRules), arg0)
+}
+
+// DeletePolicyRulesContext mocks base method.
+func (m *MockPolicyAPI) DeletePolicyRulesContext(arg0 context.Context, arg1 *quobyte.DeletePolicyRulesRequest) (*quobyte.DeletePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeletePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePolicyRulesContext indicates an expected call of DeletePolicyRulesContext.
+func (mr *MockPolicyAPIMockRecorder) DeletePolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyRulesContext", reflect.TypeOf((*MockPolicyAPI)(nil).DeletePolicyRulesContext), arg0, arg1)
+}
+
+// DumpEffectivePolicyRules mocks base method.
+func (m *MockPolicyAPI) DumpEffectivePolicyRules(arg0 *quobyte.DumpEffectivePolicyRulesRequest) (*quobyte.DumpEffectivePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpEffectivePolicyRules", arg0)
+	ret0, _ := ret[0].(*quobyte.DumpEffectivePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpEffectivePolicyRules indicates an expected call of DumpEffectivePolicyRules.
+func (mr *MockPolicyAPIMockRecorder) DumpEffectivePolicyRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpEffectivePolicyRules", reflect.TypeOf((*MockPolicyAPI)(nil).DumpEffectivePolicyRules), arg0)
+}
+
+// DumpEffectivePolicyRulesContext mocks base method.
+func (m *MockPolicyAPI) DumpEffectivePolicyRulesContext(arg0 context.Context, arg1 *quobyte.DumpEffectivePolicyRulesRequest) (*quobyte.DumpEffectivePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpEffectivePolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DumpEffectivePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpEffectivePolicyRulesContext indicates an expected call of DumpEffectivePolicyRulesContext.
+func (mr *MockPolicyAPIMockRecorder) DumpEffectivePolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpEffectivePolicyRulesContext", reflect.TypeOf((*MockPolicyAPI)(nil).DumpEffectivePolicyRulesContext), arg0, arg1)
+}
+
+// DumpPolicyPresets mocks base method.
+func (m *MockPolicyAPI) DumpPolicyPresets(arg0 *quobyte.DumpPolicyPresetsRequest) (*quobyte.DumpPolicyPresetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpPolicyPresets", arg0)
+	ret0, _ := ret[0].(*quobyte.DumpPolicyPresetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpPolicyPresets indicates an expected call of DumpPolicyPresets.
+func (mr *MockPolicyAPIMockRecorder) DumpPolicyPresets(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpPolicyPresets", reflect.TypeOf((*MockPolicyAPI)(nil).DumpPolicyPresets), arg0)
+}
+
+// DumpPolicyPresetsContext mocks base method.
+func (m *MockPolicyAPI) DumpPolicyPresetsContext(arg0 context.Context, arg1 *quobyte.DumpPolicyPresetsRequest) (*quobyte.DumpPolicyPresetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpPolicyPresetsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DumpPolicyPresetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpPolicyPresetsContext indicates an expected call of DumpPolicyPresetsContext.
+func (mr *MockPolicyAPIMockRecorder) DumpPolicyPresetsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpPolicyPresetsContext", reflect.TypeOf((*MockPolicyAPI)(nil).DumpPolicyPresetsContext), arg0, arg1)
+}
+
+// ExportPolicyRules mocks base method.
+func (m *MockPolicyAPI) ExportPolicyRules(arg0 *quobyte.ExportPolicyRulesRequest) (*quobyte.ExportPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPolicyRules", arg0)
+	ret0, _ := ret[0].(*quobyte.ExportPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPolicyRules indicates an expected call of ExportPolicyRules.
+func (mr *MockPolicyAPIMockRecorder) ExportPolicyRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPolicyRules", reflect.TypeOf((*MockPolicyAPI)(nil).ExportPolicyRules), arg0)
+}
+
+// ExportPolicyRulesContext mocks base method.
+func (m *MockPolicyAPI) ExportPolicyRulesContext(arg0 context.Context, arg1 *quobyte.ExportPolicyRulesRequest) (*quobyte.ExportPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPolicyRulesContext indicates an expected call of ExportPolicyRulesContext.
+func (mr *MockPolicyAPIMockRecorder) ExportPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPolicyRulesContext", reflect.TypeOf((*MockPolicyAPI)(nil).ExportPolicyRulesContext), arg0, arg1)
+}
+
+// FilterPolicyRules mocks base method.
+func (m *MockPolicyAPI) FilterPolicyRules(arg0 *quobyte.FilterPolicyRulesRequest) (*quobyte.FilterPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterPolicyRules", arg0)
+	ret0, _ := ret[0].(*quobyte.FilterPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterPolicyRules indicates an expected call of FilterPolicyRules.
+func (mr *MockPolicyAPIMockRecorder) FilterPolicyRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterPolicyRules", reflect.TypeOf((*MockPolicyAPI)(nil).FilterPolicyRules), arg0)
+}
+
+// FilterPolicyRulesContext mocks base method.
+func (m *MockPolicyAPI) FilterPolicyRulesContext(arg0 context.Context, arg1 *quobyte.FilterPolicyRulesRequest) (*quobyte.FilterPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.FilterPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterPolicyRulesContext indicates an expected call of FilterPolicyRulesContext.
+func (mr *MockPolicyAPIMockRecorder) FilterPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterPolicyRulesContext", reflect.TypeOf((*MockPolicyAPI)(nil).FilterPolicyRulesContext), arg0, arg1)
+}
+
+// GetPolicyPresets mocks base method.
+func (m *MockPolicyAPI) GetPolicyPresets(arg0 *quobyte.GetPolicyPresetsRequest) (*quobyte.GetPolicyPresetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyPresets", arg0)
+	ret0, _ := ret[0].(*quobyte.GetPolicyPresetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyPresets indicates an expected call of GetPolicyPresets.
+func (mr *MockPolicyAPIMockRecorder) GetPolicyPresets(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyPresets", reflect.TypeOf((*MockPolicyAPI)(nil).GetPolicyPresets), arg0)
+}
+
+// GetPolicyPresetsContext mocks base method.
+func (m *MockPolicyAPI) GetPolicyPresetsContext(arg0 context.Context, arg1 *quobyte.GetPolicyPresetsRequest) (*quobyte.GetPolicyPresetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyPresetsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetPolicyPresetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyPresetsContext indicates an expected call of GetPolicyPresetsContext.
+func (mr *MockPolicyAPIMockRecorder) GetPolicyPresetsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyPresetsContext", reflect.TypeOf((*MockPolicyAPI)(nil).GetPolicyPresetsContext), arg0, arg1)
+}
+
+// GetPolicyRuleSets mocks base method.
+func (m *MockPolicyAPI) GetPolicyRuleSets(arg0 *quobyte.GetPolicyRuleSetsRequest) (*quobyte.GetPolicyRuleSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyRuleSets", arg0)
+	ret0, _ := ret[0].(*quobyte.GetPolicyRuleSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyRuleSets indicates an expected call of GetPolicyRuleSets.
+func (mr *MockPolicyAPIMockRecorder) GetPolicyRuleSets(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRuleSets", reflect.TypeOf((*MockPolicyAPI)(nil).GetPolicyRuleSets), arg0)
+}
+
+// GetPolicyRuleSetsContext mocks base method.
+func (m *MockPolicyAPI) GetPolicyRuleSetsContext(arg0 context.Context, arg1 *quobyte.GetPolicyRuleSetsRequest) (*quobyte.GetPolicyRuleSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyRuleSetsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetPolicyRuleSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyRuleSetsContext indicates an expected call of GetPolicyRuleSetsContext.
+func (mr *MockPolicyAPIMockRecorder) GetPolicyRuleSetsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRuleSetsContext", reflect.TypeOf((*MockPolicyAPI)(nil).GetPolicyRuleSetsContext), arg0, arg1)
+}
+
+// GetPolicyRules mocks base method.
+func (m *MockPolicyAPI) GetPolicyRules(arg0 *quobyte.GetPolicyRulesRequest) (*quobyte.GetPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyRules", arg0)
+	ret0, _ := ret[0].(*quobyte.GetPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyRules indicates an expected call of GetPolicyRules.
+func (mr *MockPolicyAPIMockRecorder) GetPolicyRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRules", reflect.TypeOf((*MockPolicyAPI)(nil).GetPolicyRules), arg0)
+}
+
+// GetPolicyRulesContext mocks base method.
+func (m *MockPolicyAPI) GetPolicyRulesContext(arg0 context.Context, arg1 *quobyte.GetPolicyRulesRequest) (*quobyte.GetPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyRulesContext indicates an expected call of GetPolicyRulesContext.
+func (mr *MockPolicyAPIMockRecorder) GetPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRulesContext", reflect.TypeOf((*MockPolicyAPI)(nil).GetPolicyRulesContext), arg0, arg1)
+}
+
+// ImportPolicyRules mocks base method.
+func (m *MockPolicyAPI) ImportPolicyRules(arg0 *quobyte.ImportPolicyRulesRequest) (*quobyte.ImportPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPolicyRules", arg0)
+	ret0, _ := ret[0].(*quobyte.ImportPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPolicyRules indicates an expected call of ImportPolicyRules.
+func (mr *MockPolicyAPIMockRecorder) ImportPolicyRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPolicyRules", reflect.TypeOf((*MockPolicyAPI)(nil).ImportPolicyRules), arg0)
+}
+
+// ImportPolicyRulesContext mocks base method.
+func (m *MockPolicyAPI) ImportPolicyRulesContext(arg0 context.Context, arg1 *quobyte.ImportPolicyRulesRequest) (*quobyte.ImportPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ImportPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPolicyRulesContext indicates an expected call of ImportPolicyRulesContext.
+func (mr *MockPolicyAPIMockRecorder) ImportPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPolicyRulesContext", reflect.TypeOf((*MockPolicyAPI)(nil).ImportPolicyRulesContext), arg0, arg1)
+}
+
+// ResolvePolicyRuleName mocks base method.
+func (m *MockPolicyAPI) ResolvePolicyRuleName(arg0 *quobyte.ResolvePolicyRuleNameRequest) (*quobyte.ResolvePolicyRuleNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePolicyRuleName", arg0)
+	ret0, _ := ret[0].(*quobyte.ResolvePolicyRuleNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePolicyRuleName indicates an expected call of ResolvePolicyRuleName.
+func (mr *MockPolicyAPIMockRecorder) ResolvePolicyRuleName(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePolicyRuleName", reflect.TypeOf((*MockPolicyAPI)(nil).ResolvePolicyRuleName), arg0)
+}
+
+// ResolvePolicyRuleNameContext mocks base method.
+func (m *MockPolicyAPI) ResolvePolicyRuleNameContext(arg0 context.Context, arg1 *quobyte.ResolvePolicyRuleNameRequest) (*quobyte.ResolvePolicyRuleNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePolicyRuleNameContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResolvePolicyRuleNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePolicyRuleNameContext indicates an expected call of ResolvePolicyRuleNameContext.
+func (mr *MockPolicyAPIMockRecorder) ResolvePolicyRuleNameContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePolicyRuleNameContext", reflect.TypeOf((*MockPolicyAPI)(nil).ResolvePolicyRuleNameContext), arg0, arg1)
+}
+
+// UpdatePolicyRules mocks base method.
+func (m *MockPolicyAPI) UpdatePolicyRules(arg0 *quobyte.UpdatePolicyRulesRequest) (*quobyte.UpdatePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicyRules", arg0)
+	ret0, _ := ret[0].(*quobyte.UpdatePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicyRules indicates an expected call of UpdatePolicyRules.
+func (mr *MockPolicyAPIMockRecorder) UpdatePolicyRules(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyRules", reflect.TypeOf((*MockPolicyAPI)(nil).UpdatePolicyRules), arg0)
+}
+
+// UpdatePolicyRulesContext mocks base method.
+func (m *MockPolicyAPI) UpdatePolicyRulesContext(arg0 context.Context, arg1 *quobyte.UpdatePolicyRulesRequest) (*quobyte.UpdatePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdatePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicyRulesContext indicates an expected call of UpdatePolicyRulesContext.
+func (mr *MockPolicyAPIMockRecorder) UpdatePolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyRulesContext", reflect.TypeOf((*MockPolicyAPI)(nil).UpdatePolicyRulesContext), arg0, arg1)
+}
@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	http "net/http"
 	reflect "reflect"
 
@@ -55,6 +56,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) AcceptTermsAndConditions(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTermsAndConditions", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AcceptTermsAndConditions), arg0)
 }
 
+// AcceptTermsAndConditionsContext mocks base method.
+func (m *MockExtendedQuobyteApi) AcceptTermsAndConditionsContext(arg0 context.Context, arg1 *quobyte.AcceptTermsAndConditionsRequest) (*quobyte.AcceptTermsAndConditionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptTermsAndConditionsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AcceptTermsAndConditionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptTermsAndConditionsContext indicates an expected call of AcceptTermsAndConditionsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) AcceptTermsAndConditionsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTermsAndConditionsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AcceptTermsAndConditionsContext), arg0, arg1)
+}
+
 // AcknowledgeAlert mocks base method.
 func (m *MockExtendedQuobyteApi) AcknowledgeAlert(arg0 *quobyte.AcknowledgeAlertRequest) (*quobyte.AcknowledgeAlertResponse, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +86,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) AcknowledgeAlert(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeAlert", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AcknowledgeAlert), arg0)
 }
 
+// AcknowledgeAlertContext mocks base method.
+func (m *MockExtendedQuobyteApi) AcknowledgeAlertContext(arg0 context.Context, arg1 *quobyte.AcknowledgeAlertRequest) (*quobyte.AcknowledgeAlertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeAlertContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AcknowledgeAlertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeAlertContext indicates an expected call of AcknowledgeAlertContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) AcknowledgeAlertContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeAlertContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AcknowledgeAlertContext), arg0, arg1)
+}
+
 // AddCa mocks base method.
 func (m *MockExtendedQuobyteApi) AddCa(arg0 *quobyte.AddCaRequest) (*quobyte.AddCaResponse, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +116,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) AddCa(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCa", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddCa), arg0)
 }
 
+// AddCaContext mocks base method.
+func (m *MockExtendedQuobyteApi) AddCaContext(arg0 context.Context, arg1 *quobyte.AddCaRequest) (*quobyte.AddCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCaContext indicates an expected call of AddCaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) AddCaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddCaContext), arg0, arg1)
+}
+
 // AddCertificate mocks base method.
 func (m *MockExtendedQuobyteApi) AddCertificate(arg0 *quobyte.AddCertificateRequest) (*quobyte.AddCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +146,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) AddCertificate(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificate", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddCertificate), arg0)
 }
 
+// AddCertificateContext mocks base method.
+func (m *MockExtendedQuobyteApi) AddCertificateContext(arg0 context.Context, arg1 *quobyte.AddCertificateRequest) (*quobyte.AddCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCertificateContext indicates an expected call of AddCertificateContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) AddCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificateContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddCertificateContext), arg0, arg1)
+}
+
 // AddCsr mocks base method.
 func (m *MockExtendedQuobyteApi) AddCsr(arg0 *quobyte.AddCsrRequest) (*quobyte.AddCsrResponse, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +176,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) AddCsr(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCsr", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddCsr), arg0)
 }
 
+// AddCsrContext mocks base method.
+func (m *MockExtendedQuobyteApi) AddCsrContext(arg0 context.Context, arg1 *quobyte.AddCsrRequest) (*quobyte.AddCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCsrContext indicates an expected call of AddCsrContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) AddCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCsrContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddCsrContext), arg0, arg1)
+}
+
 // AddRegistryReplica mocks base method.
 func (m *MockExtendedQuobyteApi) AddRegistryReplica(arg0 *quobyte.AddRegistryReplicaRequest) (*quobyte.AddRegistryReplicaResponse, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +206,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) AddRegistryReplica(arg0 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegistryReplica", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddRegistryReplica), arg0)
 }
 
+// AddRegistryReplicaContext mocks base method.
+func (m *MockExtendedQuobyteApi) AddRegistryReplicaContext(arg0 context.Context, arg1 *quobyte.AddRegistryReplicaRequest) (*quobyte.AddRegistryReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRegistryReplicaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddRegistryReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRegistryReplicaContext indicates an expected call of AddRegistryReplicaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) AddRegistryReplicaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegistryReplicaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AddRegistryReplicaContext), arg0, arg1)
+}
+
 // AnalyzeVolumes mocks base method.
 func (m *MockExtendedQuobyteApi) AnalyzeVolumes(arg0 *quobyte.AnalyzeVolumesRequest) (*quobyte.AnalyzeVolumesResponse, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +236,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) AnalyzeVolumes(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeVolumes", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AnalyzeVolumes), arg0)
 }
 
+// AnalyzeVolumesContext mocks base method.
+func (m *MockExtendedQuobyteApi) AnalyzeVolumesContext(arg0 context.Context, arg1 *quobyte.AnalyzeVolumesRequest) (*quobyte.AnalyzeVolumesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeVolumesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AnalyzeVolumesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeVolumesContext indicates an expected call of AnalyzeVolumesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) AnalyzeVolumesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeVolumesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).AnalyzeVolumesContext), arg0, arg1)
+}
+
 // CancelNetworkTest mocks base method.
 func (m *MockExtendedQuobyteApi) CancelNetworkTest(arg0 *quobyte.CancelNetworkTestRequest) (*quobyte.CancelNetworkTestResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +266,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CancelNetworkTest(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNetworkTest", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelNetworkTest), arg0)
 }
 
+// CancelNetworkTestContext mocks base method.
+func (m *MockExtendedQuobyteApi) CancelNetworkTestContext(arg0 context.Context, arg1 *quobyte.CancelNetworkTestRequest) (*quobyte.CancelNetworkTestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNetworkTestContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelNetworkTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelNetworkTestContext indicates an expected call of CancelNetworkTestContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CancelNetworkTestContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNetworkTestContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelNetworkTestContext), arg0, arg1)
+}
+
 // CancelQuery mocks base method.
 func (m *MockExtendedQuobyteApi) CancelQuery(arg0 *quobyte.CancelQueryRequest) (*quobyte.CancelQueryResponse, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +296,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CancelQuery(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelQuery", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelQuery), arg0)
 }
 
+// CancelQueryContext mocks base method.
+func (m *MockExtendedQuobyteApi) CancelQueryContext(arg0 context.Context, arg1 *quobyte.CancelQueryRequest) (*quobyte.CancelQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelQueryContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelQueryContext indicates an expected call of CancelQueryContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CancelQueryContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelQueryContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelQueryContext), arg0, arg1)
+}
+
 // CancelSupportDump mocks base method.
 func (m *MockExtendedQuobyteApi) CancelSupportDump(arg0 *quobyte.CancelSupportDumpRequest) (*quobyte.CancelSupportDumpResponse, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +326,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CancelSupportDump(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSupportDump", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelSupportDump), arg0)
 }
 
+// CancelSupportDumpContext mocks base method.
+func (m *MockExtendedQuobyteApi) CancelSupportDumpContext(arg0 context.Context, arg1 *quobyte.CancelSupportDumpRequest) (*quobyte.CancelSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSupportDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSupportDumpContext indicates an expected call of CancelSupportDumpContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CancelSupportDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSupportDumpContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelSupportDumpContext), arg0, arg1)
+}
+
 // CancelTask mocks base method.
 func (m *MockExtendedQuobyteApi) CancelTask(arg0 *quobyte.CancelTaskRequest) (*quobyte.CancelTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +356,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CancelTask(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTask", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelTask), arg0)
 }
 
+// CancelTaskContext mocks base method.
+func (m *MockExtendedQuobyteApi) CancelTaskContext(arg0 context.Context, arg1 *quobyte.CancelTaskRequest) (*quobyte.CancelTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTaskContext indicates an expected call of CancelTaskContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CancelTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTaskContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelTaskContext), arg0, arg1)
+}
+
 // CancelVolumeErasure mocks base method.
 func (m *MockExtendedQuobyteApi) CancelVolumeErasure(arg0 *quobyte.CancelVolumeErasureRequest) (*quobyte.CancelVolumeErasureResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +386,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CancelVolumeErasure(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVolumeErasure", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelVolumeErasure), arg0)
 }
 
+// CancelVolumeErasureContext mocks base method.
+func (m *MockExtendedQuobyteApi) CancelVolumeErasureContext(arg0 context.Context, arg1 *quobyte.CancelVolumeErasureRequest) (*quobyte.CancelVolumeErasureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVolumeErasureContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelVolumeErasureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVolumeErasureContext indicates an expected call of CancelVolumeErasureContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CancelVolumeErasureContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVolumeErasureContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CancelVolumeErasureContext), arg0, arg1)
+}
+
 // ChangePolicyRulePriority mocks base method.
 func (m *MockExtendedQuobyteApi) ChangePolicyRulePriority(arg0 *quobyte.ChangePolicyRulePriorityRequest) (*quobyte.ChangePolicyRulePriorityResponse, error) {
 	m.ctrl.T.Helper()
@@ -235,6 +416,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ChangePolicyRulePriority(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePolicyRulePriority", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ChangePolicyRulePriority), arg0)
 }
 
+// ChangePolicyRulePriorityContext mocks base method.
+func (m *MockExtendedQuobyteApi) ChangePolicyRulePriorityContext(arg0 context.Context, arg1 *quobyte.ChangePolicyRulePriorityRequest) (*quobyte.ChangePolicyRulePriorityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePolicyRulePriorityContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ChangePolicyRulePriorityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePolicyRulePriorityContext indicates an expected call of ChangePolicyRulePriorityContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ChangePolicyRulePriorityContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePolicyRulePriorityContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ChangePolicyRulePriorityContext), arg0, arg1)
+}
+
 // ConfigureRule mocks base method.
 func (m *MockExtendedQuobyteApi) ConfigureRule(arg0 *quobyte.ConfigureRuleRequest) (*quobyte.ConfigureRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +446,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ConfigureRule(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureRule", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ConfigureRule), arg0)
 }
 
+// ConfigureRuleContext mocks base method.
+func (m *MockExtendedQuobyteApi) ConfigureRuleContext(arg0 context.Context, arg1 *quobyte.ConfigureRuleRequest) (*quobyte.ConfigureRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ConfigureRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureRuleContext indicates an expected call of ConfigureRuleContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ConfigureRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureRuleContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ConfigureRuleContext), arg0, arg1)
+}
+
 // CreateAccessKeyCredentials mocks base method.
 func (m *MockExtendedQuobyteApi) CreateAccessKeyCredentials(arg0 *quobyte.CreateAccessKeyCredentialsRequest) (*quobyte.CreateAccessKeyCredentialsResponse, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +476,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateAccessKeyCredentials(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessKeyCredentials", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateAccessKeyCredentials), arg0)
 }
 
+// CreateAccessKeyCredentialsContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateAccessKeyCredentialsContext(arg0 context.Context, arg1 *quobyte.CreateAccessKeyCredentialsRequest) (*quobyte.CreateAccessKeyCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessKeyCredentialsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateAccessKeyCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessKeyCredentialsContext indicates an expected call of CreateAccessKeyCredentialsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateAccessKeyCredentialsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessKeyCredentialsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateAccessKeyCredentialsContext), arg0, arg1)
+}
+
 // CreateMasterKeystoreSlot mocks base method.
 func (m *MockExtendedQuobyteApi) CreateMasterKeystoreSlot(arg0 *quobyte.CreateMasterKeystoreSlotRequest) (*quobyte.CreateMasterKeystoreSlotResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +506,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateMasterKeystoreSlot(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMasterKeystoreSlot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateMasterKeystoreSlot), arg0)
 }
 
+// CreateMasterKeystoreSlotContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateMasterKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.CreateMasterKeystoreSlotRequest) (*quobyte.CreateMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMasterKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMasterKeystoreSlotContext indicates an expected call of CreateMasterKeystoreSlotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateMasterKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMasterKeystoreSlotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateMasterKeystoreSlotContext), arg0, arg1)
+}
+
 // CreateMirroredVolume mocks base method.
 func (m *MockExtendedQuobyteApi) CreateMirroredVolume(arg0 *quobyte.CreateMirroredVolumeRequest) (*quobyte.CreateMirroredVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -295,6 +536,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateMirroredVolume(arg0 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMirroredVolume", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateMirroredVolume), arg0)
 }
 
+// CreateMirroredVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateMirroredVolumeContext(arg0 context.Context, arg1 *quobyte.CreateMirroredVolumeRequest) (*quobyte.CreateMirroredVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMirroredVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateMirroredVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMirroredVolumeContext indicates an expected call of CreateMirroredVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateMirroredVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMirroredVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateMirroredVolumeContext), arg0, arg1)
+}
+
 // CreateNewUserKeystoreSlot mocks base method.
 func (m *MockExtendedQuobyteApi) CreateNewUserKeystoreSlot(arg0 *quobyte.CreateNewUserKeystoreSlotRequest) (*quobyte.CreateNewUserKeystoreSlotResponse, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +566,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateNewUserKeystoreSlot(arg0 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewUserKeystoreSlot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateNewUserKeystoreSlot), arg0)
 }
 
+// CreateNewUserKeystoreSlotContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateNewUserKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.CreateNewUserKeystoreSlotRequest) (*quobyte.CreateNewUserKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewUserKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateNewUserKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewUserKeystoreSlotContext indicates an expected call of CreateNewUserKeystoreSlotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateNewUserKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewUserKeystoreSlotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateNewUserKeystoreSlotContext), arg0, arg1)
+}
+
 // CreateNotificationRule mocks base method.
 func (m *MockExtendedQuobyteApi) CreateNotificationRule(arg0 *quobyte.CreateNotificationRuleRequest) (*quobyte.CreateNotificationRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +596,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateNotificationRule(arg0 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationRule", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateNotificationRule), arg0)
 }
 
+// CreateNotificationRuleContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateNotificationRuleContext(arg0 context.Context, arg1 *quobyte.CreateNotificationRuleRequest) (*quobyte.CreateNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationRuleContext indicates an expected call of CreateNotificationRuleContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateNotificationRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationRuleContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateNotificationRuleContext), arg0, arg1)
+}
+
 // CreatePolicyRule mocks base method.
 func (m *MockExtendedQuobyteApi) CreatePolicyRule(arg0 *quobyte.CreatePolicyRuleRequest) (*quobyte.CreatePolicyRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +626,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreatePolicyRule(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRule", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreatePolicyRule), arg0)
 }
 
+// CreatePolicyRuleContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreatePolicyRuleContext(arg0 context.Context, arg1 *quobyte.CreatePolicyRuleRequest) (*quobyte.CreatePolicyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicyRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreatePolicyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicyRuleContext indicates an expected call of CreatePolicyRuleContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreatePolicyRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRuleContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreatePolicyRuleContext), arg0, arg1)
+}
+
 // CreatePolicyRuleSet mocks base method.
 func (m *MockExtendedQuobyteApi) CreatePolicyRuleSet(arg0 *quobyte.CreatePolicyRuleSetRequest) (*quobyte.CreatePolicyRuleSetResponse, error) {
 	m.ctrl.T.Helper()
@@ -355,6 +656,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreatePolicyRuleSet(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRuleSet", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreatePolicyRuleSet), arg0)
 }
 
+// CreatePolicyRuleSetContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreatePolicyRuleSetContext(arg0 context.Context, arg1 *quobyte.CreatePolicyRuleSetRequest) (*quobyte.CreatePolicyRuleSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicyRuleSetContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreatePolicyRuleSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicyRuleSetContext indicates an expected call of CreatePolicyRuleSetContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreatePolicyRuleSetContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicyRuleSetContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreatePolicyRuleSetContext), arg0, arg1)
+}
+
 // CreateSnapshot mocks base method.
 func (m *MockExtendedQuobyteApi) CreateSnapshot(arg0 *quobyte.CreateSnapshotRequest) (*quobyte.CreateSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -370,6 +686,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateSnapshot(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateSnapshot), arg0)
 }
 
+// CreateSnapshotContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateSnapshotContext(arg0 context.Context, arg1 *quobyte.CreateSnapshotRequest) (*quobyte.CreateSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotContext indicates an expected call of CreateSnapshotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateSnapshotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateSnapshotContext), arg0, arg1)
+}
+
 // CreateTask mocks base method.
 func (m *MockExtendedQuobyteApi) CreateTask(arg0 *quobyte.CreateTaskRequest) (*quobyte.CreateTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +716,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateTask(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateTask), arg0)
 }
 
+// CreateTaskContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateTaskContext(arg0 context.Context, arg1 *quobyte.CreateTaskRequest) (*quobyte.CreateTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskContext indicates an expected call of CreateTaskContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateTaskContext), arg0, arg1)
+}
+
 // CreateUser mocks base method.
 func (m *MockExtendedQuobyteApi) CreateUser(arg0 *quobyte.CreateUserRequest) (*quobyte.CreateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +746,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateUser(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateUser), arg0)
 }
 
+// CreateUserContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateUserContext(arg0 context.Context, arg1 *quobyte.CreateUserRequest) (*quobyte.CreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserContext indicates an expected call of CreateUserContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateUserContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateUserContext), arg0, arg1)
+}
+
 // CreateVolume mocks base method.
 func (m *MockExtendedQuobyteApi) CreateVolume(arg0 *quobyte.CreateVolumeRequest) (*quobyte.CreateVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +776,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) CreateVolume(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateVolume), arg0)
 }
 
+// CreateVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) CreateVolumeContext(arg0 context.Context, arg1 *quobyte.CreateVolumeRequest) (*quobyte.CreateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeContext indicates an expected call of CreateVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) CreateVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).CreateVolumeContext), arg0, arg1)
+}
+
 // DecideCsr mocks base method.
 func (m *MockExtendedQuobyteApi) DecideCsr(arg0 *quobyte.DecideCsrRequest) (*quobyte.DecideCsrResponse, error) {
 	m.ctrl.T.Helper()
@@ -430,6 +806,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DecideCsr(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideCsr", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DecideCsr), arg0)
 }
 
+// DecideCsrContext mocks base method.
+func (m *MockExtendedQuobyteApi) DecideCsrContext(arg0 context.Context, arg1 *quobyte.DecideCsrRequest) (*quobyte.DecideCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DecideCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideCsrContext indicates an expected call of DecideCsrContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DecideCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideCsrContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DecideCsrContext), arg0, arg1)
+}
+
 // DeleteAccessKeyCredentials mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteAccessKeyCredentials(arg0 *quobyte.DeleteAccessKeyCredentialsRequest) (*quobyte.DeleteAccessKeyCredentialsResponse, error) {
 	m.ctrl.T.Helper()
@@ -445,6 +836,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteAccessKeyCredentials(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessKeyCredentials", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteAccessKeyCredentials), arg0)
 }
 
+// DeleteAccessKeyCredentialsContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteAccessKeyCredentialsContext(arg0 context.Context, arg1 *quobyte.DeleteAccessKeyCredentialsRequest) (*quobyte.DeleteAccessKeyCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccessKeyCredentialsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteAccessKeyCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccessKeyCredentialsContext indicates an expected call of DeleteAccessKeyCredentialsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteAccessKeyCredentialsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessKeyCredentialsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteAccessKeyCredentialsContext), arg0, arg1)
+}
+
 // DeleteCa mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteCa(arg0 *quobyte.DeleteCaRequest) (*quobyte.DeleteCaResponse, error) {
 	m.ctrl.T.Helper()
@@ -460,6 +866,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteCa(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCa", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteCa), arg0)
 }
 
+// DeleteCaContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteCaContext(arg0 context.Context, arg1 *quobyte.DeleteCaRequest) (*quobyte.DeleteCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCaContext indicates an expected call of DeleteCaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteCaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteCaContext), arg0, arg1)
+}
+
 // DeleteCertificate mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteCertificate(arg0 *quobyte.DeleteCertificateRequest) (*quobyte.DeleteCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -475,11 +896,26 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteCertificate(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificate", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteCertificate), arg0)
 }
 
-// DeleteConfiguration mocks base method.
-func (m *MockExtendedQuobyteApi) DeleteConfiguration(arg0 *quobyte.DeleteConfigurationRequest) (*quobyte.DeleteConfigurationResponse, error) {
+// DeleteCertificateContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteCertificateContext(arg0 context.Context, arg1 *quobyte.DeleteCertificateRequest) (*quobyte.DeleteCertificateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteConfiguration", arg0)
-	ret0, _ := ret[0].(*quobyte.DeleteConfigurationResponse)
+	ret := m.ctrl.Call(m, "DeleteCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertificateContext indicates an expected call of DeleteCertificateContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificateContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteCertificateContext), arg0, arg1)
+}
+
+// DeleteConfiguration mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteConfiguration(arg0 *quobyte.DeleteConfigurationRequest) (*quobyte.DeleteConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfiguration", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteConfigurationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -490,6 +926,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteConfiguration(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfiguration", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteConfiguration), arg0)
 }
 
+// DeleteConfigurationContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteConfigurationContext(arg0 context.Context, arg1 *quobyte.DeleteConfigurationRequest) (*quobyte.DeleteConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConfigurationContext indicates an expected call of DeleteConfigurationContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigurationContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteConfigurationContext), arg0, arg1)
+}
+
 // DeleteCsr mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteCsr(arg0 *quobyte.DeleteCsrRequest) (*quobyte.DeleteCsrResponse, error) {
 	m.ctrl.T.Helper()
@@ -505,6 +956,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteCsr(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCsr", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteCsr), arg0)
 }
 
+// DeleteCsrContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteCsrContext(arg0 context.Context, arg1 *quobyte.DeleteCsrRequest) (*quobyte.DeleteCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCsrContext indicates an expected call of DeleteCsrContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCsrContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteCsrContext), arg0, arg1)
+}
+
 // DeleteLabels mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteLabels(arg0 *quobyte.DeleteLabelsRequest) (*quobyte.DeleteLabelsResponse, error) {
 	m.ctrl.T.Helper()
@@ -520,6 +986,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteLabels(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabels", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteLabels), arg0)
 }
 
+// DeleteLabelsContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteLabelsContext(arg0 context.Context, arg1 *quobyte.DeleteLabelsRequest) (*quobyte.DeleteLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabelsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLabelsContext indicates an expected call of DeleteLabelsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteLabelsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabelsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteLabelsContext), arg0, arg1)
+}
+
 // DeleteNotificationRule mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteNotificationRule(arg0 *quobyte.DeleteNotificationRuleRequest) (*quobyte.DeleteNotificationRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -535,6 +1016,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteNotificationRule(arg0 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationRule", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteNotificationRule), arg0)
 }
 
+// DeleteNotificationRuleContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteNotificationRuleContext(arg0 context.Context, arg1 *quobyte.DeleteNotificationRuleRequest) (*quobyte.DeleteNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNotificationRuleContext indicates an expected call of DeleteNotificationRuleContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteNotificationRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationRuleContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteNotificationRuleContext), arg0, arg1)
+}
+
 // DeletePolicyRules mocks base method.
 func (m *MockExtendedQuobyteApi) DeletePolicyRules(arg0 *quobyte.DeletePolicyRulesRequest) (*quobyte.DeletePolicyRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -550,6 +1046,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeletePolicyRules(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeletePolicyRules), arg0)
 }
 
+// DeletePolicyRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeletePolicyRulesContext(arg0 context.Context, arg1 *quobyte.DeletePolicyRulesRequest) (*quobyte.DeletePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeletePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePolicyRulesContext indicates an expected call of DeletePolicyRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeletePolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicyRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeletePolicyRulesContext), arg0, arg1)
+}
+
 // DeleteSnapshot mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteSnapshot(arg0 *quobyte.DeleteSnapshotRequest) (*quobyte.DeleteSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -565,6 +1076,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteSnapshot(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteSnapshot), arg0)
 }
 
+// DeleteSnapshotContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteSnapshotContext(arg0 context.Context, arg1 *quobyte.DeleteSnapshotRequest) (*quobyte.DeleteSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshotContext indicates an expected call of DeleteSnapshotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteSnapshotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteSnapshotContext), arg0, arg1)
+}
+
 // DeleteTenant mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteTenant(arg0 *quobyte.DeleteTenantRequest) (*quobyte.DeleteTenantResponse, error) {
 	m.ctrl.T.Helper()
@@ -580,6 +1106,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteTenant(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteTenant), arg0)
 }
 
+// DeleteTenantContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteTenantContext(arg0 context.Context, arg1 *quobyte.DeleteTenantRequest) (*quobyte.DeleteTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantContext indicates an expected call of DeleteTenantContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteTenantContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteTenantContext), arg0, arg1)
+}
+
 // DeleteUser mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteUser(arg0 *quobyte.DeleteUserRequest) (*quobyte.DeleteUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -595,6 +1136,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteUser(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteUser), arg0)
 }
 
+// DeleteUserContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteUserContext(arg0 context.Context, arg1 *quobyte.DeleteUserRequest) (*quobyte.DeleteUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserContext indicates an expected call of DeleteUserContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteUserContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteUserContext), arg0, arg1)
+}
+
 // DeleteVolume mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteVolume(arg0 *quobyte.DeleteVolumeRequest) (*quobyte.DeleteVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -624,6 +1180,20 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteVolumeByName(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeByName", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteVolumeByName), arg0, arg1)
 }
 
+// DeleteVolumeByNameContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteVolumeByNameContext(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeByNameContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolumeByNameContext indicates an expected call of DeleteVolumeByNameContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteVolumeByNameContext(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeByNameContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteVolumeByNameContext), arg0, arg1, arg2)
+}
+
 // DeleteVolumeByResolvingNamesToUUID mocks base method.
 func (m *MockExtendedQuobyteApi) DeleteVolumeByResolvingNamesToUUID(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -638,6 +1208,35 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeleteVolumeByResolvingNamesToUUID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeByResolvingNamesToUUID", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteVolumeByResolvingNamesToUUID), arg0, arg1)
 }
 
+// DeleteVolumeByResolvingNamesToUUIDContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteVolumeByResolvingNamesToUUIDContext(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeByResolvingNamesToUUIDContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolumeByResolvingNamesToUUIDContext indicates an expected call of DeleteVolumeByResolvingNamesToUUIDContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteVolumeByResolvingNamesToUUIDContext(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeByResolvingNamesToUUIDContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteVolumeByResolvingNamesToUUIDContext), arg0, arg1, arg2)
+}
+
+// DeleteVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeleteVolumeContext(arg0 context.Context, arg1 *quobyte.DeleteVolumeRequest) (*quobyte.DeleteVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolumeContext indicates an expected call of DeleteVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeleteVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeleteVolumeContext), arg0, arg1)
+}
+
 // DeregisterService mocks base method.
 func (m *MockExtendedQuobyteApi) DeregisterService(arg0 *quobyte.DeregisterServiceRequest) (*quobyte.DeregisterServiceResponse, error) {
 	m.ctrl.T.Helper()
@@ -653,6 +1252,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DeregisterService(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterService", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeregisterService), arg0)
 }
 
+// DeregisterServiceContext mocks base method.
+func (m *MockExtendedQuobyteApi) DeregisterServiceContext(arg0 context.Context, arg1 *quobyte.DeregisterServiceRequest) (*quobyte.DeregisterServiceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterServiceContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeregisterServiceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeregisterServiceContext indicates an expected call of DeregisterServiceContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DeregisterServiceContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterServiceContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DeregisterServiceContext), arg0, arg1)
+}
+
 // DisconnectMirroredVolume mocks base method.
 func (m *MockExtendedQuobyteApi) DisconnectMirroredVolume(arg0 *quobyte.DisconnectMirroredVolumeRequest) (*quobyte.DisconnectMirroredVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -668,6 +1282,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DisconnectMirroredVolume(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisconnectMirroredVolume", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DisconnectMirroredVolume), arg0)
 }
 
+// DisconnectMirroredVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) DisconnectMirroredVolumeContext(arg0 context.Context, arg1 *quobyte.DisconnectMirroredVolumeRequest) (*quobyte.DisconnectMirroredVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisconnectMirroredVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DisconnectMirroredVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisconnectMirroredVolumeContext indicates an expected call of DisconnectMirroredVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DisconnectMirroredVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisconnectMirroredVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DisconnectMirroredVolumeContext), arg0, arg1)
+}
+
 // DumpEffectivePolicyRules mocks base method.
 func (m *MockExtendedQuobyteApi) DumpEffectivePolicyRules(arg0 *quobyte.DumpEffectivePolicyRulesRequest) (*quobyte.DumpEffectivePolicyRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +1312,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DumpEffectivePolicyRules(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpEffectivePolicyRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DumpEffectivePolicyRules), arg0)
 }
 
+// DumpEffectivePolicyRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) DumpEffectivePolicyRulesContext(arg0 context.Context, arg1 *quobyte.DumpEffectivePolicyRulesRequest) (*quobyte.DumpEffectivePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpEffectivePolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DumpEffectivePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpEffectivePolicyRulesContext indicates an expected call of DumpEffectivePolicyRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DumpEffectivePolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpEffectivePolicyRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DumpEffectivePolicyRulesContext), arg0, arg1)
+}
+
 // DumpPolicyPresets mocks base method.
 func (m *MockExtendedQuobyteApi) DumpPolicyPresets(arg0 *quobyte.DumpPolicyPresetsRequest) (*quobyte.DumpPolicyPresetsResponse, error) {
 	m.ctrl.T.Helper()
@@ -698,6 +1342,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) DumpPolicyPresets(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpPolicyPresets", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DumpPolicyPresets), arg0)
 }
 
+// DumpPolicyPresetsContext mocks base method.
+func (m *MockExtendedQuobyteApi) DumpPolicyPresetsContext(arg0 context.Context, arg1 *quobyte.DumpPolicyPresetsRequest) (*quobyte.DumpPolicyPresetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpPolicyPresetsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DumpPolicyPresetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpPolicyPresetsContext indicates an expected call of DumpPolicyPresetsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) DumpPolicyPresetsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpPolicyPresetsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).DumpPolicyPresetsContext), arg0, arg1)
+}
+
 // EraseSnapshot mocks base method.
 func (m *MockExtendedQuobyteApi) EraseSnapshot(arg0 *quobyte.EraseSnapshotRequest) (*quobyte.EraseSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -713,6 +1372,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) EraseSnapshot(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseSnapshot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).EraseSnapshot), arg0)
 }
 
+// EraseSnapshotContext mocks base method.
+func (m *MockExtendedQuobyteApi) EraseSnapshotContext(arg0 context.Context, arg1 *quobyte.EraseSnapshotRequest) (*quobyte.EraseSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseSnapshotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.EraseSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseSnapshotContext indicates an expected call of EraseSnapshotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) EraseSnapshotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseSnapshotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).EraseSnapshotContext), arg0, arg1)
+}
+
 // EraseVolume mocks base method.
 func (m *MockExtendedQuobyteApi) EraseVolume(arg0 *quobyte.EraseVolumeRequest) (*quobyte.EraseVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -742,6 +1416,20 @@ func (mr *MockExtendedQuobyteApiMockRecorder) EraseVolumeByResolvingNamesToUUID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVolumeByResolvingNamesToUUID", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).EraseVolumeByResolvingNamesToUUID), arg0, arg1, arg2)
 }
 
+// EraseVolumeByResolvingNamesToUUIDContext mocks base method.
+func (m *MockExtendedQuobyteApi) EraseVolumeByResolvingNamesToUUIDContext(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseVolumeByResolvingNamesToUUIDContext", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseVolumeByResolvingNamesToUUIDContext indicates an expected call of EraseVolumeByResolvingNamesToUUIDContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) EraseVolumeByResolvingNamesToUUIDContext(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVolumeByResolvingNamesToUUIDContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).EraseVolumeByResolvingNamesToUUIDContext), arg0, arg1, arg2, arg3)
+}
+
 // EraseVolumeByResolvingNamesToUUID_2X mocks base method.
 func (m *MockExtendedQuobyteApi) EraseVolumeByResolvingNamesToUUID_2X(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -756,6 +1444,35 @@ func (mr *MockExtendedQuobyteApiMockRecorder) EraseVolumeByResolvingNamesToUUID_
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVolumeByResolvingNamesToUUID_2X", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).EraseVolumeByResolvingNamesToUUID_2X), arg0, arg1)
 }
 
+// EraseVolumeByResolvingNamesToUUID_2XContext mocks base method.
+func (m *MockExtendedQuobyteApi) EraseVolumeByResolvingNamesToUUID_2XContext(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseVolumeByResolvingNamesToUUID_2XContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseVolumeByResolvingNamesToUUID_2XContext indicates an expected call of EraseVolumeByResolvingNamesToUUID_2XContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) EraseVolumeByResolvingNamesToUUID_2XContext(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVolumeByResolvingNamesToUUID_2XContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).EraseVolumeByResolvingNamesToUUID_2XContext), arg0, arg1, arg2)
+}
+
+// EraseVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) EraseVolumeContext(arg0 context.Context, arg1 *quobyte.EraseVolumeRequest) (*quobyte.EraseVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.EraseVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseVolumeContext indicates an expected call of EraseVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) EraseVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).EraseVolumeContext), arg0, arg1)
+}
+
 // ExportCertificate mocks base method.
 func (m *MockExtendedQuobyteApi) ExportCertificate(arg0 *quobyte.ExportCertificateRequest) (*quobyte.ExportCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -771,6 +1488,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ExportCertificate(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCertificate", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportCertificate), arg0)
 }
 
+// ExportCertificateContext mocks base method.
+func (m *MockExtendedQuobyteApi) ExportCertificateContext(arg0 context.Context, arg1 *quobyte.ExportCertificateRequest) (*quobyte.ExportCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCertificateContext indicates an expected call of ExportCertificateContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ExportCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCertificateContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportCertificateContext), arg0, arg1)
+}
+
 // ExportConfiguration mocks base method.
 func (m *MockExtendedQuobyteApi) ExportConfiguration(arg0 *quobyte.ExportConfigurationRequest) (*quobyte.ExportConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -786,6 +1518,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ExportConfiguration(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportConfiguration", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportConfiguration), arg0)
 }
 
+// ExportConfigurationContext mocks base method.
+func (m *MockExtendedQuobyteApi) ExportConfigurationContext(arg0 context.Context, arg1 *quobyte.ExportConfigurationRequest) (*quobyte.ExportConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportConfigurationContext indicates an expected call of ExportConfigurationContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ExportConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportConfigurationContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportConfigurationContext), arg0, arg1)
+}
+
 // ExportPolicyRules mocks base method.
 func (m *MockExtendedQuobyteApi) ExportPolicyRules(arg0 *quobyte.ExportPolicyRulesRequest) (*quobyte.ExportPolicyRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -801,6 +1548,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ExportPolicyRules(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPolicyRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportPolicyRules), arg0)
 }
 
+// ExportPolicyRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) ExportPolicyRulesContext(arg0 context.Context, arg1 *quobyte.ExportPolicyRulesRequest) (*quobyte.ExportPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPolicyRulesContext indicates an expected call of ExportPolicyRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ExportPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPolicyRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportPolicyRulesContext), arg0, arg1)
+}
+
 // ExportVolume mocks base method.
 func (m *MockExtendedQuobyteApi) ExportVolume(arg0 *quobyte.ExportVolumeRequest) (*quobyte.ExportVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -816,6 +1578,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ExportVolume(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVolume", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportVolume), arg0)
 }
 
+// ExportVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) ExportVolumeContext(arg0 context.Context, arg1 *quobyte.ExportVolumeRequest) (*quobyte.ExportVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportVolumeContext indicates an expected call of ExportVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ExportVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ExportVolumeContext), arg0, arg1)
+}
+
 // FilterPolicyRules mocks base method.
 func (m *MockExtendedQuobyteApi) FilterPolicyRules(arg0 *quobyte.FilterPolicyRulesRequest) (*quobyte.FilterPolicyRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -831,6 +1608,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) FilterPolicyRules(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterPolicyRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).FilterPolicyRules), arg0)
 }
 
+// FilterPolicyRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) FilterPolicyRulesContext(arg0 context.Context, arg1 *quobyte.FilterPolicyRulesRequest) (*quobyte.FilterPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.FilterPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterPolicyRulesContext indicates an expected call of FilterPolicyRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) FilterPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterPolicyRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).FilterPolicyRulesContext), arg0, arg1)
+}
+
 // GenerateAsyncSupportDump mocks base method.
 func (m *MockExtendedQuobyteApi) GenerateAsyncSupportDump(arg0 *quobyte.GenerateAsyncSupportDumpRequest) (*quobyte.GenerateAsyncSupportDumpResponse, error) {
 	m.ctrl.T.Helper()
@@ -846,6 +1638,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GenerateAsyncSupportDump(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAsyncSupportDump", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GenerateAsyncSupportDump), arg0)
 }
 
+// GenerateAsyncSupportDumpContext mocks base method.
+func (m *MockExtendedQuobyteApi) GenerateAsyncSupportDumpContext(arg0 context.Context, arg1 *quobyte.GenerateAsyncSupportDumpRequest) (*quobyte.GenerateAsyncSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAsyncSupportDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GenerateAsyncSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateAsyncSupportDumpContext indicates an expected call of GenerateAsyncSupportDumpContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GenerateAsyncSupportDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAsyncSupportDumpContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GenerateAsyncSupportDumpContext), arg0, arg1)
+}
+
 // GetAPIRetryPolicy mocks base method.
 func (m *MockExtendedQuobyteApi) GetAPIRetryPolicy() string {
 	m.ctrl.T.Helper()
@@ -875,6 +1682,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetAccounting(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounting", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAccounting), arg0)
 }
 
+// GetAccountingContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetAccountingContext(arg0 context.Context, arg1 *quobyte.GetAccountingRequest) (*quobyte.GetAccountingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountingContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAccountingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountingContext indicates an expected call of GetAccountingContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetAccountingContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountingContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAccountingContext), arg0, arg1)
+}
+
 // GetAddKeySlotData mocks base method.
 func (m *MockExtendedQuobyteApi) GetAddKeySlotData(arg0 *quobyte.GetAddKeySlotDataRequest) (*quobyte.GetAddKeySlotDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -890,6 +1712,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetAddKeySlotData(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddKeySlotData", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAddKeySlotData), arg0)
 }
 
+// GetAddKeySlotDataContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetAddKeySlotDataContext(arg0 context.Context, arg1 *quobyte.GetAddKeySlotDataRequest) (*quobyte.GetAddKeySlotDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddKeySlotDataContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAddKeySlotDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddKeySlotDataContext indicates an expected call of GetAddKeySlotDataContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetAddKeySlotDataContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddKeySlotDataContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAddKeySlotDataContext), arg0, arg1)
+}
+
 // GetAnalyzeReports mocks base method.
 func (m *MockExtendedQuobyteApi) GetAnalyzeReports(arg0 *quobyte.GetAnalyzeReportsRequest) (*quobyte.GetAnalyzeReportsResponse, error) {
 	m.ctrl.T.Helper()
@@ -905,11 +1742,26 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetAnalyzeReports(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyzeReports", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAnalyzeReports), arg0)
 }
 
-// GetAuditLog mocks base method.
-func (m *MockExtendedQuobyteApi) GetAuditLog(arg0 *quobyte.GetAuditLogRequest) (*quobyte.GetAuditLogResponse, error) {
+// GetAnalyzeReportsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetAnalyzeReportsContext(arg0 context.Context, arg1 *quobyte.GetAnalyzeReportsRequest) (*quobyte.GetAnalyzeReportsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuditLog", arg0)
-	ret0, _ := ret[0].(*quobyte.GetAuditLogResponse)
+	ret := m.ctrl.Call(m, "GetAnalyzeReportsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAnalyzeReportsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalyzeReportsContext indicates an expected call of GetAnalyzeReportsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetAnalyzeReportsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyzeReportsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAnalyzeReportsContext), arg0, arg1)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockExtendedQuobyteApi) GetAuditLog(arg0 *quobyte.GetAuditLogRequest) (*quobyte.GetAuditLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", arg0)
+	ret0, _ := ret[0].(*quobyte.GetAuditLogResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -920,6 +1772,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetAuditLog(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAuditLog), arg0)
 }
 
+// GetAuditLogContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetAuditLogContext(arg0 context.Context, arg1 *quobyte.GetAuditLogRequest) (*quobyte.GetAuditLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLogContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAuditLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLogContext indicates an expected call of GetAuditLogContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetAuditLogContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLogContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetAuditLogContext), arg0, arg1)
+}
+
 // GetCertificateSubject mocks base method.
 func (m *MockExtendedQuobyteApi) GetCertificateSubject(arg0 *quobyte.GetCertificateSubjectRequest) (*quobyte.GetCertificateSubjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -935,6 +1802,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetCertificateSubject(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateSubject", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetCertificateSubject), arg0)
 }
 
+// GetCertificateSubjectContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetCertificateSubjectContext(arg0 context.Context, arg1 *quobyte.GetCertificateSubjectRequest) (*quobyte.GetCertificateSubjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateSubjectContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetCertificateSubjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateSubjectContext indicates an expected call of GetCertificateSubjectContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetCertificateSubjectContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateSubjectContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetCertificateSubjectContext), arg0, arg1)
+}
+
 // GetClientList mocks base method.
 func (m *MockExtendedQuobyteApi) GetClientList(arg0 *quobyte.GetClientListRequest) (*quobyte.GetClientListResponse, error) {
 	m.ctrl.T.Helper()
@@ -950,6 +1832,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetClientList(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientList", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetClientList), arg0)
 }
 
+// GetClientListContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetClientListContext(arg0 context.Context, arg1 *quobyte.GetClientListRequest) (*quobyte.GetClientListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetClientListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientListContext indicates an expected call of GetClientListContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetClientListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientListContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetClientListContext), arg0, arg1)
+}
+
 // GetConfiguration mocks base method.
 func (m *MockExtendedQuobyteApi) GetConfiguration(arg0 *quobyte.GetConfigurationRequest) (*quobyte.GetConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -965,6 +1862,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetConfiguration(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfiguration", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetConfiguration), arg0)
 }
 
+// GetConfigurationContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetConfigurationContext(arg0 context.Context, arg1 *quobyte.GetConfigurationRequest) (*quobyte.GetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfigurationContext indicates an expected call of GetConfigurationContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigurationContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetConfigurationContext), arg0, arg1)
+}
+
 // GetDefaultKeyStoreSlotParams mocks base method.
 func (m *MockExtendedQuobyteApi) GetDefaultKeyStoreSlotParams(arg0 *quobyte.GetDefaultKeyStoreSlotParamsRequest) (*quobyte.GetDefaultKeyStoreSlotParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -980,6 +1892,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetDefaultKeyStoreSlotParams(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultKeyStoreSlotParams", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDefaultKeyStoreSlotParams), arg0)
 }
 
+// GetDefaultKeyStoreSlotParamsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetDefaultKeyStoreSlotParamsContext(arg0 context.Context, arg1 *quobyte.GetDefaultKeyStoreSlotParamsRequest) (*quobyte.GetDefaultKeyStoreSlotParamsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultKeyStoreSlotParamsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDefaultKeyStoreSlotParamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultKeyStoreSlotParamsContext indicates an expected call of GetDefaultKeyStoreSlotParamsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetDefaultKeyStoreSlotParamsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultKeyStoreSlotParamsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDefaultKeyStoreSlotParamsContext), arg0, arg1)
+}
+
 // GetDeviceGroups mocks base method.
 func (m *MockExtendedQuobyteApi) GetDeviceGroups(arg0 *quobyte.GetDeviceGroupsRequest) (*quobyte.GetDeviceGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -995,6 +1922,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceGroups(arg0 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceGroups", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceGroups), arg0)
 }
 
+// GetDeviceGroupsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetDeviceGroupsContext(arg0 context.Context, arg1 *quobyte.GetDeviceGroupsRequest) (*quobyte.GetDeviceGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceGroupsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceGroupsContext indicates an expected call of GetDeviceGroupsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceGroupsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceGroupsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceGroupsContext), arg0, arg1)
+}
+
 // GetDeviceIds mocks base method.
 func (m *MockExtendedQuobyteApi) GetDeviceIds(arg0 *quobyte.GetDeviceIdsRequest) (*quobyte.GetDeviceIdsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1010,6 +1952,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceIds(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceIds", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceIds), arg0)
 }
 
+// GetDeviceIdsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetDeviceIdsContext(arg0 context.Context, arg1 *quobyte.GetDeviceIdsRequest) (*quobyte.GetDeviceIdsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceIdsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceIdsContext indicates an expected call of GetDeviceIdsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceIdsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceIdsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceIdsContext), arg0, arg1)
+}
+
 // GetDeviceList mocks base method.
 func (m *MockExtendedQuobyteApi) GetDeviceList(arg0 *quobyte.GetDeviceListRequest) (*quobyte.GetDeviceListResponse, error) {
 	m.ctrl.T.Helper()
@@ -1025,6 +1982,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceList(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceList", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceList), arg0)
 }
 
+// GetDeviceListContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetDeviceListContext(arg0 context.Context, arg1 *quobyte.GetDeviceListRequest) (*quobyte.GetDeviceListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceListContext indicates an expected call of GetDeviceListContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceListContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceListContext), arg0, arg1)
+}
+
 // GetDeviceNetworkEndpoints mocks base method.
 func (m *MockExtendedQuobyteApi) GetDeviceNetworkEndpoints(arg0 *quobyte.GetDeviceNetworkEndpointsRequest) (*quobyte.GetDeviceNetworkEndpointsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1040,6 +2012,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceNetworkEndpoints(arg0 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceNetworkEndpoints", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceNetworkEndpoints), arg0)
 }
 
+// GetDeviceNetworkEndpointsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetDeviceNetworkEndpointsContext(arg0 context.Context, arg1 *quobyte.GetDeviceNetworkEndpointsRequest) (*quobyte.GetDeviceNetworkEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceNetworkEndpointsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceNetworkEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceNetworkEndpointsContext indicates an expected call of GetDeviceNetworkEndpointsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceNetworkEndpointsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceNetworkEndpointsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceNetworkEndpointsContext), arg0, arg1)
+}
+
 // GetDeviceTags mocks base method.
 func (m *MockExtendedQuobyteApi) GetDeviceTags(arg0 *quobyte.GetDeviceTagsRequest) (*quobyte.GetDeviceTagsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1055,6 +2042,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceTags(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceTags", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceTags), arg0)
 }
 
+// GetDeviceTagsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetDeviceTagsContext(arg0 context.Context, arg1 *quobyte.GetDeviceTagsRequest) (*quobyte.GetDeviceTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceTagsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDeviceTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceTagsContext indicates an expected call of GetDeviceTagsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetDeviceTagsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceTagsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetDeviceTagsContext), arg0, arg1)
+}
+
 // GetEffectiveVolumeConfiguration mocks base method.
 func (m *MockExtendedQuobyteApi) GetEffectiveVolumeConfiguration(arg0 *quobyte.GetEffectiveVolumeConfigurationRequest) (*quobyte.GetEffectiveVolumeConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1070,6 +2072,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetEffectiveVolumeConfiguration(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveVolumeConfiguration", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetEffectiveVolumeConfiguration), arg0)
 }
 
+// GetEffectiveVolumeConfigurationContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetEffectiveVolumeConfigurationContext(arg0 context.Context, arg1 *quobyte.GetEffectiveVolumeConfigurationRequest) (*quobyte.GetEffectiveVolumeConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveVolumeConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetEffectiveVolumeConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveVolumeConfigurationContext indicates an expected call of GetEffectiveVolumeConfigurationContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetEffectiveVolumeConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveVolumeConfigurationContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetEffectiveVolumeConfigurationContext), arg0, arg1)
+}
+
 // GetEncryptStatus mocks base method.
 func (m *MockExtendedQuobyteApi) GetEncryptStatus(arg0 *quobyte.GetEncryptStatusRequest) (*quobyte.GetEncryptStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -1085,6 +2102,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetEncryptStatus(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptStatus", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetEncryptStatus), arg0)
 }
 
+// GetEncryptStatusContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetEncryptStatusContext(arg0 context.Context, arg1 *quobyte.GetEncryptStatusRequest) (*quobyte.GetEncryptStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptStatusContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetEncryptStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptStatusContext indicates an expected call of GetEncryptStatusContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetEncryptStatusContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptStatusContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetEncryptStatusContext), arg0, arg1)
+}
+
 // GetEncryptedVolumeKey mocks base method.
 func (m *MockExtendedQuobyteApi) GetEncryptedVolumeKey(arg0 *quobyte.GetEncryptedVolumeKeyRequest) (*quobyte.GetEncryptedVolumeKeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1100,6 +2132,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetEncryptedVolumeKey(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptedVolumeKey", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetEncryptedVolumeKey), arg0)
 }
 
+// GetEncryptedVolumeKeyContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetEncryptedVolumeKeyContext(arg0 context.Context, arg1 *quobyte.GetEncryptedVolumeKeyRequest) (*quobyte.GetEncryptedVolumeKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptedVolumeKeyContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetEncryptedVolumeKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptedVolumeKeyContext indicates an expected call of GetEncryptedVolumeKeyContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetEncryptedVolumeKeyContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptedVolumeKeyContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetEncryptedVolumeKeyContext), arg0, arg1)
+}
+
 // GetFileMetadataDump mocks base method.
 func (m *MockExtendedQuobyteApi) GetFileMetadataDump(arg0 *quobyte.GetFileMetadataDumpRequest) (*quobyte.GetFileMetadataDumpResponse, error) {
 	m.ctrl.T.Helper()
@@ -1115,6 +2162,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetFileMetadataDump(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileMetadataDump", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetFileMetadataDump), arg0)
 }
 
+// GetFileMetadataDumpContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetFileMetadataDumpContext(arg0 context.Context, arg1 *quobyte.GetFileMetadataDumpRequest) (*quobyte.GetFileMetadataDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileMetadataDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetFileMetadataDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileMetadataDumpContext indicates an expected call of GetFileMetadataDumpContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetFileMetadataDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileMetadataDumpContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetFileMetadataDumpContext), arg0, arg1)
+}
+
 // GetFiringRules mocks base method.
 func (m *MockExtendedQuobyteApi) GetFiringRules(arg0 *quobyte.GetFiringRulesRequest) (*quobyte.GetFiringRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1130,6 +2192,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetFiringRules(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiringRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetFiringRules), arg0)
 }
 
+// GetFiringRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetFiringRulesContext(arg0 context.Context, arg1 *quobyte.GetFiringRulesRequest) (*quobyte.GetFiringRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiringRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetFiringRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiringRulesContext indicates an expected call of GetFiringRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetFiringRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiringRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetFiringRulesContext), arg0, arg1)
+}
+
 // GetHealthManagerStatus mocks base method.
 func (m *MockExtendedQuobyteApi) GetHealthManagerStatus(arg0 *quobyte.GetHealthManagerStatusRequest) (*quobyte.GetHealthManagerStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -1145,6 +2222,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetHealthManagerStatus(arg0 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthManagerStatus", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetHealthManagerStatus), arg0)
 }
 
+// GetHealthManagerStatusContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetHealthManagerStatusContext(arg0 context.Context, arg1 *quobyte.GetHealthManagerStatusRequest) (*quobyte.GetHealthManagerStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealthManagerStatusContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetHealthManagerStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealthManagerStatusContext indicates an expected call of GetHealthManagerStatusContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetHealthManagerStatusContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthManagerStatusContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetHealthManagerStatusContext), arg0, arg1)
+}
+
 // GetInformation mocks base method.
 func (m *MockExtendedQuobyteApi) GetInformation(arg0 *quobyte.GetInformationRequest) (*quobyte.GetInformationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1160,6 +2252,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetInformation(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInformation", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetInformation), arg0)
 }
 
+// GetInformationContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetInformationContext(arg0 context.Context, arg1 *quobyte.GetInformationRequest) (*quobyte.GetInformationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInformationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetInformationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInformationContext indicates an expected call of GetInformationContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetInformationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInformationContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetInformationContext), arg0, arg1)
+}
+
 // GetKeyStoreSlotWithoutHash mocks base method.
 func (m *MockExtendedQuobyteApi) GetKeyStoreSlotWithoutHash(arg0 *quobyte.GetKeyStoreSlotWithoutHashRequest) (*quobyte.GetKeyStoreSlotWithoutHashResponse, error) {
 	m.ctrl.T.Helper()
@@ -1175,6 +2282,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetKeyStoreSlotWithoutHash(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyStoreSlotWithoutHash", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetKeyStoreSlotWithoutHash), arg0)
 }
 
+// GetKeyStoreSlotWithoutHashContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetKeyStoreSlotWithoutHashContext(arg0 context.Context, arg1 *quobyte.GetKeyStoreSlotWithoutHashRequest) (*quobyte.GetKeyStoreSlotWithoutHashResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyStoreSlotWithoutHashContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetKeyStoreSlotWithoutHashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyStoreSlotWithoutHashContext indicates an expected call of GetKeyStoreSlotWithoutHashContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetKeyStoreSlotWithoutHashContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyStoreSlotWithoutHashContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetKeyStoreSlotWithoutHashContext), arg0, arg1)
+}
+
 // GetLabels mocks base method.
 func (m *MockExtendedQuobyteApi) GetLabels(arg0 *quobyte.GetLabelsRequest) (*quobyte.GetLabelsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1190,6 +2312,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetLabels(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabels", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetLabels), arg0)
 }
 
+// GetLabelsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetLabelsContext(arg0 context.Context, arg1 *quobyte.GetLabelsRequest) (*quobyte.GetLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelsContext indicates an expected call of GetLabelsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetLabelsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetLabelsContext), arg0, arg1)
+}
+
 // GetLatestEvent mocks base method.
 func (m *MockExtendedQuobyteApi) GetLatestEvent(arg0 *quobyte.GetLatestEventRequest) (*quobyte.GetLatestEventResponse, error) {
 	m.ctrl.T.Helper()
@@ -1205,6 +2342,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetLatestEvent(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEvent", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetLatestEvent), arg0)
 }
 
+// GetLatestEventContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetLatestEventContext(arg0 context.Context, arg1 *quobyte.GetLatestEventRequest) (*quobyte.GetLatestEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEventContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetLatestEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEventContext indicates an expected call of GetLatestEventContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetLatestEventContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEventContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetLatestEventContext), arg0, arg1)
+}
+
 // GetLicense mocks base method.
 func (m *MockExtendedQuobyteApi) GetLicense(arg0 *quobyte.GetLicenseRequest) (*quobyte.GetLicenseResponse, error) {
 	m.ctrl.T.Helper()
@@ -1220,6 +2372,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetLicense(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicense", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetLicense), arg0)
 }
 
+// GetLicenseContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetLicenseContext(arg0 context.Context, arg1 *quobyte.GetLicenseRequest) (*quobyte.GetLicenseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLicenseContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetLicenseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLicenseContext indicates an expected call of GetLicenseContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetLicenseContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLicenseContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetLicenseContext), arg0, arg1)
+}
+
 // GetMasterKeystoreSlots mocks base method.
 func (m *MockExtendedQuobyteApi) GetMasterKeystoreSlots(arg0 *quobyte.GetMasterKeystoreSlotsRequest) (*quobyte.GetMasterKeystoreSlotsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1235,6 +2402,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetMasterKeystoreSlots(arg0 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterKeystoreSlots", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetMasterKeystoreSlots), arg0)
 }
 
+// GetMasterKeystoreSlotsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetMasterKeystoreSlotsContext(arg0 context.Context, arg1 *quobyte.GetMasterKeystoreSlotsRequest) (*quobyte.GetMasterKeystoreSlotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterKeystoreSlotsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetMasterKeystoreSlotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterKeystoreSlotsContext indicates an expected call of GetMasterKeystoreSlotsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetMasterKeystoreSlotsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterKeystoreSlotsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetMasterKeystoreSlotsContext), arg0, arg1)
+}
+
 // GetNetworkTestResult mocks base method.
 func (m *MockExtendedQuobyteApi) GetNetworkTestResult(arg0 *quobyte.GetNetworkTestResultRequest) (*quobyte.GetNetworkTestResultResponse, error) {
 	m.ctrl.T.Helper()
@@ -1250,6 +2432,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetNetworkTestResult(arg0 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkTestResult", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetNetworkTestResult), arg0)
 }
 
+// GetNetworkTestResultContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetNetworkTestResultContext(arg0 context.Context, arg1 *quobyte.GetNetworkTestResultRequest) (*quobyte.GetNetworkTestResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkTestResultContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetNetworkTestResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkTestResultContext indicates an expected call of GetNetworkTestResultContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetNetworkTestResultContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkTestResultContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetNetworkTestResultContext), arg0, arg1)
+}
+
 // GetNotificationRules mocks base method.
 func (m *MockExtendedQuobyteApi) GetNotificationRules(arg0 *quobyte.GetNotificationRulesRequest) (*quobyte.GetNotificationRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1265,6 +2462,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetNotificationRules(arg0 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetNotificationRules), arg0)
 }
 
+// GetNotificationRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetNotificationRulesContext(arg0 context.Context, arg1 *quobyte.GetNotificationRulesRequest) (*quobyte.GetNotificationRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetNotificationRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationRulesContext indicates an expected call of GetNotificationRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetNotificationRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetNotificationRulesContext), arg0, arg1)
+}
+
 // GetPolicyPresets mocks base method.
 func (m *MockExtendedQuobyteApi) GetPolicyPresets(arg0 *quobyte.GetPolicyPresetsRequest) (*quobyte.GetPolicyPresetsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1280,6 +2492,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetPolicyPresets(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyPresets", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetPolicyPresets), arg0)
 }
 
+// GetPolicyPresetsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetPolicyPresetsContext(arg0 context.Context, arg1 *quobyte.GetPolicyPresetsRequest) (*quobyte.GetPolicyPresetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyPresetsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetPolicyPresetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyPresetsContext indicates an expected call of GetPolicyPresetsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetPolicyPresetsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyPresetsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetPolicyPresetsContext), arg0, arg1)
+}
+
 // GetPolicyRuleSets mocks base method.
 func (m *MockExtendedQuobyteApi) GetPolicyRuleSets(arg0 *quobyte.GetPolicyRuleSetsRequest) (*quobyte.GetPolicyRuleSetsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1295,6 +2522,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetPolicyRuleSets(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRuleSets", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetPolicyRuleSets), arg0)
 }
 
+// GetPolicyRuleSetsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetPolicyRuleSetsContext(arg0 context.Context, arg1 *quobyte.GetPolicyRuleSetsRequest) (*quobyte.GetPolicyRuleSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyRuleSetsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetPolicyRuleSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyRuleSetsContext indicates an expected call of GetPolicyRuleSetsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetPolicyRuleSetsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRuleSetsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetPolicyRuleSetsContext), arg0, arg1)
+}
+
 // GetPolicyRules mocks base method.
 func (m *MockExtendedQuobyteApi) GetPolicyRules(arg0 *quobyte.GetPolicyRulesRequest) (*quobyte.GetPolicyRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1310,6 +2552,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetPolicyRules(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetPolicyRules), arg0)
 }
 
+// GetPolicyRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetPolicyRulesContext(arg0 context.Context, arg1 *quobyte.GetPolicyRulesRequest) (*quobyte.GetPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyRulesContext indicates an expected call of GetPolicyRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetPolicyRulesContext), arg0, arg1)
+}
+
 // GetQueryProgress mocks base method.
 func (m *MockExtendedQuobyteApi) GetQueryProgress(arg0 *quobyte.GetQueryProgressRequest) (*quobyte.GetQueryProgressResponse, error) {
 	m.ctrl.T.Helper()
@@ -1325,6 +2582,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetQueryProgress(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryProgress", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetQueryProgress), arg0)
 }
 
+// GetQueryProgressContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetQueryProgressContext(arg0 context.Context, arg1 *quobyte.GetQueryProgressRequest) (*quobyte.GetQueryProgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryProgressContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetQueryProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryProgressContext indicates an expected call of GetQueryProgressContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetQueryProgressContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryProgressContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetQueryProgressContext), arg0, arg1)
+}
+
 // GetQuota mocks base method.
 func (m *MockExtendedQuobyteApi) GetQuota(arg0 *quobyte.GetQuotaRequest) (*quobyte.GetQuotaResponse, error) {
 	m.ctrl.T.Helper()
@@ -1340,6 +2612,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetQuota(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetQuota), arg0)
 }
 
+// GetQuotaContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetQuotaContext(arg0 context.Context, arg1 *quobyte.GetQuotaRequest) (*quobyte.GetQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaContext indicates an expected call of GetQuotaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetQuotaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetQuotaContext), arg0, arg1)
+}
+
 // GetRules mocks base method.
 func (m *MockExtendedQuobyteApi) GetRules(arg0 *quobyte.GetRulesRequest) (*quobyte.GetRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1355,6 +2642,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetRules(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetRules), arg0)
 }
 
+// GetRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetRulesContext(arg0 context.Context, arg1 *quobyte.GetRulesRequest) (*quobyte.GetRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRulesContext indicates an expected call of GetRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetRulesContext), arg0, arg1)
+}
+
 // GetServiceDump mocks base method.
 func (m *MockExtendedQuobyteApi) GetServiceDump(arg0 *quobyte.GetServiceDumpRequest) (*quobyte.GetServiceDumpResponse, error) {
 	m.ctrl.T.Helper()
@@ -1370,6 +2672,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetServiceDump(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceDump", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetServiceDump), arg0)
 }
 
+// GetServiceDumpContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetServiceDumpContext(arg0 context.Context, arg1 *quobyte.GetServiceDumpRequest) (*quobyte.GetServiceDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetServiceDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceDumpContext indicates an expected call of GetServiceDumpContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetServiceDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceDumpContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetServiceDumpContext), arg0, arg1)
+}
+
 // GetServices mocks base method.
 func (m *MockExtendedQuobyteApi) GetServices(arg0 *quobyte.GetServicesRequest) (*quobyte.GetServicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1385,6 +2702,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetServices(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServices", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetServices), arg0)
 }
 
+// GetServicesContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetServicesContext(arg0 context.Context, arg1 *quobyte.GetServicesRequest) (*quobyte.GetServicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServicesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetServicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServicesContext indicates an expected call of GetServicesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetServicesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServicesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetServicesContext), arg0, arg1)
+}
+
 // GetSupportDump mocks base method.
 func (m *MockExtendedQuobyteApi) GetSupportDump(arg0 *quobyte.GetSupportDumpRequest) (*quobyte.GetSupportDumpResponse, error) {
 	m.ctrl.T.Helper()
@@ -1400,6 +2732,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetSupportDump(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDump", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetSupportDump), arg0)
 }
 
+// GetSupportDumpContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetSupportDumpContext(arg0 context.Context, arg1 *quobyte.GetSupportDumpRequest) (*quobyte.GetSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportDumpContext indicates an expected call of GetSupportDumpContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetSupportDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDumpContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetSupportDumpContext), arg0, arg1)
+}
+
 // GetSupportDumpStatus mocks base method.
 func (m *MockExtendedQuobyteApi) GetSupportDumpStatus(arg0 *quobyte.GetSupportDumpStatusRequest) (*quobyte.GetSupportDumpStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -1415,6 +2762,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetSupportDumpStatus(arg0 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDumpStatus", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetSupportDumpStatus), arg0)
 }
 
+// GetSupportDumpStatusContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetSupportDumpStatusContext(arg0 context.Context, arg1 *quobyte.GetSupportDumpStatusRequest) (*quobyte.GetSupportDumpStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportDumpStatusContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetSupportDumpStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportDumpStatusContext indicates an expected call of GetSupportDumpStatusContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetSupportDumpStatusContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDumpStatusContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetSupportDumpStatusContext), arg0, arg1)
+}
+
 // GetSystemStatistics mocks base method.
 func (m *MockExtendedQuobyteApi) GetSystemStatistics(arg0 *quobyte.GetSystemStatisticsRequest) (*quobyte.GetSystemStatisticsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1430,6 +2792,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetSystemStatistics(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemStatistics", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetSystemStatistics), arg0)
 }
 
+// GetSystemStatisticsContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetSystemStatisticsContext(arg0 context.Context, arg1 *quobyte.GetSystemStatisticsRequest) (*quobyte.GetSystemStatisticsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSystemStatisticsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetSystemStatisticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemStatisticsContext indicates an expected call of GetSystemStatisticsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetSystemStatisticsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemStatisticsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetSystemStatisticsContext), arg0, arg1)
+}
+
 // GetTaskList mocks base method.
 func (m *MockExtendedQuobyteApi) GetTaskList(arg0 *quobyte.GetTaskListRequest) (*quobyte.GetTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -1445,6 +2822,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetTaskList(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskList", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTaskList), arg0)
 }
 
+// GetTaskListContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetTaskListContext(arg0 context.Context, arg1 *quobyte.GetTaskListRequest) (*quobyte.GetTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListContext indicates an expected call of GetTaskListContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetTaskListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTaskListContext), arg0, arg1)
+}
+
 // GetTenant mocks base method.
 func (m *MockExtendedQuobyteApi) GetTenant(arg0 *quobyte.GetTenantRequest) (*quobyte.GetTenantResponse, error) {
 	m.ctrl.T.Helper()
@@ -1460,6 +2852,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetTenant(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenant", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTenant), arg0)
 }
 
+// GetTenantContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetTenantContext(arg0 context.Context, arg1 *quobyte.GetTenantRequest) (*quobyte.GetTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantContext indicates an expected call of GetTenantContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetTenantContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTenantContext), arg0, arg1)
+}
+
 // GetTenantMap mocks base method.
 func (m *MockExtendedQuobyteApi) GetTenantMap() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -1475,6 +2882,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetTenantMap() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantMap", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTenantMap))
 }
 
+// GetTenantMapContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetTenantMapContext(arg0 context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantMapContext", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantMapContext indicates an expected call of GetTenantMapContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetTenantMapContext(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantMapContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTenantMapContext), arg0)
+}
+
 // GetTenantUUID mocks base method.
 func (m *MockExtendedQuobyteApi) GetTenantUUID(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1490,6 +2912,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetTenantUUID(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantUUID", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTenantUUID), arg0)
 }
 
+// GetTenantUUIDContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetTenantUUIDContext(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantUUIDContext", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantUUIDContext indicates an expected call of GetTenantUUIDContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetTenantUUIDContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantUUIDContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTenantUUIDContext), arg0, arg1)
+}
+
 // GetTopCapacityConsumer mocks base method.
 func (m *MockExtendedQuobyteApi) GetTopCapacityConsumer(arg0 *quobyte.GetTopCapacityConsumerRequest) (*quobyte.GetTopCapacityConsumerResponse, error) {
 	m.ctrl.T.Helper()
@@ -1505,6 +2942,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetTopCapacityConsumer(arg0 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopCapacityConsumer", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTopCapacityConsumer), arg0)
 }
 
+// GetTopCapacityConsumerContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetTopCapacityConsumerContext(arg0 context.Context, arg1 *quobyte.GetTopCapacityConsumerRequest) (*quobyte.GetTopCapacityConsumerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopCapacityConsumerContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetTopCapacityConsumerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopCapacityConsumerContext indicates an expected call of GetTopCapacityConsumerContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetTopCapacityConsumerContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopCapacityConsumerContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetTopCapacityConsumerContext), arg0, arg1)
+}
+
 // GetUnformattedDevices mocks base method.
 func (m *MockExtendedQuobyteApi) GetUnformattedDevices(arg0 *quobyte.GetUnformattedDevicesRequest) (*quobyte.GetUnformattedDevicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1520,6 +2972,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetUnformattedDevices(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnformattedDevices", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetUnformattedDevices), arg0)
 }
 
+// GetUnformattedDevicesContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetUnformattedDevicesContext(arg0 context.Context, arg1 *quobyte.GetUnformattedDevicesRequest) (*quobyte.GetUnformattedDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnformattedDevicesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetUnformattedDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnformattedDevicesContext indicates an expected call of GetUnformattedDevicesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetUnformattedDevicesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnformattedDevicesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetUnformattedDevicesContext), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockExtendedQuobyteApi) GetUsers(arg0 *quobyte.GetUsersRequest) (*quobyte.GetUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1535,6 +3002,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetUsers(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetUsers), arg0)
 }
 
+// GetUsersContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetUsersContext(arg0 context.Context, arg1 *quobyte.GetUsersRequest) (*quobyte.GetUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersContext indicates an expected call of GetUsersContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetUsersContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetUsersContext), arg0, arg1)
+}
+
 // GetVolumeList mocks base method.
 func (m *MockExtendedQuobyteApi) GetVolumeList(arg0 *quobyte.GetVolumeListRequest) (*quobyte.GetVolumeListResponse, error) {
 	m.ctrl.T.Helper()
@@ -1550,6 +3032,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetVolumeList(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeList", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetVolumeList), arg0)
 }
 
+// GetVolumeListContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetVolumeListContext(arg0 context.Context, arg1 *quobyte.GetVolumeListRequest) (*quobyte.GetVolumeListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetVolumeListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeListContext indicates an expected call of GetVolumeListContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetVolumeListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeListContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetVolumeListContext), arg0, arg1)
+}
+
 // GetVolumeUUID mocks base method.
 func (m *MockExtendedQuobyteApi) GetVolumeUUID(arg0, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1565,6 +3062,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) GetVolumeUUID(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeUUID", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetVolumeUUID), arg0, arg1)
 }
 
+// GetVolumeUUIDContext mocks base method.
+func (m *MockExtendedQuobyteApi) GetVolumeUUIDContext(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeUUIDContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeUUIDContext indicates an expected call of GetVolumeUUIDContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) GetVolumeUUIDContext(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeUUIDContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).GetVolumeUUIDContext), arg0, arg1, arg2)
+}
+
 // ImportAccessKeys mocks base method.
 func (m *MockExtendedQuobyteApi) ImportAccessKeys(arg0 *quobyte.ImportAccessKeysRequest) (*quobyte.ImportAccessKeysResponse, error) {
 	m.ctrl.T.Helper()
@@ -1580,6 +3092,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ImportAccessKeys(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccessKeys", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ImportAccessKeys), arg0)
 }
 
+// ImportAccessKeysContext mocks base method.
+func (m *MockExtendedQuobyteApi) ImportAccessKeysContext(arg0 context.Context, arg1 *quobyte.ImportAccessKeysRequest) (*quobyte.ImportAccessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAccessKeysContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ImportAccessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAccessKeysContext indicates an expected call of ImportAccessKeysContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ImportAccessKeysContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccessKeysContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ImportAccessKeysContext), arg0, arg1)
+}
+
 // ImportConfiguration mocks base method.
 func (m *MockExtendedQuobyteApi) ImportConfiguration(arg0 *quobyte.ImportConfigurationRequest) (*quobyte.ImportConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1595,6 +3122,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ImportConfiguration(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportConfiguration", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ImportConfiguration), arg0)
 }
 
+// ImportConfigurationContext mocks base method.
+func (m *MockExtendedQuobyteApi) ImportConfigurationContext(arg0 context.Context, arg1 *quobyte.ImportConfigurationRequest) (*quobyte.ImportConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ImportConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportConfigurationContext indicates an expected call of ImportConfigurationContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ImportConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportConfigurationContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ImportConfigurationContext), arg0, arg1)
+}
+
 // ImportPolicyRules mocks base method.
 func (m *MockExtendedQuobyteApi) ImportPolicyRules(arg0 *quobyte.ImportPolicyRulesRequest) (*quobyte.ImportPolicyRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1610,6 +3152,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ImportPolicyRules(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPolicyRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ImportPolicyRules), arg0)
 }
 
+// ImportPolicyRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) ImportPolicyRulesContext(arg0 context.Context, arg1 *quobyte.ImportPolicyRulesRequest) (*quobyte.ImportPolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ImportPolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPolicyRulesContext indicates an expected call of ImportPolicyRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ImportPolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPolicyRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ImportPolicyRulesContext), arg0, arg1)
+}
+
 // ListCa mocks base method.
 func (m *MockExtendedQuobyteApi) ListCa(arg0 *quobyte.ListCaRequest) (*quobyte.ListCaResponse, error) {
 	m.ctrl.T.Helper()
@@ -1625,6 +3182,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ListCa(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCa", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListCa), arg0)
 }
 
+// ListCaContext mocks base method.
+func (m *MockExtendedQuobyteApi) ListCaContext(arg0 context.Context, arg1 *quobyte.ListCaRequest) (*quobyte.ListCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCaContext indicates an expected call of ListCaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ListCaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListCaContext), arg0, arg1)
+}
+
 // ListCertificates mocks base method.
 func (m *MockExtendedQuobyteApi) ListCertificates(arg0 *quobyte.ListCertificatesRequest) (*quobyte.ListCertificatesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1640,6 +3212,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ListCertificates(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificates", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListCertificates), arg0)
 }
 
+// ListCertificatesContext mocks base method.
+func (m *MockExtendedQuobyteApi) ListCertificatesContext(arg0 context.Context, arg1 *quobyte.ListCertificatesRequest) (*quobyte.ListCertificatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificatesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListCertificatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertificatesContext indicates an expected call of ListCertificatesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ListCertificatesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificatesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListCertificatesContext), arg0, arg1)
+}
+
 // ListCsr mocks base method.
 func (m *MockExtendedQuobyteApi) ListCsr(arg0 *quobyte.ListCsrRequest) (*quobyte.ListCsrResponse, error) {
 	m.ctrl.T.Helper()
@@ -1655,6 +3242,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ListCsr(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCsr", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListCsr), arg0)
 }
 
+// ListCsrContext mocks base method.
+func (m *MockExtendedQuobyteApi) ListCsrContext(arg0 context.Context, arg1 *quobyte.ListCsrRequest) (*quobyte.ListCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCsrContext indicates an expected call of ListCsrContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ListCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCsrContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListCsrContext), arg0, arg1)
+}
+
 // ListRegistryReplicas mocks base method.
 func (m *MockExtendedQuobyteApi) ListRegistryReplicas(arg0 *quobyte.ListRegistryReplicasRequest) (*quobyte.ListRegistryReplicasResponse, error) {
 	m.ctrl.T.Helper()
@@ -1670,6 +3272,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ListRegistryReplicas(arg0 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistryReplicas", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListRegistryReplicas), arg0)
 }
 
+// ListRegistryReplicasContext mocks base method.
+func (m *MockExtendedQuobyteApi) ListRegistryReplicasContext(arg0 context.Context, arg1 *quobyte.ListRegistryReplicasRequest) (*quobyte.ListRegistryReplicasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistryReplicasContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListRegistryReplicasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistryReplicasContext indicates an expected call of ListRegistryReplicasContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ListRegistryReplicasContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistryReplicasContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListRegistryReplicasContext), arg0, arg1)
+}
+
 // ListSnapshots mocks base method.
 func (m *MockExtendedQuobyteApi) ListSnapshots(arg0 *quobyte.ListSnapshotsRequest) (*quobyte.ListSnapshotsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1685,6 +3302,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ListSnapshots(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListSnapshots), arg0)
 }
 
+// ListSnapshotsContext mocks base method.
+func (m *MockExtendedQuobyteApi) ListSnapshotsContext(arg0 context.Context, arg1 *quobyte.ListSnapshotsRequest) (*quobyte.ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshotsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotsContext indicates an expected call of ListSnapshotsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ListSnapshotsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ListSnapshotsContext), arg0, arg1)
+}
+
 // MakeDevice mocks base method.
 func (m *MockExtendedQuobyteApi) MakeDevice(arg0 *quobyte.MakeDeviceRequest) (*quobyte.MakeDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1700,6 +3332,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) MakeDevice(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeDevice", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).MakeDevice), arg0)
 }
 
+// MakeDeviceContext mocks base method.
+func (m *MockExtendedQuobyteApi) MakeDeviceContext(arg0 context.Context, arg1 *quobyte.MakeDeviceRequest) (*quobyte.MakeDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeDeviceContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.MakeDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeDeviceContext indicates an expected call of MakeDeviceContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) MakeDeviceContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeDeviceContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).MakeDeviceContext), arg0, arg1)
+}
+
 // PublishBucketVolume mocks base method.
 func (m *MockExtendedQuobyteApi) PublishBucketVolume(arg0 *quobyte.PublishBucketVolumeRequest) (*quobyte.PublishBucketVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1715,6 +3362,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) PublishBucketVolume(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBucketVolume", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).PublishBucketVolume), arg0)
 }
 
+// PublishBucketVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) PublishBucketVolumeContext(arg0 context.Context, arg1 *quobyte.PublishBucketVolumeRequest) (*quobyte.PublishBucketVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishBucketVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.PublishBucketVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishBucketVolumeContext indicates an expected call of PublishBucketVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) PublishBucketVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBucketVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).PublishBucketVolumeContext), arg0, arg1)
+}
+
 // QueryFiles mocks base method.
 func (m *MockExtendedQuobyteApi) QueryFiles(arg0 *quobyte.QueryFilesRequest) (*quobyte.QueryFilesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1730,6 +3392,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) QueryFiles(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFiles", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).QueryFiles), arg0)
 }
 
+// QueryFilesContext mocks base method.
+func (m *MockExtendedQuobyteApi) QueryFilesContext(arg0 context.Context, arg1 *quobyte.QueryFilesRequest) (*quobyte.QueryFilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFilesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.QueryFilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryFilesContext indicates an expected call of QueryFilesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) QueryFilesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFilesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).QueryFilesContext), arg0, arg1)
+}
+
 // RegenerateDatabase mocks base method.
 func (m *MockExtendedQuobyteApi) RegenerateDatabase(arg0 *quobyte.RegenerateDatabaseRequest) (*quobyte.RegenerateDatabaseResponse, error) {
 	m.ctrl.T.Helper()
@@ -1745,6 +3422,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) RegenerateDatabase(arg0 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateDatabase", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RegenerateDatabase), arg0)
 }
 
+// RegenerateDatabaseContext mocks base method.
+func (m *MockExtendedQuobyteApi) RegenerateDatabaseContext(arg0 context.Context, arg1 *quobyte.RegenerateDatabaseRequest) (*quobyte.RegenerateDatabaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateDatabaseContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RegenerateDatabaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateDatabaseContext indicates an expected call of RegenerateDatabaseContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) RegenerateDatabaseContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateDatabaseContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RegenerateDatabaseContext), arg0, arg1)
+}
+
 // RemoveKeystoreSlot mocks base method.
 func (m *MockExtendedQuobyteApi) RemoveKeystoreSlot(arg0 *quobyte.RemoveKeystoreSlotRequest) (*quobyte.RemoveKeystoreSlotResponse, error) {
 	m.ctrl.T.Helper()
@@ -1760,6 +3452,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) RemoveKeystoreSlot(arg0 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKeystoreSlot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RemoveKeystoreSlot), arg0)
 }
 
+// RemoveKeystoreSlotContext mocks base method.
+func (m *MockExtendedQuobyteApi) RemoveKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.RemoveKeystoreSlotRequest) (*quobyte.RemoveKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RemoveKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveKeystoreSlotContext indicates an expected call of RemoveKeystoreSlotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) RemoveKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKeystoreSlotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RemoveKeystoreSlotContext), arg0, arg1)
+}
+
 // RemoveMasterKeystoreSlot mocks base method.
 func (m *MockExtendedQuobyteApi) RemoveMasterKeystoreSlot(arg0 *quobyte.RemoveMasterKeystoreSlotRequest) (*quobyte.RemoveMasterKeystoreSlotResponse, error) {
 	m.ctrl.T.Helper()
@@ -1775,6 +3482,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) RemoveMasterKeystoreSlot(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMasterKeystoreSlot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RemoveMasterKeystoreSlot), arg0)
 }
 
+// RemoveMasterKeystoreSlotContext mocks base method.
+func (m *MockExtendedQuobyteApi) RemoveMasterKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.RemoveMasterKeystoreSlotRequest) (*quobyte.RemoveMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMasterKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RemoveMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMasterKeystoreSlotContext indicates an expected call of RemoveMasterKeystoreSlotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) RemoveMasterKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMasterKeystoreSlotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RemoveMasterKeystoreSlotContext), arg0, arg1)
+}
+
 // RemoveRegistryReplica mocks base method.
 func (m *MockExtendedQuobyteApi) RemoveRegistryReplica(arg0 *quobyte.RemoveRegistryReplicaRequest) (*quobyte.RemoveRegistryReplicaResponse, error) {
 	m.ctrl.T.Helper()
@@ -1790,6 +3512,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) RemoveRegistryReplica(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegistryReplica", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RemoveRegistryReplica), arg0)
 }
 
+// RemoveRegistryReplicaContext mocks base method.
+func (m *MockExtendedQuobyteApi) RemoveRegistryReplicaContext(arg0 context.Context, arg1 *quobyte.RemoveRegistryReplicaRequest) (*quobyte.RemoveRegistryReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRegistryReplicaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RemoveRegistryReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRegistryReplicaContext indicates an expected call of RemoveRegistryReplicaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) RemoveRegistryReplicaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegistryReplicaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RemoveRegistryReplicaContext), arg0, arg1)
+}
+
 // ResolveGlobalFileId mocks base method.
 func (m *MockExtendedQuobyteApi) ResolveGlobalFileId(arg0 *quobyte.ResolveGlobalFileIdRequest) (*quobyte.ResolveGlobalFileIdResponse, error) {
 	m.ctrl.T.Helper()
@@ -1805,19 +3542,49 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ResolveGlobalFileId(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveGlobalFileId", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveGlobalFileId), arg0)
 }
 
+// ResolveGlobalFileIdContext mocks base method.
+func (m *MockExtendedQuobyteApi) ResolveGlobalFileIdContext(arg0 context.Context, arg1 *quobyte.ResolveGlobalFileIdRequest) (*quobyte.ResolveGlobalFileIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveGlobalFileIdContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResolveGlobalFileIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveGlobalFileIdContext indicates an expected call of ResolveGlobalFileIdContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResolveGlobalFileIdContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveGlobalFileIdContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveGlobalFileIdContext), arg0, arg1)
+}
+
 // ResolvePolicyRuleName mocks base method.
 func (m *MockExtendedQuobyteApi) ResolvePolicyRuleName(arg0 *quobyte.ResolvePolicyRuleNameRequest) (*quobyte.ResolvePolicyRuleNameResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResolvePolicyRuleName", arg0)
+	ret := m.ctrl.Call(m, "ResolvePolicyRuleName", arg0)
+	ret0, _ := ret[0].(*quobyte.ResolvePolicyRuleNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePolicyRuleName indicates an expected call of ResolvePolicyRuleName.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResolvePolicyRuleName(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePolicyRuleName", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolvePolicyRuleName), arg0)
+}
+
+// ResolvePolicyRuleNameContext mocks base method.
+func (m *MockExtendedQuobyteApi) ResolvePolicyRuleNameContext(arg0 context.Context, arg1 *quobyte.ResolvePolicyRuleNameRequest) (*quobyte.ResolvePolicyRuleNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePolicyRuleNameContext", arg0, arg1)
 	ret0, _ := ret[0].(*quobyte.ResolvePolicyRuleNameResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ResolvePolicyRuleName indicates an expected call of ResolvePolicyRuleName.
-func (mr *MockExtendedQuobyteApiMockRecorder) ResolvePolicyRuleName(arg0 any) *gomock.Call {
+// ResolvePolicyRuleNameContext indicates an expected call of ResolvePolicyRuleNameContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResolvePolicyRuleNameContext(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePolicyRuleName", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolvePolicyRuleName), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePolicyRuleNameContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolvePolicyRuleNameContext), arg0, arg1)
 }
 
 // ResolveTenantName mocks base method.
@@ -1835,6 +3602,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ResolveTenantName(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTenantName", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveTenantName), arg0)
 }
 
+// ResolveTenantNameContext mocks base method.
+func (m *MockExtendedQuobyteApi) ResolveTenantNameContext(arg0 context.Context, arg1 *quobyte.ResolveTenantNameRequest) (*quobyte.ResolveTenantNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTenantNameContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResolveTenantNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTenantNameContext indicates an expected call of ResolveTenantNameContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResolveTenantNameContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTenantNameContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveTenantNameContext), arg0, arg1)
+}
+
 // ResolveTenantNameToUUID mocks base method.
 func (m *MockExtendedQuobyteApi) ResolveTenantNameToUUID(arg0 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1850,6 +3632,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ResolveTenantNameToUUID(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTenantNameToUUID", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveTenantNameToUUID), arg0)
 }
 
+// ResolveTenantNameToUUIDContext mocks base method.
+func (m *MockExtendedQuobyteApi) ResolveTenantNameToUUIDContext(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTenantNameToUUIDContext", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTenantNameToUUIDContext indicates an expected call of ResolveTenantNameToUUIDContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResolveTenantNameToUUIDContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTenantNameToUUIDContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveTenantNameToUUIDContext), arg0, arg1)
+}
+
 // ResolveVolumeName mocks base method.
 func (m *MockExtendedQuobyteApi) ResolveVolumeName(arg0 *quobyte.ResolveVolumeNameRequest) (*quobyte.ResolveVolumeNameResponse, error) {
 	m.ctrl.T.Helper()
@@ -1865,6 +3662,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ResolveVolumeName(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVolumeName", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveVolumeName), arg0)
 }
 
+// ResolveVolumeNameContext mocks base method.
+func (m *MockExtendedQuobyteApi) ResolveVolumeNameContext(arg0 context.Context, arg1 *quobyte.ResolveVolumeNameRequest) (*quobyte.ResolveVolumeNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveVolumeNameContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResolveVolumeNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveVolumeNameContext indicates an expected call of ResolveVolumeNameContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResolveVolumeNameContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVolumeNameContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveVolumeNameContext), arg0, arg1)
+}
+
 // ResolveVolumeNameToUUID mocks base method.
 func (m *MockExtendedQuobyteApi) ResolveVolumeNameToUUID(arg0, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1880,6 +3692,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ResolveVolumeNameToUUID(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVolumeNameToUUID", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveVolumeNameToUUID), arg0, arg1)
 }
 
+// ResolveVolumeNameToUUIDContext mocks base method.
+func (m *MockExtendedQuobyteApi) ResolveVolumeNameToUUIDContext(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveVolumeNameToUUIDContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveVolumeNameToUUIDContext indicates an expected call of ResolveVolumeNameToUUIDContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResolveVolumeNameToUUIDContext(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVolumeNameToUUIDContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResolveVolumeNameToUUIDContext), arg0, arg1, arg2)
+}
+
 // ResumeTask mocks base method.
 func (m *MockExtendedQuobyteApi) ResumeTask(arg0 *quobyte.ResumeTaskRequest) (*quobyte.ResumeTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -1895,6 +3722,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) ResumeTask(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTask", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResumeTask), arg0)
 }
 
+// ResumeTaskContext mocks base method.
+func (m *MockExtendedQuobyteApi) ResumeTaskContext(arg0 context.Context, arg1 *quobyte.ResumeTaskRequest) (*quobyte.ResumeTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResumeTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskContext indicates an expected call of ResumeTaskContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) ResumeTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).ResumeTaskContext), arg0, arg1)
+}
+
 // RetryTask mocks base method.
 func (m *MockExtendedQuobyteApi) RetryTask(arg0 *quobyte.RetryTaskRequest) (*quobyte.RetryTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -1910,6 +3752,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) RetryTask(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTask", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RetryTask), arg0)
 }
 
+// RetryTaskContext mocks base method.
+func (m *MockExtendedQuobyteApi) RetryTaskContext(arg0 context.Context, arg1 *quobyte.RetryTaskRequest) (*quobyte.RetryTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RetryTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryTaskContext indicates an expected call of RetryTaskContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) RetryTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTaskContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RetryTaskContext), arg0, arg1)
+}
+
 // RevokeCertificate mocks base method.
 func (m *MockExtendedQuobyteApi) RevokeCertificate(arg0 *quobyte.RevokeCertificateRequest) (*quobyte.RevokeCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1925,6 +3782,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) RevokeCertificate(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificate", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RevokeCertificate), arg0)
 }
 
+// RevokeCertificateContext mocks base method.
+func (m *MockExtendedQuobyteApi) RevokeCertificateContext(arg0 context.Context, arg1 *quobyte.RevokeCertificateRequest) (*quobyte.RevokeCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RevokeCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCertificateContext indicates an expected call of RevokeCertificateContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) RevokeCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificateContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).RevokeCertificateContext), arg0, arg1)
+}
+
 // SetAPIRetryPolicy mocks base method.
 func (m *MockExtendedQuobyteApi) SetAPIRetryPolicy(arg0 string) {
 	m.ctrl.T.Helper()
@@ -1952,6 +3824,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetCertificateOwner(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateOwner", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetCertificateOwner), arg0)
 }
 
+// SetCertificateOwnerContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetCertificateOwnerContext(arg0 context.Context, arg1 *quobyte.SetCertificateOwnerRequest) (*quobyte.SetCertificateOwnerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCertificateOwnerContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetCertificateOwnerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCertificateOwnerContext indicates an expected call of SetCertificateOwnerContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetCertificateOwnerContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateOwnerContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetCertificateOwnerContext), arg0, arg1)
+}
+
 // SetCertificateSubject mocks base method.
 func (m *MockExtendedQuobyteApi) SetCertificateSubject(arg0 *quobyte.SetCertificateSubjectRequest) (*quobyte.SetCertificateSubjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -1967,6 +3854,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetCertificateSubject(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateSubject", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetCertificateSubject), arg0)
 }
 
+// SetCertificateSubjectContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetCertificateSubjectContext(arg0 context.Context, arg1 *quobyte.SetCertificateSubjectRequest) (*quobyte.SetCertificateSubjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCertificateSubjectContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetCertificateSubjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCertificateSubjectContext indicates an expected call of SetCertificateSubjectContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetCertificateSubjectContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateSubjectContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetCertificateSubjectContext), arg0, arg1)
+}
+
 // SetConfiguration mocks base method.
 func (m *MockExtendedQuobyteApi) SetConfiguration(arg0 *quobyte.SetConfigurationRequest) (*quobyte.SetConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1982,6 +3884,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetConfiguration(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetConfiguration), arg0)
 }
 
+// SetConfigurationContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetConfigurationContext(arg0 context.Context, arg1 *quobyte.SetConfigurationRequest) (*quobyte.SetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfigurationContext indicates an expected call of SetConfigurationContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfigurationContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetConfigurationContext), arg0, arg1)
+}
+
 // SetEncryptedVolumeKey mocks base method.
 func (m *MockExtendedQuobyteApi) SetEncryptedVolumeKey(arg0 *quobyte.SetEncryptedVolumeKeyRequest) (*quobyte.SetEncryptedVolumeKeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1997,6 +3914,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetEncryptedVolumeKey(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncryptedVolumeKey", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetEncryptedVolumeKey), arg0)
 }
 
+// SetEncryptedVolumeKeyContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetEncryptedVolumeKeyContext(arg0 context.Context, arg1 *quobyte.SetEncryptedVolumeKeyRequest) (*quobyte.SetEncryptedVolumeKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEncryptedVolumeKeyContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetEncryptedVolumeKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEncryptedVolumeKeyContext indicates an expected call of SetEncryptedVolumeKeyContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetEncryptedVolumeKeyContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncryptedVolumeKeyContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetEncryptedVolumeKeyContext), arg0, arg1)
+}
+
 // SetLabels mocks base method.
 func (m *MockExtendedQuobyteApi) SetLabels(arg0 *quobyte.SetLabelsRequest) (*quobyte.SetLabelsResponse, error) {
 	m.ctrl.T.Helper()
@@ -2012,6 +3944,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetLabels(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabels", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetLabels), arg0)
 }
 
+// SetLabelsContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetLabelsContext(arg0 context.Context, arg1 *quobyte.SetLabelsRequest) (*quobyte.SetLabelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLabelsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetLabelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLabelsContext indicates an expected call of SetLabelsContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetLabelsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabelsContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetLabelsContext), arg0, arg1)
+}
+
 // SetLicenseKey mocks base method.
 func (m *MockExtendedQuobyteApi) SetLicenseKey(arg0 *quobyte.SetLicenseKeyRequest) (*quobyte.SetLicenseKeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -2027,6 +3974,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetLicenseKey(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLicenseKey", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetLicenseKey), arg0)
 }
 
+// SetLicenseKeyContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetLicenseKeyContext(arg0 context.Context, arg1 *quobyte.SetLicenseKeyRequest) (*quobyte.SetLicenseKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLicenseKeyContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetLicenseKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLicenseKeyContext indicates an expected call of SetLicenseKeyContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetLicenseKeyContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLicenseKeyContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetLicenseKeyContext), arg0, arg1)
+}
+
 // SetNotificationRule mocks base method.
 func (m *MockExtendedQuobyteApi) SetNotificationRule(arg0 *quobyte.SetNotificationRuleRequest) (*quobyte.SetNotificationRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -2042,6 +4004,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetNotificationRule(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationRule", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetNotificationRule), arg0)
 }
 
+// SetNotificationRuleContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetNotificationRuleContext(arg0 context.Context, arg1 *quobyte.SetNotificationRuleRequest) (*quobyte.SetNotificationRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNotificationRuleContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetNotificationRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNotificationRuleContext indicates an expected call of SetNotificationRuleContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetNotificationRuleContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotificationRuleContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetNotificationRuleContext), arg0, arg1)
+}
+
 // SetQuota mocks base method.
 func (m *MockExtendedQuobyteApi) SetQuota(arg0 *quobyte.SetQuotaRequest) (*quobyte.SetQuotaResponse, error) {
 	m.ctrl.T.Helper()
@@ -2057,6 +4034,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetQuota(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuota", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetQuota), arg0)
 }
 
+// SetQuotaContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetQuotaContext(arg0 context.Context, arg1 *quobyte.SetQuotaRequest) (*quobyte.SetQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuotaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuotaContext indicates an expected call of SetQuotaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetQuotaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuotaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetQuotaContext), arg0, arg1)
+}
+
 // SetTenant mocks base method.
 func (m *MockExtendedQuobyteApi) SetTenant(arg0 *quobyte.SetTenantRequest) (*quobyte.SetTenantResponse, error) {
 	m.ctrl.T.Helper()
@@ -2072,6 +4064,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetTenant(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTenant", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetTenant), arg0)
 }
 
+// SetTenantContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetTenantContext(arg0 context.Context, arg1 *quobyte.SetTenantRequest) (*quobyte.SetTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTenantContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTenantContext indicates an expected call of SetTenantContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetTenantContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTenantContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetTenantContext), arg0, arg1)
+}
+
 // SetTransport mocks base method.
 func (m *MockExtendedQuobyteApi) SetTransport(arg0 http.RoundTripper) {
 	m.ctrl.T.Helper()
@@ -2098,6 +4105,20 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SetVolumeQuota(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVolumeQuota", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetVolumeQuota), arg0, arg1)
 }
 
+// SetVolumeQuotaContext mocks base method.
+func (m *MockExtendedQuobyteApi) SetVolumeQuotaContext(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVolumeQuotaContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVolumeQuotaContext indicates an expected call of SetVolumeQuotaContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SetVolumeQuotaContext(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVolumeQuotaContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SetVolumeQuotaContext), arg0, arg1, arg2)
+}
+
 // SilenceAlert mocks base method.
 func (m *MockExtendedQuobyteApi) SilenceAlert(arg0 *quobyte.SilenceAlertRequest) (*quobyte.SilenceAlertResponse, error) {
 	m.ctrl.T.Helper()
@@ -2113,6 +4134,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) SilenceAlert(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SilenceAlert", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SilenceAlert), arg0)
 }
 
+// SilenceAlertContext mocks base method.
+func (m *MockExtendedQuobyteApi) SilenceAlertContext(arg0 context.Context, arg1 *quobyte.SilenceAlertRequest) (*quobyte.SilenceAlertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SilenceAlertContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SilenceAlertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SilenceAlertContext indicates an expected call of SilenceAlertContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) SilenceAlertContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SilenceAlertContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).SilenceAlertContext), arg0, arg1)
+}
+
 // StartNetworkTest mocks base method.
 func (m *MockExtendedQuobyteApi) StartNetworkTest(arg0 *quobyte.StartNetworkTestRequest) (*quobyte.StartNetworkTestResponse, error) {
 	m.ctrl.T.Helper()
@@ -2128,6 +4164,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) StartNetworkTest(arg0 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNetworkTest", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).StartNetworkTest), arg0)
 }
 
+// StartNetworkTestContext mocks base method.
+func (m *MockExtendedQuobyteApi) StartNetworkTestContext(arg0 context.Context, arg1 *quobyte.StartNetworkTestRequest) (*quobyte.StartNetworkTestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNetworkTestContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.StartNetworkTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartNetworkTestContext indicates an expected call of StartNetworkTestContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) StartNetworkTestContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNetworkTestContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).StartNetworkTestContext), arg0, arg1)
+}
+
 // TriggerVolumeCheckpoint mocks base method.
 func (m *MockExtendedQuobyteApi) TriggerVolumeCheckpoint(arg0 *quobyte.TriggerVolumeCheckpointRequest) (*quobyte.TriggerVolumeCheckpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -2143,6 +4194,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) TriggerVolumeCheckpoint(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerVolumeCheckpoint", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).TriggerVolumeCheckpoint), arg0)
 }
 
+// TriggerVolumeCheckpointContext mocks base method.
+func (m *MockExtendedQuobyteApi) TriggerVolumeCheckpointContext(arg0 context.Context, arg1 *quobyte.TriggerVolumeCheckpointRequest) (*quobyte.TriggerVolumeCheckpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerVolumeCheckpointContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.TriggerVolumeCheckpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerVolumeCheckpointContext indicates an expected call of TriggerVolumeCheckpointContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) TriggerVolumeCheckpointContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerVolumeCheckpointContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).TriggerVolumeCheckpointContext), arg0, arg1)
+}
+
 // UnlockMasterKeystoreSlot mocks base method.
 func (m *MockExtendedQuobyteApi) UnlockMasterKeystoreSlot(arg0 *quobyte.UnlockMasterKeystoreSlotRequest) (*quobyte.UnlockMasterKeystoreSlotResponse, error) {
 	m.ctrl.T.Helper()
@@ -2158,6 +4224,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) UnlockMasterKeystoreSlot(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockMasterKeystoreSlot", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UnlockMasterKeystoreSlot), arg0)
 }
 
+// UnlockMasterKeystoreSlotContext mocks base method.
+func (m *MockExtendedQuobyteApi) UnlockMasterKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.UnlockMasterKeystoreSlotRequest) (*quobyte.UnlockMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockMasterKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UnlockMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockMasterKeystoreSlotContext indicates an expected call of UnlockMasterKeystoreSlotContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) UnlockMasterKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockMasterKeystoreSlotContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UnlockMasterKeystoreSlotContext), arg0, arg1)
+}
+
 // UnpublishBucketVolume mocks base method.
 func (m *MockExtendedQuobyteApi) UnpublishBucketVolume(arg0 *quobyte.UnpublishBucketVolumeRequest) (*quobyte.UnpublishBucketVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -2173,6 +4254,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) UnpublishBucketVolume(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishBucketVolume", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UnpublishBucketVolume), arg0)
 }
 
+// UnpublishBucketVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) UnpublishBucketVolumeContext(arg0 context.Context, arg1 *quobyte.UnpublishBucketVolumeRequest) (*quobyte.UnpublishBucketVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishBucketVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UnpublishBucketVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishBucketVolumeContext indicates an expected call of UnpublishBucketVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) UnpublishBucketVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishBucketVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UnpublishBucketVolumeContext), arg0, arg1)
+}
+
 // UpdateDevice mocks base method.
 func (m *MockExtendedQuobyteApi) UpdateDevice(arg0 *quobyte.UpdateDeviceRequest) (*quobyte.UpdateDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -2188,6 +4284,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) UpdateDevice(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDevice", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdateDevice), arg0)
 }
 
+// UpdateDeviceContext mocks base method.
+func (m *MockExtendedQuobyteApi) UpdateDeviceContext(arg0 context.Context, arg1 *quobyte.UpdateDeviceRequest) (*quobyte.UpdateDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeviceContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdateDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeviceContext indicates an expected call of UpdateDeviceContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) UpdateDeviceContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeviceContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdateDeviceContext), arg0, arg1)
+}
+
 // UpdatePolicyRules mocks base method.
 func (m *MockExtendedQuobyteApi) UpdatePolicyRules(arg0 *quobyte.UpdatePolicyRulesRequest) (*quobyte.UpdatePolicyRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -2203,6 +4314,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) UpdatePolicyRules(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyRules", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdatePolicyRules), arg0)
 }
 
+// UpdatePolicyRulesContext mocks base method.
+func (m *MockExtendedQuobyteApi) UpdatePolicyRulesContext(arg0 context.Context, arg1 *quobyte.UpdatePolicyRulesRequest) (*quobyte.UpdatePolicyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicyRulesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdatePolicyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePolicyRulesContext indicates an expected call of UpdatePolicyRulesContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) UpdatePolicyRulesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicyRulesContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdatePolicyRulesContext), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockExtendedQuobyteApi) UpdateUser(arg0 *quobyte.UpdateUserRequest) (*quobyte.UpdateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -2218,6 +4344,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) UpdateUser(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdateUser), arg0)
 }
 
+// UpdateUserContext mocks base method.
+func (m *MockExtendedQuobyteApi) UpdateUserContext(arg0 context.Context, arg1 *quobyte.UpdateUserRequest) (*quobyte.UpdateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserContext indicates an expected call of UpdateUserContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) UpdateUserContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdateUserContext), arg0, arg1)
+}
+
 // UpdateVolume mocks base method.
 func (m *MockExtendedQuobyteApi) UpdateVolume(arg0 *quobyte.UpdateVolumeRequest) (*quobyte.UpdateVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -2233,6 +4374,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) UpdateVolume(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdateVolume), arg0)
 }
 
+// UpdateVolumeContext mocks base method.
+func (m *MockExtendedQuobyteApi) UpdateVolumeContext(arg0 context.Context, arg1 *quobyte.UpdateVolumeRequest) (*quobyte.UpdateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolumeContext indicates an expected call of UpdateVolumeContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) UpdateVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).UpdateVolumeContext), arg0, arg1)
+}
+
 // VerifyLicense mocks base method.
 func (m *MockExtendedQuobyteApi) VerifyLicense(arg0 *quobyte.VerifyLicenseRequest) (*quobyte.VerifyLicenseResponse, error) {
 	m.ctrl.T.Helper()
@@ -2248,6 +4404,21 @@ func (mr *MockExtendedQuobyteApiMockRecorder) VerifyLicense(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLicense", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).VerifyLicense), arg0)
 }
 
+// VerifyLicenseContext mocks base method.
+func (m *MockExtendedQuobyteApi) VerifyLicenseContext(arg0 context.Context, arg1 *quobyte.VerifyLicenseRequest) (*quobyte.VerifyLicenseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLicenseContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.VerifyLicenseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLicenseContext indicates an expected call of VerifyLicenseContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) VerifyLicenseContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLicenseContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).VerifyLicenseContext), arg0, arg1)
+}
+
 // WhoAmI mocks base method.
 func (m *MockExtendedQuobyteApi) WhoAmI(arg0 *quobyte.WhoAmIRequest) (*quobyte.WhoAmIResponse, error) {
 	m.ctrl.T.Helper()
@@ -2262,3 +4433,18 @@ func (mr *MockExtendedQuobyteApiMockRecorder) WhoAmI(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhoAmI", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).WhoAmI), arg0)
 }
+
+// WhoAmIContext mocks base method.
+func (m *MockExtendedQuobyteApi) WhoAmIContext(arg0 context.Context, arg1 *quobyte.WhoAmIRequest) (*quobyte.WhoAmIResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhoAmIContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.WhoAmIResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhoAmIContext indicates an expected call of WhoAmIContext.
+func (mr *MockExtendedQuobyteApiMockRecorder) WhoAmIContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhoAmIContext", reflect.TypeOf((*MockExtendedQuobyteApi)(nil).WhoAmIContext), arg0, arg1)
+}
@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: QuotaAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_quota_api.go github.com/quobyte/api/quobyte QuotaAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuotaAPI is a mock of QuotaAPI interface.
+type MockQuotaAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaAPIMockRecorder
+}
+
+// MockQuotaAPIMockRecorder is the mock recorder for MockQuotaAPI.
+type MockQuotaAPIMockRecorder struct {
+	mock *MockQuotaAPI
+}
+
+// NewMockQuotaAPI creates a new mock instance.
+func NewMockQuotaAPI(ctrl *gomock.Controller) *MockQuotaAPI {
+	mock := &MockQuotaAPI{ctrl: ctrl}
+	mock.recorder = &MockQuotaAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaAPI) EXPECT() *MockQuotaAPIMockRecorder {
+	return m.recorder
+}
+
+// GetAccounting mocks base method.
+func (m *MockQuotaAPI) GetAccounting(arg0 *quobyte.GetAccountingRequest) (*quobyte.GetAccountingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccounting", arg0)
+	ret0, _ := ret[0].(*quobyte.GetAccountingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccounting indicates an expected call of GetAccounting.
+func (mr *MockQuotaAPIMockRecorder) GetAccounting(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounting", reflect.TypeOf((*MockQuotaAPI)(nil).GetAccounting), arg0)
+}
+
+// GetAccountingContext mocks base method.
+func (m *MockQuotaAPI) GetAccountingContext(arg0 context.Context, arg1 *quobyte.GetAccountingRequest) (*quobyte.GetAccountingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountingContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAccountingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountingContext indicates an expected call of GetAccountingContext.
+func (mr *MockQuotaAPIMockRecorder) GetAccountingContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountingContext", reflect.TypeOf((*MockQuotaAPI)(nil).GetAccountingContext), arg0, arg1)
+}
+
+// GetQuota mocks base method.
+func (m *MockQuotaAPI) GetQuota(arg0 *quobyte.GetQuotaRequest) (*quobyte.GetQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuota", arg0)
+	ret0, _ := ret[0].(*quobyte.GetQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuota indicates an expected call of GetQuota.
+func (mr *MockQuotaAPIMockRecorder) GetQuota(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuota", reflect.TypeOf((*MockQuotaAPI)(nil).GetQuota), arg0)
+}
+
+// GetQuotaContext mocks base method.
+func (m *MockQuotaAPI) GetQuotaContext(arg0 context.Context, arg1 *quobyte.GetQuotaRequest) (*quobyte.GetQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaContext indicates an expected call of GetQuotaContext.
+func (mr *MockQuotaAPIMockRecorder) GetQuotaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaContext", reflect.TypeOf((*MockQuotaAPI)(nil).GetQuotaContext), arg0, arg1)
+}
+
+// GetTopCapacityConsumer mocks base method.
+func (m *MockQuotaAPI) GetTopCapacityConsumer(arg0 *quobyte.GetTopCapacityConsumerRequest) (*quobyte.GetTopCapacityConsumerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopCapacityConsumer", arg0)
+	ret0, _ := ret[0].(*quobyte.GetTopCapacityConsumerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopCapacityConsumer indicates an expected call of GetTopCapacityConsumer.
+func (mr *MockQuotaAPIMockRecorder) GetTopCapacityConsumer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopCapacityConsumer", reflect.TypeOf((*MockQuotaAPI)(nil).GetTopCapacityConsumer), arg0)
+}
+
+// GetTopCapacityConsumerContext mocks base method.
+func (m *MockQuotaAPI) GetTopCapacityConsumerContext(arg0 context.Context, arg1 *quobyte.GetTopCapacityConsumerRequest) (*quobyte.GetTopCapacityConsumerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopCapacityConsumerContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetTopCapacityConsumerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopCapacityConsumerContext indicates an expected call of GetTopCapacityConsumerContext.
+func (mr *MockQuotaAPIMockRecorder) GetTopCapacityConsumerContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopCapacityConsumerContext", reflect.TypeOf((*MockQuotaAPI)(nil).GetTopCapacityConsumerContext), arg0, arg1)
+}
+
+// SetQuota mocks base method.
+func (m *MockQuotaAPI) SetQuota(arg0 *quobyte.SetQuotaRequest) (*quobyte.SetQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuota", arg0)
+	ret0, _ := ret[0].(*quobyte.SetQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuota indicates an expected call of SetQuota.
+func (mr *MockQuotaAPIMockRecorder) SetQuota(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuota", reflect.TypeOf((*MockQuotaAPI)(nil).SetQuota), arg0)
+}
+
+// SetQuotaContext mocks base method.
+func (m *MockQuotaAPI) SetQuotaContext(arg0 context.Context, arg1 *quobyte.SetQuotaRequest) (*quobyte.SetQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuotaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuotaContext indicates an expected call of SetQuotaContext.
+func (mr *MockQuotaAPIMockRecorder) SetQuotaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuotaContext", reflect.TypeOf((*MockQuotaAPI)(nil).SetQuotaContext), arg0, arg1)
+}
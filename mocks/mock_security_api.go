@@ -0,0 +1,851 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: SecurityAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_security_api.go github.com/quobyte/api/quobyte SecurityAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecurityAPI is a mock of SecurityAPI interface.
+type MockSecurityAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecurityAPIMockRecorder
+}
+
+// MockSecurityAPIMockRecorder is the mock recorder for MockSecurityAPI.
+type MockSecurityAPIMockRecorder struct {
+	mock *MockSecurityAPI
+}
+
+// NewMockSecurityAPI creates a new mock instance.
+func NewMockSecurityAPI(ctrl *gomock.Controller) *MockSecurityAPI {
+	mock := &MockSecurityAPI{ctrl: ctrl}
+	mock.recorder = &MockSecurityAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecurityAPI) EXPECT() *MockSecurityAPIMockRecorder {
+	return m.recorder
+}
+
+// AddCa mocks base method.
+func (m *MockSecurityAPI) AddCa(arg0 *quobyte.AddCaRequest) (*quobyte.AddCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCa", arg0)
+	ret0, _ := ret[0].(*quobyte.AddCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCa indicates an expected call of AddCa.
+func (mr *MockSecurityAPIMockRecorder) AddCa(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCa", reflect.TypeOf((*MockSecurityAPI)(nil).AddCa), arg0)
+}
+
+// AddCaContext mocks base method.
+func (m *MockSecurityAPI) AddCaContext(arg0 context.Context, arg1 *quobyte.AddCaRequest) (*quobyte.AddCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCaContext indicates an expected call of AddCaContext.
+func (mr *MockSecurityAPIMockRecorder) AddCaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCaContext", reflect.TypeOf((*MockSecurityAPI)(nil).AddCaContext), arg0, arg1)
+}
+
+// AddCertificate mocks base method.
+func (m *MockSecurityAPI) AddCertificate(arg0 *quobyte.AddCertificateRequest) (*quobyte.AddCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCertificate", arg0)
+	ret0, _ := ret[0].(*quobyte.AddCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCertificate indicates an expected call of AddCertificate.
+func (mr *MockSecurityAPIMockRecorder) AddCertificate(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificate", reflect.TypeOf((*MockSecurityAPI)(nil).AddCertificate), arg0)
+}
+
+// AddCertificateContext mocks base method.
+func (m *MockSecurityAPI) AddCertificateContext(arg0 context.Context, arg1 *quobyte.AddCertificateRequest) (*quobyte.AddCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCertificateContext indicates an expected call of AddCertificateContext.
+func (mr *MockSecurityAPIMockRecorder) AddCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCertificateContext", reflect.TypeOf((*MockSecurityAPI)(nil).AddCertificateContext), arg0, arg1)
+}
+
+// AddCsr mocks base method.
+func (m *MockSecurityAPI) AddCsr(arg0 *quobyte.AddCsrRequest) (*quobyte.AddCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCsr", arg0)
+	ret0, _ := ret[0].(*quobyte.AddCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCsr indicates an expected call of AddCsr.
+func (mr *MockSecurityAPIMockRecorder) AddCsr(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCsr", reflect.TypeOf((*MockSecurityAPI)(nil).AddCsr), arg0)
+}
+
+// AddCsrContext mocks base method.
+func (m *MockSecurityAPI) AddCsrContext(arg0 context.Context, arg1 *quobyte.AddCsrRequest) (*quobyte.AddCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AddCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCsrContext indicates an expected call of AddCsrContext.
+func (mr *MockSecurityAPIMockRecorder) AddCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCsrContext", reflect.TypeOf((*MockSecurityAPI)(nil).AddCsrContext), arg0, arg1)
+}
+
+// CreateMasterKeystoreSlot mocks base method.
+func (m *MockSecurityAPI) CreateMasterKeystoreSlot(arg0 *quobyte.CreateMasterKeystoreSlotRequest) (*quobyte.CreateMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMasterKeystoreSlot", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMasterKeystoreSlot indicates an expected call of CreateMasterKeystoreSlot.
+func (mr *MockSecurityAPIMockRecorder) CreateMasterKeystoreSlot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMasterKeystoreSlot", reflect.TypeOf((*MockSecurityAPI)(nil).CreateMasterKeystoreSlot), arg0)
+}
+
+// CreateMasterKeystoreSlotContext mocks base method.
+func (m *MockSecurityAPI) CreateMasterKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.CreateMasterKeystoreSlotRequest) (*quobyte.CreateMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMasterKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMasterKeystoreSlotContext indicates an expected call of CreateMasterKeystoreSlotContext.
+func (mr *MockSecurityAPIMockRecorder) CreateMasterKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMasterKeystoreSlotContext", reflect.TypeOf((*MockSecurityAPI)(nil).CreateMasterKeystoreSlotContext), arg0, arg1)
+}
+
+// CreateNewUserKeystoreSlot mocks base method.
+func (m *MockSecurityAPI) CreateNewUserKeystoreSlot(arg0 *quobyte.CreateNewUserKeystoreSlotRequest) (*quobyte.CreateNewUserKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewUserKeystoreSlot", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateNewUserKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewUserKeystoreSlot indicates an expected call of CreateNewUserKeystoreSlot.
+func (mr *MockSecurityAPIMockRecorder) CreateNewUserKeystoreSlot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewUserKeystoreSlot", reflect.TypeOf((*MockSecurityAPI)(nil).CreateNewUserKeystoreSlot), arg0)
+}
+
+// CreateNewUserKeystoreSlotContext mocks base method.
+func (m *MockSecurityAPI) CreateNewUserKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.CreateNewUserKeystoreSlotRequest) (*quobyte.CreateNewUserKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewUserKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateNewUserKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewUserKeystoreSlotContext indicates an expected call of CreateNewUserKeystoreSlotContext.
+func (mr *MockSecurityAPIMockRecorder) CreateNewUserKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewUserKeystoreSlotContext", reflect.TypeOf((*MockSecurityAPI)(nil).CreateNewUserKeystoreSlotContext), arg0, arg1)
+}
+
+// DecideCsr mocks base method.
+func (m *MockSecurityAPI) DecideCsr(arg0 *quobyte.DecideCsrRequest) (*quobyte.DecideCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideCsr", arg0)
+	ret0, _ := ret[0].(*quobyte.DecideCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideCsr indicates an expected call of DecideCsr.
+func (mr *MockSecurityAPIMockRecorder) DecideCsr(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideCsr", reflect.TypeOf((*MockSecurityAPI)(nil).DecideCsr), arg0)
+}
+
+// DecideCsrContext mocks base method.
+func (m *MockSecurityAPI) DecideCsrContext(arg0 context.Context, arg1 *quobyte.DecideCsrRequest) (*quobyte.DecideCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DecideCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideCsrContext indicates an expected call of DecideCsrContext.
+func (mr *MockSecurityAPIMockRecorder) DecideCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideCsrContext", reflect.TypeOf((*MockSecurityAPI)(nil).DecideCsrContext), arg0, arg1)
+}
+
+// DeleteCa mocks base method.
+func (m *MockSecurityAPI) DeleteCa(arg0 *quobyte.DeleteCaRequest) (*quobyte.DeleteCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCa", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCa indicates an expected call of DeleteCa.
+func (mr *MockSecurityAPIMockRecorder) DeleteCa(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCa", reflect.TypeOf((*MockSecurityAPI)(nil).DeleteCa), arg0)
+}
+
+// DeleteCaContext mocks base method.
+func (m *MockSecurityAPI) DeleteCaContext(arg0 context.Context, arg1 *quobyte.DeleteCaRequest) (*quobyte.DeleteCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCaContext indicates an expected call of DeleteCaContext.
+func (mr *MockSecurityAPIMockRecorder) DeleteCaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCaContext", reflect.TypeOf((*MockSecurityAPI)(nil).DeleteCaContext), arg0, arg1)
+}
+
+// DeleteCertificate mocks base method.
+func (m *MockSecurityAPI) DeleteCertificate(arg0 *quobyte.DeleteCertificateRequest) (*quobyte.DeleteCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertificate", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertificate indicates an expected call of DeleteCertificate.
+func (mr *MockSecurityAPIMockRecorder) DeleteCertificate(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificate", reflect.TypeOf((*MockSecurityAPI)(nil).DeleteCertificate), arg0)
+}
+
+// DeleteCertificateContext mocks base method.
+func (m *MockSecurityAPI) DeleteCertificateContext(arg0 context.Context, arg1 *quobyte.DeleteCertificateRequest) (*quobyte.DeleteCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCertificateContext indicates an expected call of DeleteCertificateContext.
+func (mr *MockSecurityAPIMockRecorder) DeleteCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCertificateContext", reflect.TypeOf((*MockSecurityAPI)(nil).DeleteCertificateContext), arg0, arg1)
+}
+
+// DeleteCsr mocks base method.
+func (m *MockSecurityAPI) DeleteCsr(arg0 *quobyte.DeleteCsrRequest) (*quobyte.DeleteCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCsr", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCsr indicates an expected call of DeleteCsr.
+func (mr *MockSecurityAPIMockRecorder) DeleteCsr(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCsr", reflect.TypeOf((*MockSecurityAPI)(nil).DeleteCsr), arg0)
+}
+
+// DeleteCsrContext mocks base method.
+func (m *MockSecurityAPI) DeleteCsrContext(arg0 context.Context, arg1 *quobyte.DeleteCsrRequest) (*quobyte.DeleteCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCsrContext indicates an expected call of DeleteCsrContext.
+func (mr *MockSecurityAPIMockRecorder) DeleteCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCsrContext", reflect.TypeOf((*MockSecurityAPI)(nil).DeleteCsrContext), arg0, arg1)
+}
+
+// ExportCertificate mocks base method.
+func (m *MockSecurityAPI) ExportCertificate(arg0 *quobyte.ExportCertificateRequest) (*quobyte.ExportCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCertificate", arg0)
+	ret0, _ := ret[0].(*quobyte.ExportCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCertificate indicates an expected call of ExportCertificate.
+func (mr *MockSecurityAPIMockRecorder) ExportCertificate(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCertificate", reflect.TypeOf((*MockSecurityAPI)(nil).ExportCertificate), arg0)
+}
+
+// ExportCertificateContext mocks base method.
+func (m *MockSecurityAPI) ExportCertificateContext(arg0 context.Context, arg1 *quobyte.ExportCertificateRequest) (*quobyte.ExportCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCertificateContext indicates an expected call of ExportCertificateContext.
+func (mr *MockSecurityAPIMockRecorder) ExportCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCertificateContext", reflect.TypeOf((*MockSecurityAPI)(nil).ExportCertificateContext), arg0, arg1)
+}
+
+// GetAddKeySlotData mocks base method.
+func (m *MockSecurityAPI) GetAddKeySlotData(arg0 *quobyte.GetAddKeySlotDataRequest) (*quobyte.GetAddKeySlotDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddKeySlotData", arg0)
+	ret0, _ := ret[0].(*quobyte.GetAddKeySlotDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddKeySlotData indicates an expected call of GetAddKeySlotData.
+func (mr *MockSecurityAPIMockRecorder) GetAddKeySlotData(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddKeySlotData", reflect.TypeOf((*MockSecurityAPI)(nil).GetAddKeySlotData), arg0)
+}
+
+// GetAddKeySlotDataContext mocks base method.
+func (m *MockSecurityAPI) GetAddKeySlotDataContext(arg0 context.Context, arg1 *quobyte.GetAddKeySlotDataRequest) (*quobyte.GetAddKeySlotDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddKeySlotDataContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAddKeySlotDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddKeySlotDataContext indicates an expected call of GetAddKeySlotDataContext.
+func (mr *MockSecurityAPIMockRecorder) GetAddKeySlotDataContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddKeySlotDataContext", reflect.TypeOf((*MockSecurityAPI)(nil).GetAddKeySlotDataContext), arg0, arg1)
+}
+
+// GetCertificateSubject mocks base method.
+func (m *MockSecurityAPI) GetCertificateSubject(arg0 *quobyte.GetCertificateSubjectRequest) (*quobyte.GetCertificateSubjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateSubject", arg0)
+	ret0, _ := ret[0].(*quobyte.GetCertificateSubjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateSubject indicates an expected call of GetCertificateSubject.
+func (mr *MockSecurityAPIMockRecorder) GetCertificateSubject(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateSubject", reflect.TypeOf((*MockSecurityAPI)(nil).GetCertificateSubject), arg0)
+}
+
+// GetCertificateSubjectContext mocks base method.
+func (m *MockSecurityAPI) GetCertificateSubjectContext(arg0 context.Context, arg1 *quobyte.GetCertificateSubjectRequest) (*quobyte.GetCertificateSubjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateSubjectContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetCertificateSubjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateSubjectContext indicates an expected call of GetCertificateSubjectContext.
+func (mr *MockSecurityAPIMockRecorder) GetCertificateSubjectContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateSubjectContext", reflect.TypeOf((*MockSecurityAPI)(nil).GetCertificateSubjectContext), arg0, arg1)
+}
+
+// GetDefaultKeyStoreSlotParams mocks base method.
+func (m *MockSecurityAPI) GetDefaultKeyStoreSlotParams(arg0 *quobyte.GetDefaultKeyStoreSlotParamsRequest) (*quobyte.GetDefaultKeyStoreSlotParamsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultKeyStoreSlotParams", arg0)
+	ret0, _ := ret[0].(*quobyte.GetDefaultKeyStoreSlotParamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultKeyStoreSlotParams indicates an expected call of GetDefaultKeyStoreSlotParams.
+func (mr *MockSecurityAPIMockRecorder) GetDefaultKeyStoreSlotParams(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultKeyStoreSlotParams", reflect.TypeOf((*MockSecurityAPI)(nil).GetDefaultKeyStoreSlotParams), arg0)
+}
+
+// GetDefaultKeyStoreSlotParamsContext mocks base method.
+func (m *MockSecurityAPI) GetDefaultKeyStoreSlotParamsContext(arg0 context.Context, arg1 *quobyte.GetDefaultKeyStoreSlotParamsRequest) (*quobyte.GetDefaultKeyStoreSlotParamsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultKeyStoreSlotParamsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetDefaultKeyStoreSlotParamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultKeyStoreSlotParamsContext indicates an expected call of GetDefaultKeyStoreSlotParamsContext.
+func (mr *MockSecurityAPIMockRecorder) GetDefaultKeyStoreSlotParamsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultKeyStoreSlotParamsContext", reflect.TypeOf((*MockSecurityAPI)(nil).GetDefaultKeyStoreSlotParamsContext), arg0, arg1)
+}
+
+// GetEncryptStatus mocks base method.
+func (m *MockSecurityAPI) GetEncryptStatus(arg0 *quobyte.GetEncryptStatusRequest) (*quobyte.GetEncryptStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptStatus", arg0)
+	ret0, _ := ret[0].(*quobyte.GetEncryptStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptStatus indicates an expected call of GetEncryptStatus.
+func (mr *MockSecurityAPIMockRecorder) GetEncryptStatus(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptStatus", reflect.TypeOf((*MockSecurityAPI)(nil).GetEncryptStatus), arg0)
+}
+
+// GetEncryptStatusContext mocks base method.
+func (m *MockSecurityAPI) GetEncryptStatusContext(arg0 context.Context, arg1 *quobyte.GetEncryptStatusRequest) (*quobyte.GetEncryptStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptStatusContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetEncryptStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptStatusContext indicates an expected call of GetEncryptStatusContext.
+func (mr *MockSecurityAPIMockRecorder) GetEncryptStatusContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptStatusContext", reflect.TypeOf((*MockSecurityAPI)(nil).GetEncryptStatusContext), arg0, arg1)
+}
+
+// GetEncryptedVolumeKey mocks base method.
+func (m *MockSecurityAPI) GetEncryptedVolumeKey(arg0 *quobyte.GetEncryptedVolumeKeyRequest) (*quobyte.GetEncryptedVolumeKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptedVolumeKey", arg0)
+	ret0, _ := ret[0].(*quobyte.GetEncryptedVolumeKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptedVolumeKey indicates an expected call of GetEncryptedVolumeKey.
+func (mr *MockSecurityAPIMockRecorder) GetEncryptedVolumeKey(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptedVolumeKey", reflect.TypeOf((*MockSecurityAPI)(nil).GetEncryptedVolumeKey), arg0)
+}
+
+// GetEncryptedVolumeKeyContext mocks base method.
+func (m *MockSecurityAPI) GetEncryptedVolumeKeyContext(arg0 context.Context, arg1 *quobyte.GetEncryptedVolumeKeyRequest) (*quobyte.GetEncryptedVolumeKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptedVolumeKeyContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetEncryptedVolumeKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptedVolumeKeyContext indicates an expected call of GetEncryptedVolumeKeyContext.
+func (mr *MockSecurityAPIMockRecorder) GetEncryptedVolumeKeyContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptedVolumeKeyContext", reflect.TypeOf((*MockSecurityAPI)(nil).GetEncryptedVolumeKeyContext), arg0, arg1)
+}
+
+// GetKeyStoreSlotWithoutHash mocks base method.
+func (m *MockSecurityAPI) GetKeyStoreSlotWithoutHash(arg0 *quobyte.GetKeyStoreSlotWithoutHashRequest) (*quobyte.GetKeyStoreSlotWithoutHashResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyStoreSlotWithoutHash", arg0)
+	ret0, _ := ret[0].(*quobyte.GetKeyStoreSlotWithoutHashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyStoreSlotWithoutHash indicates an expected call of GetKeyStoreSlotWithoutHash.
+func (mr *MockSecurityAPIMockRecorder) GetKeyStoreSlotWithoutHash(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyStoreSlotWithoutHash", reflect.TypeOf((*MockSecurityAPI)(nil).GetKeyStoreSlotWithoutHash), arg0)
+}
+
+// GetKeyStoreSlotWithoutHashContext mocks base method.
+func (m *MockSecurityAPI) GetKeyStoreSlotWithoutHashContext(arg0 context.Context, arg1 *quobyte.GetKeyStoreSlotWithoutHashRequest) (*quobyte.GetKeyStoreSlotWithoutHashResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyStoreSlotWithoutHashContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetKeyStoreSlotWithoutHashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyStoreSlotWithoutHashContext indicates an expected call of GetKeyStoreSlotWithoutHashContext.
+func (mr *MockSecurityAPIMockRecorder) GetKeyStoreSlotWithoutHashContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyStoreSlotWithoutHashContext", reflect.TypeOf((*MockSecurityAPI)(nil).GetKeyStoreSlotWithoutHashContext), arg0, arg1)
+}
+
+// GetMasterKeystoreSlots mocks base method.
+func (m *MockSecurityAPI) GetMasterKeystoreSlots(arg0 *quobyte.GetMasterKeystoreSlotsRequest) (*quobyte.GetMasterKeystoreSlotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterKeystoreSlots", arg0)
+	ret0, _ := ret[0].(*quobyte.GetMasterKeystoreSlotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterKeystoreSlots indicates an expected call of GetMasterKeystoreSlots.
+func (mr *MockSecurityAPIMockRecorder) GetMasterKeystoreSlots(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterKeystoreSlots", reflect.TypeOf((*MockSecurityAPI)(nil).GetMasterKeystoreSlots), arg0)
+}
+
+// GetMasterKeystoreSlotsContext mocks base method.
+func (m *MockSecurityAPI) GetMasterKeystoreSlotsContext(arg0 context.Context, arg1 *quobyte.GetMasterKeystoreSlotsRequest) (*quobyte.GetMasterKeystoreSlotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMasterKeystoreSlotsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetMasterKeystoreSlotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMasterKeystoreSlotsContext indicates an expected call of GetMasterKeystoreSlotsContext.
+func (mr *MockSecurityAPIMockRecorder) GetMasterKeystoreSlotsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterKeystoreSlotsContext", reflect.TypeOf((*MockSecurityAPI)(nil).GetMasterKeystoreSlotsContext), arg0, arg1)
+}
+
+// ListCa mocks base method.
+func (m *MockSecurityAPI) ListCa(arg0 *quobyte.ListCaRequest) (*quobyte.ListCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCa", arg0)
+	ret0, _ := ret[0].(*quobyte.ListCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCa indicates an expected call of ListCa.
+func (mr *MockSecurityAPIMockRecorder) ListCa(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCa", reflect.TypeOf((*MockSecurityAPI)(nil).ListCa), arg0)
+}
+
+// ListCaContext mocks base method.
+func (m *MockSecurityAPI) ListCaContext(arg0 context.Context, arg1 *quobyte.ListCaRequest) (*quobyte.ListCaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCaContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListCaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCaContext indicates an expected call of ListCaContext.
+func (mr *MockSecurityAPIMockRecorder) ListCaContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCaContext", reflect.TypeOf((*MockSecurityAPI)(nil).ListCaContext), arg0, arg1)
+}
+
+// ListCertificates mocks base method.
+func (m *MockSecurityAPI) ListCertificates(arg0 *quobyte.ListCertificatesRequest) (*quobyte.ListCertificatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificates", arg0)
+	ret0, _ := ret[0].(*quobyte.ListCertificatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertificates indicates an expected call of ListCertificates.
+func (mr *MockSecurityAPIMockRecorder) ListCertificates(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificates", reflect.TypeOf((*MockSecurityAPI)(nil).ListCertificates), arg0)
+}
+
+// ListCertificatesContext mocks base method.
+func (m *MockSecurityAPI) ListCertificatesContext(arg0 context.Context, arg1 *quobyte.ListCertificatesRequest) (*quobyte.ListCertificatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificatesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListCertificatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertificatesContext indicates an expected call of ListCertificatesContext.
+func (mr *MockSecurityAPIMockRecorder) ListCertificatesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificatesContext", reflect.TypeOf((*MockSecurityAPI)(nil).ListCertificatesContext), arg0, arg1)
+}
+
+// ListCsr mocks base method.
+func (m *MockSecurityAPI) ListCsr(arg0 *quobyte.ListCsrRequest) (*quobyte.ListCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCsr", arg0)
+	ret0, _ := ret[0].(*quobyte.ListCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCsr indicates an expected call of ListCsr.
+func (mr *MockSecurityAPIMockRecorder) ListCsr(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCsr", reflect.TypeOf((*MockSecurityAPI)(nil).ListCsr), arg0)
+}
+
+// ListCsrContext mocks base method.
+func (m *MockSecurityAPI) ListCsrContext(arg0 context.Context, arg1 *quobyte.ListCsrRequest) (*quobyte.ListCsrResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCsrContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListCsrResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCsrContext indicates an expected call of ListCsrContext.
+func (mr *MockSecurityAPIMockRecorder) ListCsrContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCsrContext", reflect.TypeOf((*MockSecurityAPI)(nil).ListCsrContext), arg0, arg1)
+}
+
+// RemoveKeystoreSlot mocks base method.
+func (m *MockSecurityAPI) RemoveKeystoreSlot(arg0 *quobyte.RemoveKeystoreSlotRequest) (*quobyte.RemoveKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveKeystoreSlot", arg0)
+	ret0, _ := ret[0].(*quobyte.RemoveKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveKeystoreSlot indicates an expected call of RemoveKeystoreSlot.
+func (mr *MockSecurityAPIMockRecorder) RemoveKeystoreSlot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKeystoreSlot", reflect.TypeOf((*MockSecurityAPI)(nil).RemoveKeystoreSlot), arg0)
+}
+
+// RemoveKeystoreSlotContext mocks base method.
+func (m *MockSecurityAPI) RemoveKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.RemoveKeystoreSlotRequest) (*quobyte.RemoveKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RemoveKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveKeystoreSlotContext indicates an expected call of RemoveKeystoreSlotContext.
+func (mr *MockSecurityAPIMockRecorder) RemoveKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKeystoreSlotContext", reflect.TypeOf((*MockSecurityAPI)(nil).RemoveKeystoreSlotContext), arg0, arg1)
+}
+
+// RemoveMasterKeystoreSlot mocks base method.
+func (m *MockSecurityAPI) RemoveMasterKeystoreSlot(arg0 *quobyte.RemoveMasterKeystoreSlotRequest) (*quobyte.RemoveMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMasterKeystoreSlot", arg0)
+	ret0, _ := ret[0].(*quobyte.RemoveMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMasterKeystoreSlot indicates an expected call of RemoveMasterKeystoreSlot.
+func (mr *MockSecurityAPIMockRecorder) RemoveMasterKeystoreSlot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMasterKeystoreSlot", reflect.TypeOf((*MockSecurityAPI)(nil).RemoveMasterKeystoreSlot), arg0)
+}
+
+// RemoveMasterKeystoreSlotContext mocks base method.
+func (m *MockSecurityAPI) RemoveMasterKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.RemoveMasterKeystoreSlotRequest) (*quobyte.RemoveMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMasterKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RemoveMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMasterKeystoreSlotContext indicates an expected call of RemoveMasterKeystoreSlotContext.
+func (mr *MockSecurityAPIMockRecorder) RemoveMasterKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMasterKeystoreSlotContext", reflect.TypeOf((*MockSecurityAPI)(nil).RemoveMasterKeystoreSlotContext), arg0, arg1)
+}
+
+// RevokeCertificate mocks base method.
+func (m *MockSecurityAPI) RevokeCertificate(arg0 *quobyte.RevokeCertificateRequest) (*quobyte.RevokeCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificate", arg0)
+	ret0, _ := ret[0].(*quobyte.RevokeCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCertificate indicates an expected call of RevokeCertificate.
+func (mr *MockSecurityAPIMockRecorder) RevokeCertificate(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificate", reflect.TypeOf((*MockSecurityAPI)(nil).RevokeCertificate), arg0)
+}
+
+// RevokeCertificateContext mocks base method.
+func (m *MockSecurityAPI) RevokeCertificateContext(arg0 context.Context, arg1 *quobyte.RevokeCertificateRequest) (*quobyte.RevokeCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificateContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RevokeCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCertificateContext indicates an expected call of RevokeCertificateContext.
+func (mr *MockSecurityAPIMockRecorder) RevokeCertificateContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificateContext", reflect.TypeOf((*MockSecurityAPI)(nil).RevokeCertificateContext), arg0, arg1)
+}
+
+// SetCertificateOwner mocks base method.
+func (m *MockSecurityAPI) SetCertificateOwner(arg0 *quobyte.SetCertificateOwnerRequest) (*quobyte.SetCertificateOwnerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCertificateOwner", arg0)
+	ret0, _ := ret[0].(*quobyte.SetCertificateOwnerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCertificateOwner indicates an expected call of SetCertificateOwner.
+func (mr *MockSecurityAPIMockRecorder) SetCertificateOwner(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateOwner", reflect.TypeOf((*MockSecurityAPI)(nil).SetCertificateOwner), arg0)
+}
+
+// SetCertificateOwnerContext mocks base method.
+func (m *MockSecurityAPI) SetCertificateOwnerContext(arg0 context.Context, arg1 *quobyte.SetCertificateOwnerRequest) (*quobyte.SetCertificateOwnerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCertificateOwnerContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetCertificateOwnerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCertificateOwnerContext indicates an expected call of SetCertificateOwnerContext.
+func (mr *MockSecurityAPIMockRecorder) SetCertificateOwnerContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateOwnerContext", reflect.TypeOf((*MockSecurityAPI)(nil).SetCertificateOwnerContext), arg0, arg1)
+}
+
+// SetCertificateSubject mocks base method.
+func (m *MockSecurityAPI) SetCertificateSubject(arg0 *quobyte.SetCertificateSubjectRequest) (*quobyte.SetCertificateSubjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCertificateSubject", arg0)
+	ret0, _ := ret[0].(*quobyte.SetCertificateSubjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCertificateSubject indicates an expected call of SetCertificateSubject.
+func (mr *MockSecurityAPIMockRecorder) SetCertificateSubject(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateSubject", reflect.TypeOf((*MockSecurityAPI)(nil).SetCertificateSubject), arg0)
+}
+
+// SetCertificateSubjectContext mocks base method.
+func (m *MockSecurityAPI) SetCertificateSubjectContext(arg0 context.Context, arg1 *quobyte.SetCertificateSubjectRequest) (*quobyte.SetCertificateSubjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCertificateSubjectContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetCertificateSubjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCertificateSubjectContext indicates an expected call of SetCertificateSubjectContext.
+func (mr *MockSecurityAPIMockRecorder) SetCertificateSubjectContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCertificateSubjectContext", reflect.TypeOf((*MockSecurityAPI)(nil).SetCertificateSubjectContext), arg0, arg1)
+}
+
+// SetEncryptedVolumeKey mocks base method.
+func (m *MockSecurityAPI) SetEncryptedVolumeKey(arg0 *quobyte.SetEncryptedVolumeKeyRequest) (*quobyte.SetEncryptedVolumeKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEncryptedVolumeKey", arg0)
+	ret0, _ := ret[0].(*quobyte.SetEncryptedVolumeKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEncryptedVolumeKey indicates an expected call of SetEncryptedVolumeKey.
+func (mr *MockSecurityAPIMockRecorder) SetEncryptedVolumeKey(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncryptedVolumeKey", reflect.TypeOf((*MockSecurityAPI)(nil).SetEncryptedVolumeKey), arg0)
+}
+
+// SetEncryptedVolumeKeyContext mocks base method.
+func (m *MockSecurityAPI) SetEncryptedVolumeKeyContext(arg0 context.Context, arg1 *quobyte.SetEncryptedVolumeKeyRequest) (*quobyte.SetEncryptedVolumeKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEncryptedVolumeKeyContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetEncryptedVolumeKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEncryptedVolumeKeyContext indicates an expected call of SetEncryptedVolumeKeyContext.
+func (mr *MockSecurityAPIMockRecorder) SetEncryptedVolumeKeyContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEncryptedVolumeKeyContext", reflect.TypeOf((*MockSecurityAPI)(nil).SetEncryptedVolumeKeyContext), arg0, arg1)
+}
+
+// UnlockMasterKeystoreSlot mocks base method.
+func (m *MockSecurityAPI) UnlockMasterKeystoreSlot(arg0 *quobyte.UnlockMasterKeystoreSlotRequest) (*quobyte.UnlockMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockMasterKeystoreSlot", arg0)
+	ret0, _ := ret[0].(*quobyte.UnlockMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockMasterKeystoreSlot indicates an expected call of UnlockMasterKeystoreSlot.
+func (mr *MockSecurityAPIMockRecorder) UnlockMasterKeystoreSlot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockMasterKeystoreSlot", reflect.TypeOf((*MockSecurityAPI)(nil).UnlockMasterKeystoreSlot), arg0)
+}
+
+// UnlockMasterKeystoreSlotContext mocks base method.
+func (m *MockSecurityAPI) UnlockMasterKeystoreSlotContext(arg0 context.Context, arg1 *quobyte.UnlockMasterKeystoreSlotRequest) (*quobyte.UnlockMasterKeystoreSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockMasterKeystoreSlotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UnlockMasterKeystoreSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockMasterKeystoreSlotContext indicates an expected call of UnlockMasterKeystoreSlotContext.
+func (mr *MockSecurityAPIMockRecorder) UnlockMasterKeystoreSlotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockMasterKeystoreSlotContext", reflect.TypeOf((*MockSecurityAPI)(nil).UnlockMasterKeystoreSlotContext), arg0, arg1)
+}
@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: SnapshotAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_snapshot_api.go github.com/quobyte/api/quobyte SnapshotAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSnapshotAPI is a mock of SnapshotAPI interface.
+type MockSnapshotAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotAPIMockRecorder
+}
+
+// MockSnapshotAPIMockRecorder is the mock recorder for MockSnapshotAPI.
+type MockSnapshotAPIMockRecorder struct {
+	mock *MockSnapshotAPI
+}
+
+// NewMockSnapshotAPI creates a new mock instance.
+func NewMockSnapshotAPI(ctrl *gomock.Controller) *MockSnapshotAPI {
+	mock := &MockSnapshotAPI{ctrl: ctrl}
+	mock.recorder = &MockSnapshotAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotAPI) EXPECT() *MockSnapshotAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockSnapshotAPI) CreateSnapshot(arg0 *quobyte.CreateSnapshotRequest) (*quobyte.CreateSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockSnapshotAPIMockRecorder) CreateSnapshot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockSnapshotAPI)(nil).CreateSnapshot), arg0)
+}
+
+// CreateSnapshotContext mocks base method.
+func (m *MockSnapshotAPI) CreateSnapshotContext(arg0 context.Context, arg1 *quobyte.CreateSnapshotRequest) (*quobyte.CreateSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotContext indicates an expected call of CreateSnapshotContext.
+func (mr *MockSnapshotAPIMockRecorder) CreateSnapshotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotContext", reflect.TypeOf((*MockSnapshotAPI)(nil).CreateSnapshotContext), arg0, arg1)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockSnapshotAPI) DeleteSnapshot(arg0 *quobyte.DeleteSnapshotRequest) (*quobyte.DeleteSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockSnapshotAPIMockRecorder) DeleteSnapshot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockSnapshotAPI)(nil).DeleteSnapshot), arg0)
+}
+
+// DeleteSnapshotContext mocks base method.
+func (m *MockSnapshotAPI) DeleteSnapshotContext(arg0 context.Context, arg1 *quobyte.DeleteSnapshotRequest) (*quobyte.DeleteSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshotContext indicates an expected call of DeleteSnapshotContext.
+func (mr *MockSnapshotAPIMockRecorder) DeleteSnapshotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotContext", reflect.TypeOf((*MockSnapshotAPI)(nil).DeleteSnapshotContext), arg0, arg1)
+}
+
+// EraseSnapshot mocks base method.
+func (m *MockSnapshotAPI) EraseSnapshot(arg0 *quobyte.EraseSnapshotRequest) (*quobyte.EraseSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseSnapshot", arg0)
+	ret0, _ := ret[0].(*quobyte.EraseSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseSnapshot indicates an expected call of EraseSnapshot.
+func (mr *MockSnapshotAPIMockRecorder) EraseSnapshot(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseSnapshot", reflect.TypeOf((*MockSnapshotAPI)(nil).EraseSnapshot), arg0)
+}
+
+// EraseSnapshotContext mocks base method.
+func (m *MockSnapshotAPI) EraseSnapshotContext(arg0 context.Context, arg1 *quobyte.EraseSnapshotRequest) (*quobyte.EraseSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseSnapshotContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.EraseSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseSnapshotContext indicates an expected call of EraseSnapshotContext.
+func (mr *MockSnapshotAPIMockRecorder) EraseSnapshotContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseSnapshotContext", reflect.TypeOf((*MockSnapshotAPI)(nil).EraseSnapshotContext), arg0, arg1)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockSnapshotAPI) ListSnapshots(arg0 *quobyte.ListSnapshotsRequest) (*quobyte.ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", arg0)
+	ret0, _ := ret[0].(*quobyte.ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockSnapshotAPIMockRecorder) ListSnapshots(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockSnapshotAPI)(nil).ListSnapshots), arg0)
+}
+
+// ListSnapshotsContext mocks base method.
+func (m *MockSnapshotAPI) ListSnapshotsContext(arg0 context.Context, arg1 *quobyte.ListSnapshotsRequest) (*quobyte.ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshotsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotsContext indicates an expected call of ListSnapshotsContext.
+func (mr *MockSnapshotAPIMockRecorder) ListSnapshotsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotsContext", reflect.TypeOf((*MockSnapshotAPI)(nil).ListSnapshotsContext), arg0, arg1)
+}
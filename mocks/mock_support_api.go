@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: SupportAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_support_api.go github.com/quobyte/api/quobyte SupportAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSupportAPI is a mock of SupportAPI interface.
+type MockSupportAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupportAPIMockRecorder
+}
+
+// MockSupportAPIMockRecorder is the mock recorder for MockSupportAPI.
+type MockSupportAPIMockRecorder struct {
+	mock *MockSupportAPI
+}
+
+// NewMockSupportAPI creates a new mock instance.
+func NewMockSupportAPI(ctrl *gomock.Controller) *MockSupportAPI {
+	mock := &MockSupportAPI{ctrl: ctrl}
+	mock.recorder = &MockSupportAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupportAPI) EXPECT() *MockSupportAPIMockRecorder {
+	return m.recorder
+}
+
+// CancelSupportDump mocks base method.
+func (m *MockSupportAPI) CancelSupportDump(arg0 *quobyte.CancelSupportDumpRequest) (*quobyte.CancelSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSupportDump", arg0)
+	ret0, _ := ret[0].(*quobyte.CancelSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSupportDump indicates an expected call of CancelSupportDump.
+func (mr *MockSupportAPIMockRecorder) CancelSupportDump(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSupportDump", reflect.TypeOf((*MockSupportAPI)(nil).CancelSupportDump), arg0)
+}
+
+// CancelSupportDumpContext mocks base method.
+func (m *MockSupportAPI) CancelSupportDumpContext(arg0 context.Context, arg1 *quobyte.CancelSupportDumpRequest) (*quobyte.CancelSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSupportDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSupportDumpContext indicates an expected call of CancelSupportDumpContext.
+func (mr *MockSupportAPIMockRecorder) CancelSupportDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSupportDumpContext", reflect.TypeOf((*MockSupportAPI)(nil).CancelSupportDumpContext), arg0, arg1)
+}
+
+// GenerateAsyncSupportDump mocks base method.
+func (m *MockSupportAPI) GenerateAsyncSupportDump(arg0 *quobyte.GenerateAsyncSupportDumpRequest) (*quobyte.GenerateAsyncSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAsyncSupportDump", arg0)
+	ret0, _ := ret[0].(*quobyte.GenerateAsyncSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateAsyncSupportDump indicates an expected call of GenerateAsyncSupportDump.
+func (mr *MockSupportAPIMockRecorder) GenerateAsyncSupportDump(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAsyncSupportDump", reflect.TypeOf((*MockSupportAPI)(nil).GenerateAsyncSupportDump), arg0)
+}
+
+// GenerateAsyncSupportDumpContext mocks base method.
+func (m *MockSupportAPI) GenerateAsyncSupportDumpContext(arg0 context.Context, arg1 *quobyte.GenerateAsyncSupportDumpRequest) (*quobyte.GenerateAsyncSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAsyncSupportDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GenerateAsyncSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateAsyncSupportDumpContext indicates an expected call of GenerateAsyncSupportDumpContext.
+func (mr *MockSupportAPIMockRecorder) GenerateAsyncSupportDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAsyncSupportDumpContext", reflect.TypeOf((*MockSupportAPI)(nil).GenerateAsyncSupportDumpContext), arg0, arg1)
+}
+
+// GetServiceDump mocks base method.
+func (m *MockSupportAPI) GetServiceDump(arg0 *quobyte.GetServiceDumpRequest) (*quobyte.GetServiceDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceDump", arg0)
+	ret0, _ := ret[0].(*quobyte.GetServiceDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceDump indicates an expected call of GetServiceDump.
+func (mr *MockSupportAPIMockRecorder) GetServiceDump(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceDump", reflect.TypeOf((*MockSupportAPI)(nil).GetServiceDump), arg0)
+}
+
+// GetServiceDumpContext mocks base method.
+func (m *MockSupportAPI) GetServiceDumpContext(arg0 context.Context, arg1 *quobyte.GetServiceDumpRequest) (*quobyte.GetServiceDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetServiceDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceDumpContext indicates an expected call of GetServiceDumpContext.
+func (mr *MockSupportAPIMockRecorder) GetServiceDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceDumpContext", reflect.TypeOf((*MockSupportAPI)(nil).GetServiceDumpContext), arg0, arg1)
+}
+
+// GetSupportDump mocks base method.
+func (m *MockSupportAPI) GetSupportDump(arg0 *quobyte.GetSupportDumpRequest) (*quobyte.GetSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportDump", arg0)
+	ret0, _ := ret[0].(*quobyte.GetSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportDump indicates an expected call of GetSupportDump.
+func (mr *MockSupportAPIMockRecorder) GetSupportDump(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDump", reflect.TypeOf((*MockSupportAPI)(nil).GetSupportDump), arg0)
+}
+
+// GetSupportDumpContext mocks base method.
+func (m *MockSupportAPI) GetSupportDumpContext(arg0 context.Context, arg1 *quobyte.GetSupportDumpRequest) (*quobyte.GetSupportDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetSupportDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportDumpContext indicates an expected call of GetSupportDumpContext.
+func (mr *MockSupportAPIMockRecorder) GetSupportDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDumpContext", reflect.TypeOf((*MockSupportAPI)(nil).GetSupportDumpContext), arg0, arg1)
+}
+
+// GetSupportDumpStatus mocks base method.
+func (m *MockSupportAPI) GetSupportDumpStatus(arg0 *quobyte.GetSupportDumpStatusRequest) (*quobyte.GetSupportDumpStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportDumpStatus", arg0)
+	ret0, _ := ret[0].(*quobyte.GetSupportDumpStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportDumpStatus indicates an expected call of GetSupportDumpStatus.
+func (mr *MockSupportAPIMockRecorder) GetSupportDumpStatus(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDumpStatus", reflect.TypeOf((*MockSupportAPI)(nil).GetSupportDumpStatus), arg0)
+}
+
+// GetSupportDumpStatusContext mocks base method.
+func (m *MockSupportAPI) GetSupportDumpStatusContext(arg0 context.Context, arg1 *quobyte.GetSupportDumpStatusRequest) (*quobyte.GetSupportDumpStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportDumpStatusContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetSupportDumpStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportDumpStatusContext indicates an expected call of GetSupportDumpStatusContext.
+func (mr *MockSupportAPIMockRecorder) GetSupportDumpStatusContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportDumpStatusContext", reflect.TypeOf((*MockSupportAPI)(nil).GetSupportDumpStatusContext), arg0, arg1)
+}
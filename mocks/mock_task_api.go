@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: TaskAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_task_api.go github.com/quobyte/api/quobyte TaskAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskAPI is a mock of TaskAPI interface.
+type MockTaskAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskAPIMockRecorder
+}
+
+// MockTaskAPIMockRecorder is the mock recorder for MockTaskAPI.
+type MockTaskAPIMockRecorder struct {
+	mock *MockTaskAPI
+}
+
+// NewMockTaskAPI creates a new mock instance.
+func NewMockTaskAPI(ctrl *gomock.Controller) *MockTaskAPI {
+	mock := &MockTaskAPI{ctrl: ctrl}
+	mock.recorder = &MockTaskAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskAPI) EXPECT() *MockTaskAPIMockRecorder {
+	return m.recorder
+}
+
+// CancelTask mocks base method.
+func (m *MockTaskAPI) CancelTask(arg0 *quobyte.CancelTaskRequest) (*quobyte.CancelTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTask", arg0)
+	ret0, _ := ret[0].(*quobyte.CancelTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTask indicates an expected call of CancelTask.
+func (mr *MockTaskAPIMockRecorder) CancelTask(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTask", reflect.TypeOf((*MockTaskAPI)(nil).CancelTask), arg0)
+}
+
+// CancelTaskContext mocks base method.
+func (m *MockTaskAPI) CancelTaskContext(arg0 context.Context, arg1 *quobyte.CancelTaskRequest) (*quobyte.CancelTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTaskContext indicates an expected call of CancelTaskContext.
+func (mr *MockTaskAPIMockRecorder) CancelTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTaskContext", reflect.TypeOf((*MockTaskAPI)(nil).CancelTaskContext), arg0, arg1)
+}
+
+// CreateTask mocks base method.
+func (m *MockTaskAPI) CreateTask(arg0 *quobyte.CreateTaskRequest) (*quobyte.CreateTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockTaskAPIMockRecorder) CreateTask(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockTaskAPI)(nil).CreateTask), arg0)
+}
+
+// CreateTaskContext mocks base method.
+func (m *MockTaskAPI) CreateTaskContext(arg0 context.Context, arg1 *quobyte.CreateTaskRequest) (*quobyte.CreateTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaskContext indicates an expected call of CreateTaskContext.
+func (mr *MockTaskAPIMockRecorder) CreateTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskContext", reflect.TypeOf((*MockTaskAPI)(nil).CreateTaskContext), arg0, arg1)
+}
+
+// GetTaskList mocks base method.
+func (m *MockTaskAPI) GetTaskList(arg0 *quobyte.GetTaskListRequest) (*quobyte.GetTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskList", arg0)
+	ret0, _ := ret[0].(*quobyte.GetTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskList indicates an expected call of GetTaskList.
+func (mr *MockTaskAPIMockRecorder) GetTaskList(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskList", reflect.TypeOf((*MockTaskAPI)(nil).GetTaskList), arg0)
+}
+
+// GetTaskListContext mocks base method.
+func (m *MockTaskAPI) GetTaskListContext(arg0 context.Context, arg1 *quobyte.GetTaskListRequest) (*quobyte.GetTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListContext indicates an expected call of GetTaskListContext.
+func (mr *MockTaskAPIMockRecorder) GetTaskListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListContext", reflect.TypeOf((*MockTaskAPI)(nil).GetTaskListContext), arg0, arg1)
+}
+
+// ResumeTask mocks base method.
+func (m *MockTaskAPI) ResumeTask(arg0 *quobyte.ResumeTaskRequest) (*quobyte.ResumeTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTask", arg0)
+	ret0, _ := ret[0].(*quobyte.ResumeTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTask indicates an expected call of ResumeTask.
+func (mr *MockTaskAPIMockRecorder) ResumeTask(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTask", reflect.TypeOf((*MockTaskAPI)(nil).ResumeTask), arg0)
+}
+
+// ResumeTaskContext mocks base method.
+func (m *MockTaskAPI) ResumeTaskContext(arg0 context.Context, arg1 *quobyte.ResumeTaskRequest) (*quobyte.ResumeTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResumeTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeTaskContext indicates an expected call of ResumeTaskContext.
+func (mr *MockTaskAPIMockRecorder) ResumeTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTaskContext", reflect.TypeOf((*MockTaskAPI)(nil).ResumeTaskContext), arg0, arg1)
+}
+
+// RetryTask mocks base method.
+func (m *MockTaskAPI) RetryTask(arg0 *quobyte.RetryTaskRequest) (*quobyte.RetryTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryTask", arg0)
+	ret0, _ := ret[0].(*quobyte.RetryTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryTask indicates an expected call of RetryTask.
+func (mr *MockTaskAPIMockRecorder) RetryTask(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTask", reflect.TypeOf((*MockTaskAPI)(nil).RetryTask), arg0)
+}
+
+// RetryTaskContext mocks base method.
+func (m *MockTaskAPI) RetryTaskContext(arg0 context.Context, arg1 *quobyte.RetryTaskRequest) (*quobyte.RetryTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryTaskContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.RetryTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryTaskContext indicates an expected call of RetryTaskContext.
+func (mr *MockTaskAPIMockRecorder) RetryTaskContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryTaskContext", reflect.TypeOf((*MockTaskAPI)(nil).RetryTaskContext), arg0, arg1)
+}
@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: TenantAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_tenant_api.go github.com/quobyte/api/quobyte TenantAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantAPI is a mock of TenantAPI interface.
+type MockTenantAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantAPIMockRecorder
+}
+
+// MockTenantAPIMockRecorder is the mock recorder for MockTenantAPI.
+type MockTenantAPIMockRecorder struct {
+	mock *MockTenantAPI
+}
+
+// NewMockTenantAPI creates a new mock instance.
+func NewMockTenantAPI(ctrl *gomock.Controller) *MockTenantAPI {
+	mock := &MockTenantAPI{ctrl: ctrl}
+	mock.recorder = &MockTenantAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantAPI) EXPECT() *MockTenantAPIMockRecorder {
+	return m.recorder
+}
+
+// DeleteTenant mocks base method.
+func (m *MockTenantAPI) DeleteTenant(arg0 *quobyte.DeleteTenantRequest) (*quobyte.DeleteTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenant", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenant indicates an expected call of DeleteTenant.
+func (mr *MockTenantAPIMockRecorder) DeleteTenant(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenant", reflect.TypeOf((*MockTenantAPI)(nil).DeleteTenant), arg0)
+}
+
+// DeleteTenantContext mocks base method.
+func (m *MockTenantAPI) DeleteTenantContext(arg0 context.Context, arg1 *quobyte.DeleteTenantRequest) (*quobyte.DeleteTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantContext indicates an expected call of DeleteTenantContext.
+func (mr *MockTenantAPIMockRecorder) DeleteTenantContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantContext", reflect.TypeOf((*MockTenantAPI)(nil).DeleteTenantContext), arg0, arg1)
+}
+
+// GetTenant mocks base method.
+func (m *MockTenantAPI) GetTenant(arg0 *quobyte.GetTenantRequest) (*quobyte.GetTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenant", arg0)
+	ret0, _ := ret[0].(*quobyte.GetTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenant indicates an expected call of GetTenant.
+func (mr *MockTenantAPIMockRecorder) GetTenant(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenant", reflect.TypeOf((*MockTenantAPI)(nil).GetTenant), arg0)
+}
+
+// GetTenantContext mocks base method.
+func (m *MockTenantAPI) GetTenantContext(arg0 context.Context, arg1 *quobyte.GetTenantRequest) (*quobyte.GetTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantContext indicates an expected call of GetTenantContext.
+func (mr *MockTenantAPIMockRecorder) GetTenantContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantContext", reflect.TypeOf((*MockTenantAPI)(nil).GetTenantContext), arg0, arg1)
+}
+
+// ResolveTenantName mocks base method.
+func (m *MockTenantAPI) ResolveTenantName(arg0 *quobyte.ResolveTenantNameRequest) (*quobyte.ResolveTenantNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTenantName", arg0)
+	ret0, _ := ret[0].(*quobyte.ResolveTenantNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTenantName indicates an expected call of ResolveTenantName.
+func (mr *MockTenantAPIMockRecorder) ResolveTenantName(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTenantName", reflect.TypeOf((*MockTenantAPI)(nil).ResolveTenantName), arg0)
+}
+
+// ResolveTenantNameContext mocks base method.
+func (m *MockTenantAPI) ResolveTenantNameContext(arg0 context.Context, arg1 *quobyte.ResolveTenantNameRequest) (*quobyte.ResolveTenantNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveTenantNameContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResolveTenantNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveTenantNameContext indicates an expected call of ResolveTenantNameContext.
+func (mr *MockTenantAPIMockRecorder) ResolveTenantNameContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveTenantNameContext", reflect.TypeOf((*MockTenantAPI)(nil).ResolveTenantNameContext), arg0, arg1)
+}
+
+// SetTenant mocks base method.
+func (m *MockTenantAPI) SetTenant(arg0 *quobyte.SetTenantRequest) (*quobyte.SetTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTenant", arg0)
+	ret0, _ := ret[0].(*quobyte.SetTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTenant indicates an expected call of SetTenant.
+func (mr *MockTenantAPIMockRecorder) SetTenant(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTenant", reflect.TypeOf((*MockTenantAPI)(nil).SetTenant), arg0)
+}
+
+// SetTenantContext mocks base method.
+func (m *MockTenantAPI) SetTenantContext(arg0 context.Context, arg1 *quobyte.SetTenantRequest) (*quobyte.SetTenantResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTenantContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.SetTenantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTenantContext indicates an expected call of SetTenantContext.
+func (mr *MockTenantAPIMockRecorder) SetTenantContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTenantContext", reflect.TypeOf((*MockTenantAPI)(nil).SetTenantContext), arg0, arg1)
+}
@@ -0,0 +1,281 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: UserAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_user_api.go github.com/quobyte/api/quobyte UserAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserAPI is a mock of UserAPI interface.
+type MockUserAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserAPIMockRecorder
+}
+
+// MockUserAPIMockRecorder is the mock recorder for MockUserAPI.
+type MockUserAPIMockRecorder struct {
+	mock *MockUserAPI
+}
+
+// NewMockUserAPI creates a new mock instance.
+func NewMockUserAPI(ctrl *gomock.Controller) *MockUserAPI {
+	mock := &MockUserAPI{ctrl: ctrl}
+	mock.recorder = &MockUserAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserAPI) EXPECT() *MockUserAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateAccessKeyCredentials mocks base method.
+func (m *MockUserAPI) CreateAccessKeyCredentials(arg0 *quobyte.CreateAccessKeyCredentialsRequest) (*quobyte.CreateAccessKeyCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessKeyCredentials", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateAccessKeyCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessKeyCredentials indicates an expected call of CreateAccessKeyCredentials.
+func (mr *MockUserAPIMockRecorder) CreateAccessKeyCredentials(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessKeyCredentials", reflect.TypeOf((*MockUserAPI)(nil).CreateAccessKeyCredentials), arg0)
+}
+
+// CreateAccessKeyCredentialsContext mocks base method.
+func (m *MockUserAPI) CreateAccessKeyCredentialsContext(arg0 context.Context, arg1 *quobyte.CreateAccessKeyCredentialsRequest) (*quobyte.CreateAccessKeyCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessKeyCredentialsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateAccessKeyCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessKeyCredentialsContext indicates an expected call of CreateAccessKeyCredentialsContext.
+func (mr *MockUserAPIMockRecorder) CreateAccessKeyCredentialsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessKeyCredentialsContext", reflect.TypeOf((*MockUserAPI)(nil).CreateAccessKeyCredentialsContext), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockUserAPI) CreateUser(arg0 *quobyte.CreateUserRequest) (*quobyte.CreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUserAPIMockRecorder) CreateUser(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserAPI)(nil).CreateUser), arg0)
+}
+
+// CreateUserContext mocks base method.
+func (m *MockUserAPI) CreateUserContext(arg0 context.Context, arg1 *quobyte.CreateUserRequest) (*quobyte.CreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserContext indicates an expected call of CreateUserContext.
+func (mr *MockUserAPIMockRecorder) CreateUserContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserContext", reflect.TypeOf((*MockUserAPI)(nil).CreateUserContext), arg0, arg1)
+}
+
+// DeleteAccessKeyCredentials mocks base method.
+func (m *MockUserAPI) DeleteAccessKeyCredentials(arg0 *quobyte.DeleteAccessKeyCredentialsRequest) (*quobyte.DeleteAccessKeyCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccessKeyCredentials", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteAccessKeyCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccessKeyCredentials indicates an expected call of DeleteAccessKeyCredentials.
+func (mr *MockUserAPIMockRecorder) DeleteAccessKeyCredentials(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessKeyCredentials", reflect.TypeOf((*MockUserAPI)(nil).DeleteAccessKeyCredentials), arg0)
+}
+
+// DeleteAccessKeyCredentialsContext mocks base method.
+func (m *MockUserAPI) DeleteAccessKeyCredentialsContext(arg0 context.Context, arg1 *quobyte.DeleteAccessKeyCredentialsRequest) (*quobyte.DeleteAccessKeyCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccessKeyCredentialsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteAccessKeyCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccessKeyCredentialsContext indicates an expected call of DeleteAccessKeyCredentialsContext.
+func (mr *MockUserAPIMockRecorder) DeleteAccessKeyCredentialsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessKeyCredentialsContext", reflect.TypeOf((*MockUserAPI)(nil).DeleteAccessKeyCredentialsContext), arg0, arg1)
+}
+
+// DeleteUser mocks base method.
+func (m *MockUserAPI) DeleteUser(arg0 *quobyte.DeleteUserRequest) (*quobyte.DeleteUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockUserAPIMockRecorder) DeleteUser(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserAPI)(nil).DeleteUser), arg0)
+}
+
+// DeleteUserContext mocks base method.
+func (m *MockUserAPI) DeleteUserContext(arg0 context.Context, arg1 *quobyte.DeleteUserRequest) (*quobyte.DeleteUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserContext indicates an expected call of DeleteUserContext.
+func (mr *MockUserAPIMockRecorder) DeleteUserContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserContext", reflect.TypeOf((*MockUserAPI)(nil).DeleteUserContext), arg0, arg1)
+}
+
+// GetUsers mocks base method.
+func (m *MockUserAPI) GetUsers(arg0 *quobyte.GetUsersRequest) (*quobyte.GetUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", arg0)
+	ret0, _ := ret[0].(*quobyte.GetUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockUserAPIMockRecorder) GetUsers(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserAPI)(nil).GetUsers), arg0)
+}
+
+// GetUsersContext mocks base method.
+func (m *MockUserAPI) GetUsersContext(arg0 context.Context, arg1 *quobyte.GetUsersRequest) (*quobyte.GetUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersContext indicates an expected call of GetUsersContext.
+func (mr *MockUserAPIMockRecorder) GetUsersContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersContext", reflect.TypeOf((*MockUserAPI)(nil).GetUsersContext), arg0, arg1)
+}
+
+// ImportAccessKeys mocks base method.
+func (m *MockUserAPI) ImportAccessKeys(arg0 *quobyte.ImportAccessKeysRequest) (*quobyte.ImportAccessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAccessKeys", arg0)
+	ret0, _ := ret[0].(*quobyte.ImportAccessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAccessKeys indicates an expected call of ImportAccessKeys.
+func (mr *MockUserAPIMockRecorder) ImportAccessKeys(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccessKeys", reflect.TypeOf((*MockUserAPI)(nil).ImportAccessKeys), arg0)
+}
+
+// ImportAccessKeysContext mocks base method.
+func (m *MockUserAPI) ImportAccessKeysContext(arg0 context.Context, arg1 *quobyte.ImportAccessKeysRequest) (*quobyte.ImportAccessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAccessKeysContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ImportAccessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAccessKeysContext indicates an expected call of ImportAccessKeysContext.
+func (mr *MockUserAPIMockRecorder) ImportAccessKeysContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccessKeysContext", reflect.TypeOf((*MockUserAPI)(nil).ImportAccessKeysContext), arg0, arg1)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUserAPI) UpdateUser(arg0 *quobyte.UpdateUserRequest) (*quobyte.UpdateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", arg0)
+	ret0, _ := ret[0].(*quobyte.UpdateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserAPIMockRecorder) UpdateUser(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserAPI)(nil).UpdateUser), arg0)
+}
+
+// UpdateUserContext mocks base method.
+func (m *MockUserAPI) UpdateUserContext(arg0 context.Context, arg1 *quobyte.UpdateUserRequest) (*quobyte.UpdateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserContext indicates an expected call of UpdateUserContext.
+func (mr *MockUserAPIMockRecorder) UpdateUserContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserContext", reflect.TypeOf((*MockUserAPI)(nil).UpdateUserContext), arg0, arg1)
+}
+
+// WhoAmI mocks base method.
+func (m *MockUserAPI) WhoAmI(arg0 *quobyte.WhoAmIRequest) (*quobyte.WhoAmIResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhoAmI", arg0)
+	ret0, _ := ret[0].(*quobyte.WhoAmIResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhoAmI indicates an expected call of WhoAmI.
+func (mr *MockUserAPIMockRecorder) WhoAmI(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhoAmI", reflect.TypeOf((*MockUserAPI)(nil).WhoAmI), arg0)
+}
+
+// WhoAmIContext mocks base method.
+func (m *MockUserAPI) WhoAmIContext(arg0 context.Context, arg1 *quobyte.WhoAmIRequest) (*quobyte.WhoAmIResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WhoAmIContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.WhoAmIResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WhoAmIContext indicates an expected call of WhoAmIContext.
+func (mr *MockUserAPIMockRecorder) WhoAmIContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WhoAmIContext", reflect.TypeOf((*MockUserAPI)(nil).WhoAmIContext), arg0, arg1)
+}
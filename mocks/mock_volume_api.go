@@ -0,0 +1,671 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/quobyte/api/quobyte (interfaces: VolumeAPI)
+//
+// Generated by this command:
+//
+//	mockgen -package=mocks -destination ../mocks/mock_volume_api.go github.com/quobyte/api/quobyte VolumeAPI
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	quobyte "github.com/quobyte/api/quobyte"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVolumeAPI is a mock of VolumeAPI interface.
+type MockVolumeAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockVolumeAPIMockRecorder
+}
+
+// MockVolumeAPIMockRecorder is the mock recorder for MockVolumeAPI.
+type MockVolumeAPIMockRecorder struct {
+	mock *MockVolumeAPI
+}
+
+// NewMockVolumeAPI creates a new mock instance.
+func NewMockVolumeAPI(ctrl *gomock.Controller) *MockVolumeAPI {
+	mock := &MockVolumeAPI{ctrl: ctrl}
+	mock.recorder = &MockVolumeAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVolumeAPI) EXPECT() *MockVolumeAPIMockRecorder {
+	return m.recorder
+}
+
+// AnalyzeVolumes mocks base method.
+func (m *MockVolumeAPI) AnalyzeVolumes(arg0 *quobyte.AnalyzeVolumesRequest) (*quobyte.AnalyzeVolumesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeVolumes", arg0)
+	ret0, _ := ret[0].(*quobyte.AnalyzeVolumesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeVolumes indicates an expected call of AnalyzeVolumes.
+func (mr *MockVolumeAPIMockRecorder) AnalyzeVolumes(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeVolumes", reflect.TypeOf((*MockVolumeAPI)(nil).AnalyzeVolumes), arg0)
+}
+
+// AnalyzeVolumesContext mocks base method.
+func (m *MockVolumeAPI) AnalyzeVolumesContext(arg0 context.Context, arg1 *quobyte.AnalyzeVolumesRequest) (*quobyte.AnalyzeVolumesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeVolumesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.AnalyzeVolumesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeVolumesContext indicates an expected call of AnalyzeVolumesContext.
+func (mr *MockVolumeAPIMockRecorder) AnalyzeVolumesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeVolumesContext", reflect.TypeOf((*MockVolumeAPI)(nil).AnalyzeVolumesContext), arg0, arg1)
+}
+
+// CancelQuery mocks base method.
+func (m *MockVolumeAPI) CancelQuery(arg0 *quobyte.CancelQueryRequest) (*quobyte.CancelQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelQuery", arg0)
+	ret0, _ := ret[0].(*quobyte.CancelQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelQuery indicates an expected call of CancelQuery.
+func (mr *MockVolumeAPIMockRecorder) CancelQuery(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelQuery", reflect.TypeOf((*MockVolumeAPI)(nil).CancelQuery), arg0)
+}
+
+// CancelQueryContext mocks base method.
+func (m *MockVolumeAPI) CancelQueryContext(arg0 context.Context, arg1 *quobyte.CancelQueryRequest) (*quobyte.CancelQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelQueryContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelQueryContext indicates an expected call of CancelQueryContext.
+func (mr *MockVolumeAPIMockRecorder) CancelQueryContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelQueryContext", reflect.TypeOf((*MockVolumeAPI)(nil).CancelQueryContext), arg0, arg1)
+}
+
+// CancelVolumeErasure mocks base method.
+func (m *MockVolumeAPI) CancelVolumeErasure(arg0 *quobyte.CancelVolumeErasureRequest) (*quobyte.CancelVolumeErasureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVolumeErasure", arg0)
+	ret0, _ := ret[0].(*quobyte.CancelVolumeErasureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVolumeErasure indicates an expected call of CancelVolumeErasure.
+func (mr *MockVolumeAPIMockRecorder) CancelVolumeErasure(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVolumeErasure", reflect.TypeOf((*MockVolumeAPI)(nil).CancelVolumeErasure), arg0)
+}
+
+// CancelVolumeErasureContext mocks base method.
+func (m *MockVolumeAPI) CancelVolumeErasureContext(arg0 context.Context, arg1 *quobyte.CancelVolumeErasureRequest) (*quobyte.CancelVolumeErasureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVolumeErasureContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CancelVolumeErasureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVolumeErasureContext indicates an expected call of CancelVolumeErasureContext.
+func (mr *MockVolumeAPIMockRecorder) CancelVolumeErasureContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVolumeErasureContext", reflect.TypeOf((*MockVolumeAPI)(nil).CancelVolumeErasureContext), arg0, arg1)
+}
+
+// CreateMirroredVolume mocks base method.
+func (m *MockVolumeAPI) CreateMirroredVolume(arg0 *quobyte.CreateMirroredVolumeRequest) (*quobyte.CreateMirroredVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMirroredVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateMirroredVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMirroredVolume indicates an expected call of CreateMirroredVolume.
+func (mr *MockVolumeAPIMockRecorder) CreateMirroredVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMirroredVolume", reflect.TypeOf((*MockVolumeAPI)(nil).CreateMirroredVolume), arg0)
+}
+
+// CreateMirroredVolumeContext mocks base method.
+func (m *MockVolumeAPI) CreateMirroredVolumeContext(arg0 context.Context, arg1 *quobyte.CreateMirroredVolumeRequest) (*quobyte.CreateMirroredVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMirroredVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateMirroredVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMirroredVolumeContext indicates an expected call of CreateMirroredVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) CreateMirroredVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMirroredVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).CreateMirroredVolumeContext), arg0, arg1)
+}
+
+// CreateVolume mocks base method.
+func (m *MockVolumeAPI) CreateVolume(arg0 *quobyte.CreateVolumeRequest) (*quobyte.CreateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.CreateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockVolumeAPIMockRecorder) CreateVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVolumeAPI)(nil).CreateVolume), arg0)
+}
+
+// CreateVolumeContext mocks base method.
+func (m *MockVolumeAPI) CreateVolumeContext(arg0 context.Context, arg1 *quobyte.CreateVolumeRequest) (*quobyte.CreateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.CreateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeContext indicates an expected call of CreateVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) CreateVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).CreateVolumeContext), arg0, arg1)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockVolumeAPI) DeleteVolume(arg0 *quobyte.DeleteVolumeRequest) (*quobyte.DeleteVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.DeleteVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockVolumeAPIMockRecorder) DeleteVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVolumeAPI)(nil).DeleteVolume), arg0)
+}
+
+// DeleteVolumeContext mocks base method.
+func (m *MockVolumeAPI) DeleteVolumeContext(arg0 context.Context, arg1 *quobyte.DeleteVolumeRequest) (*quobyte.DeleteVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DeleteVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolumeContext indicates an expected call of DeleteVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) DeleteVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).DeleteVolumeContext), arg0, arg1)
+}
+
+// DisconnectMirroredVolume mocks base method.
+func (m *MockVolumeAPI) DisconnectMirroredVolume(arg0 *quobyte.DisconnectMirroredVolumeRequest) (*quobyte.DisconnectMirroredVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisconnectMirroredVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.DisconnectMirroredVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisconnectMirroredVolume indicates an expected call of DisconnectMirroredVolume.
+func (mr *MockVolumeAPIMockRecorder) DisconnectMirroredVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisconnectMirroredVolume", reflect.TypeOf((*MockVolumeAPI)(nil).DisconnectMirroredVolume), arg0)
+}
+
+// DisconnectMirroredVolumeContext mocks base method.
+func (m *MockVolumeAPI) DisconnectMirroredVolumeContext(arg0 context.Context, arg1 *quobyte.DisconnectMirroredVolumeRequest) (*quobyte.DisconnectMirroredVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisconnectMirroredVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.DisconnectMirroredVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisconnectMirroredVolumeContext indicates an expected call of DisconnectMirroredVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) DisconnectMirroredVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisconnectMirroredVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).DisconnectMirroredVolumeContext), arg0, arg1)
+}
+
+// EraseVolume mocks base method.
+func (m *MockVolumeAPI) EraseVolume(arg0 *quobyte.EraseVolumeRequest) (*quobyte.EraseVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.EraseVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseVolume indicates an expected call of EraseVolume.
+func (mr *MockVolumeAPIMockRecorder) EraseVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVolume", reflect.TypeOf((*MockVolumeAPI)(nil).EraseVolume), arg0)
+}
+
+// EraseVolumeContext mocks base method.
+func (m *MockVolumeAPI) EraseVolumeContext(arg0 context.Context, arg1 *quobyte.EraseVolumeRequest) (*quobyte.EraseVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.EraseVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseVolumeContext indicates an expected call of EraseVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) EraseVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).EraseVolumeContext), arg0, arg1)
+}
+
+// ExportVolume mocks base method.
+func (m *MockVolumeAPI) ExportVolume(arg0 *quobyte.ExportVolumeRequest) (*quobyte.ExportVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.ExportVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportVolume indicates an expected call of ExportVolume.
+func (mr *MockVolumeAPIMockRecorder) ExportVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVolume", reflect.TypeOf((*MockVolumeAPI)(nil).ExportVolume), arg0)
+}
+
+// ExportVolumeContext mocks base method.
+func (m *MockVolumeAPI) ExportVolumeContext(arg0 context.Context, arg1 *quobyte.ExportVolumeRequest) (*quobyte.ExportVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ExportVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportVolumeContext indicates an expected call of ExportVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) ExportVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).ExportVolumeContext), arg0, arg1)
+}
+
+// GetAnalyzeReports mocks base method.
+func (m *MockVolumeAPI) GetAnalyzeReports(arg0 *quobyte.GetAnalyzeReportsRequest) (*quobyte.GetAnalyzeReportsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalyzeReports", arg0)
+	ret0, _ := ret[0].(*quobyte.GetAnalyzeReportsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalyzeReports indicates an expected call of GetAnalyzeReports.
+func (mr *MockVolumeAPIMockRecorder) GetAnalyzeReports(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyzeReports", reflect.TypeOf((*MockVolumeAPI)(nil).GetAnalyzeReports), arg0)
+}
+
+// GetAnalyzeReportsContext mocks base method.
+func (m *MockVolumeAPI) GetAnalyzeReportsContext(arg0 context.Context, arg1 *quobyte.GetAnalyzeReportsRequest) (*quobyte.GetAnalyzeReportsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalyzeReportsContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetAnalyzeReportsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalyzeReportsContext indicates an expected call of GetAnalyzeReportsContext.
+func (mr *MockVolumeAPIMockRecorder) GetAnalyzeReportsContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalyzeReportsContext", reflect.TypeOf((*MockVolumeAPI)(nil).GetAnalyzeReportsContext), arg0, arg1)
+}
+
+// GetEffectiveVolumeConfiguration mocks base method.
+func (m *MockVolumeAPI) GetEffectiveVolumeConfiguration(arg0 *quobyte.GetEffectiveVolumeConfigurationRequest) (*quobyte.GetEffectiveVolumeConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveVolumeConfiguration", arg0)
+	ret0, _ := ret[0].(*quobyte.GetEffectiveVolumeConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveVolumeConfiguration indicates an expected call of GetEffectiveVolumeConfiguration.
+func (mr *MockVolumeAPIMockRecorder) GetEffectiveVolumeConfiguration(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveVolumeConfiguration", reflect.TypeOf((*MockVolumeAPI)(nil).GetEffectiveVolumeConfiguration), arg0)
+}
+
+// GetEffectiveVolumeConfigurationContext mocks base method.
+func (m *MockVolumeAPI) GetEffectiveVolumeConfigurationContext(arg0 context.Context, arg1 *quobyte.GetEffectiveVolumeConfigurationRequest) (*quobyte.GetEffectiveVolumeConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveVolumeConfigurationContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetEffectiveVolumeConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveVolumeConfigurationContext indicates an expected call of GetEffectiveVolumeConfigurationContext.
+func (mr *MockVolumeAPIMockRecorder) GetEffectiveVolumeConfigurationContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveVolumeConfigurationContext", reflect.TypeOf((*MockVolumeAPI)(nil).GetEffectiveVolumeConfigurationContext), arg0, arg1)
+}
+
+// GetFileMetadataDump mocks base method.
+func (m *MockVolumeAPI) GetFileMetadataDump(arg0 *quobyte.GetFileMetadataDumpRequest) (*quobyte.GetFileMetadataDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileMetadataDump", arg0)
+	ret0, _ := ret[0].(*quobyte.GetFileMetadataDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileMetadataDump indicates an expected call of GetFileMetadataDump.
+func (mr *MockVolumeAPIMockRecorder) GetFileMetadataDump(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileMetadataDump", reflect.TypeOf((*MockVolumeAPI)(nil).GetFileMetadataDump), arg0)
+}
+
+// GetFileMetadataDumpContext mocks base method.
+func (m *MockVolumeAPI) GetFileMetadataDumpContext(arg0 context.Context, arg1 *quobyte.GetFileMetadataDumpRequest) (*quobyte.GetFileMetadataDumpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileMetadataDumpContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetFileMetadataDumpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileMetadataDumpContext indicates an expected call of GetFileMetadataDumpContext.
+func (mr *MockVolumeAPIMockRecorder) GetFileMetadataDumpContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileMetadataDumpContext", reflect.TypeOf((*MockVolumeAPI)(nil).GetFileMetadataDumpContext), arg0, arg1)
+}
+
+// GetQueryProgress mocks base method.
+func (m *MockVolumeAPI) GetQueryProgress(arg0 *quobyte.GetQueryProgressRequest) (*quobyte.GetQueryProgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryProgress", arg0)
+	ret0, _ := ret[0].(*quobyte.GetQueryProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryProgress indicates an expected call of GetQueryProgress.
+func (mr *MockVolumeAPIMockRecorder) GetQueryProgress(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryProgress", reflect.TypeOf((*MockVolumeAPI)(nil).GetQueryProgress), arg0)
+}
+
+// GetQueryProgressContext mocks base method.
+func (m *MockVolumeAPI) GetQueryProgressContext(arg0 context.Context, arg1 *quobyte.GetQueryProgressRequest) (*quobyte.GetQueryProgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueryProgressContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetQueryProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueryProgressContext indicates an expected call of GetQueryProgressContext.
+func (mr *MockVolumeAPIMockRecorder) GetQueryProgressContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryProgressContext", reflect.TypeOf((*MockVolumeAPI)(nil).GetQueryProgressContext), arg0, arg1)
+}
+
+// GetVolumeList mocks base method.
+func (m *MockVolumeAPI) GetVolumeList(arg0 *quobyte.GetVolumeListRequest) (*quobyte.GetVolumeListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeList", arg0)
+	ret0, _ := ret[0].(*quobyte.GetVolumeListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeList indicates an expected call of GetVolumeList.
+func (mr *MockVolumeAPIMockRecorder) GetVolumeList(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeList", reflect.TypeOf((*MockVolumeAPI)(nil).GetVolumeList), arg0)
+}
+
+// GetVolumeListContext mocks base method.
+func (m *MockVolumeAPI) GetVolumeListContext(arg0 context.Context, arg1 *quobyte.GetVolumeListRequest) (*quobyte.GetVolumeListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeListContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.GetVolumeListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeListContext indicates an expected call of GetVolumeListContext.
+func (mr *MockVolumeAPIMockRecorder) GetVolumeListContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeListContext", reflect.TypeOf((*MockVolumeAPI)(nil).GetVolumeListContext), arg0, arg1)
+}
+
+// PublishBucketVolume mocks base method.
+func (m *MockVolumeAPI) PublishBucketVolume(arg0 *quobyte.PublishBucketVolumeRequest) (*quobyte.PublishBucketVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishBucketVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.PublishBucketVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishBucketVolume indicates an expected call of PublishBucketVolume.
+func (mr *MockVolumeAPIMockRecorder) PublishBucketVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBucketVolume", reflect.TypeOf((*MockVolumeAPI)(nil).PublishBucketVolume), arg0)
+}
+
+// PublishBucketVolumeContext mocks base method.
+func (m *MockVolumeAPI) PublishBucketVolumeContext(arg0 context.Context, arg1 *quobyte.PublishBucketVolumeRequest) (*quobyte.PublishBucketVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishBucketVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.PublishBucketVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishBucketVolumeContext indicates an expected call of PublishBucketVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) PublishBucketVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBucketVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).PublishBucketVolumeContext), arg0, arg1)
+}
+
+// QueryFiles mocks base method.
+func (m *MockVolumeAPI) QueryFiles(arg0 *quobyte.QueryFilesRequest) (*quobyte.QueryFilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFiles", arg0)
+	ret0, _ := ret[0].(*quobyte.QueryFilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryFiles indicates an expected call of QueryFiles.
+func (mr *MockVolumeAPIMockRecorder) QueryFiles(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFiles", reflect.TypeOf((*MockVolumeAPI)(nil).QueryFiles), arg0)
+}
+
+// QueryFilesContext mocks base method.
+func (m *MockVolumeAPI) QueryFilesContext(arg0 context.Context, arg1 *quobyte.QueryFilesRequest) (*quobyte.QueryFilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFilesContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.QueryFilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryFilesContext indicates an expected call of QueryFilesContext.
+func (mr *MockVolumeAPIMockRecorder) QueryFilesContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFilesContext", reflect.TypeOf((*MockVolumeAPI)(nil).QueryFilesContext), arg0, arg1)
+}
+
+// ResolveGlobalFileId mocks base method.
+func (m *MockVolumeAPI) ResolveGlobalFileId(arg0 *quobyte.ResolveGlobalFileIdRequest) (*quobyte.ResolveGlobalFileIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveGlobalFileId", arg0)
+	ret0, _ := ret[0].(*quobyte.ResolveGlobalFileIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveGlobalFileId indicates an expected call of ResolveGlobalFileId.
+func (mr *MockVolumeAPIMockRecorder) ResolveGlobalFileId(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveGlobalFileId", reflect.TypeOf((*MockVolumeAPI)(nil).ResolveGlobalFileId), arg0)
+}
+
+// ResolveGlobalFileIdContext mocks base method.
+func (m *MockVolumeAPI) ResolveGlobalFileIdContext(arg0 context.Context, arg1 *quobyte.ResolveGlobalFileIdRequest) (*quobyte.ResolveGlobalFileIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveGlobalFileIdContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResolveGlobalFileIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveGlobalFileIdContext indicates an expected call of ResolveGlobalFileIdContext.
+func (mr *MockVolumeAPIMockRecorder) ResolveGlobalFileIdContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveGlobalFileIdContext", reflect.TypeOf((*MockVolumeAPI)(nil).ResolveGlobalFileIdContext), arg0, arg1)
+}
+
+// ResolveVolumeName mocks base method.
+func (m *MockVolumeAPI) ResolveVolumeName(arg0 *quobyte.ResolveVolumeNameRequest) (*quobyte.ResolveVolumeNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveVolumeName", arg0)
+	ret0, _ := ret[0].(*quobyte.ResolveVolumeNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveVolumeName indicates an expected call of ResolveVolumeName.
+func (mr *MockVolumeAPIMockRecorder) ResolveVolumeName(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVolumeName", reflect.TypeOf((*MockVolumeAPI)(nil).ResolveVolumeName), arg0)
+}
+
+// ResolveVolumeNameContext mocks base method.
+func (m *MockVolumeAPI) ResolveVolumeNameContext(arg0 context.Context, arg1 *quobyte.ResolveVolumeNameRequest) (*quobyte.ResolveVolumeNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveVolumeNameContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.ResolveVolumeNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveVolumeNameContext indicates an expected call of ResolveVolumeNameContext.
+func (mr *MockVolumeAPIMockRecorder) ResolveVolumeNameContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVolumeNameContext", reflect.TypeOf((*MockVolumeAPI)(nil).ResolveVolumeNameContext), arg0, arg1)
+}
+
+// TriggerVolumeCheckpoint mocks base method.
+func (m *MockVolumeAPI) TriggerVolumeCheckpoint(arg0 *quobyte.TriggerVolumeCheckpointRequest) (*quobyte.TriggerVolumeCheckpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerVolumeCheckpoint", arg0)
+	ret0, _ := ret[0].(*quobyte.TriggerVolumeCheckpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerVolumeCheckpoint indicates an expected call of TriggerVolumeCheckpoint.
+func (mr *MockVolumeAPIMockRecorder) TriggerVolumeCheckpoint(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerVolumeCheckpoint", reflect.TypeOf((*MockVolumeAPI)(nil).TriggerVolumeCheckpoint), arg0)
+}
+
+// TriggerVolumeCheckpointContext mocks base method.
+func (m *MockVolumeAPI) TriggerVolumeCheckpointContext(arg0 context.Context, arg1 *quobyte.TriggerVolumeCheckpointRequest) (*quobyte.TriggerVolumeCheckpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerVolumeCheckpointContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.TriggerVolumeCheckpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerVolumeCheckpointContext indicates an expected call of TriggerVolumeCheckpointContext.
+func (mr *MockVolumeAPIMockRecorder) TriggerVolumeCheckpointContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerVolumeCheckpointContext", reflect.TypeOf((*MockVolumeAPI)(nil).TriggerVolumeCheckpointContext), arg0, arg1)
+}
+
+// UnpublishBucketVolume mocks base method.
+func (m *MockVolumeAPI) UnpublishBucketVolume(arg0 *quobyte.UnpublishBucketVolumeRequest) (*quobyte.UnpublishBucketVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishBucketVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.UnpublishBucketVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishBucketVolume indicates an expected call of UnpublishBucketVolume.
+func (mr *MockVolumeAPIMockRecorder) UnpublishBucketVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishBucketVolume", reflect.TypeOf((*MockVolumeAPI)(nil).UnpublishBucketVolume), arg0)
+}
+
+// UnpublishBucketVolumeContext mocks base method.
+func (m *MockVolumeAPI) UnpublishBucketVolumeContext(arg0 context.Context, arg1 *quobyte.UnpublishBucketVolumeRequest) (*quobyte.UnpublishBucketVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishBucketVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UnpublishBucketVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishBucketVolumeContext indicates an expected call of UnpublishBucketVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) UnpublishBucketVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishBucketVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).UnpublishBucketVolumeContext), arg0, arg1)
+}
+
+// UpdateVolume mocks base method.
+func (m *MockVolumeAPI) UpdateVolume(arg0 *quobyte.UpdateVolumeRequest) (*quobyte.UpdateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolume", arg0)
+	ret0, _ := ret[0].(*quobyte.UpdateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolume indicates an expected call of UpdateVolume.
+func (mr *MockVolumeAPIMockRecorder) UpdateVolume(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockVolumeAPI)(nil).UpdateVolume), arg0)
+}
+
+// UpdateVolumeContext mocks base method.
+func (m *MockVolumeAPI) UpdateVolumeContext(arg0 context.Context, arg1 *quobyte.UpdateVolumeRequest) (*quobyte.UpdateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeContext", arg0, arg1)
+	ret0, _ := ret[0].(*quobyte.UpdateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolumeContext indicates an expected call of UpdateVolumeContext.
+func (mr *MockVolumeAPIMockRecorder) UpdateVolumeContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeContext", reflect.TypeOf((*MockVolumeAPI)(nil).UpdateVolumeContext), arg0, arg1)
+}
@@ -0,0 +1,297 @@
+package quobyte
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokens are renewed this long before they expire
+const tokenExpiryMargin = 30 * time.Second
+
+// Authenticator adds credentials to the requests that establish a session with the API service.
+// It is only called by the single request that logs in, all other requests use the session cookies.
+type Authenticator interface {
+	// Authenticate adds the credentials to req.
+	Authenticate(ctx context.Context, req *http.Request) error
+	// Refresh is called after the API service rejected the credentials. It returns true if new
+	// credentials are available and the login should be retried.
+	Refresh(ctx context.Context) (bool, error)
+}
+
+type basicAuthenticator struct {
+	username string
+	password string
+}
+
+// BasicAuth returns an Authenticator that logs in with a static user name and password.
+func BasicAuth(username, password string) Authenticator {
+	return &basicAuthenticator{username: username, password: password}
+}
+
+func (auth *basicAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	req.SetBasicAuth(auth.username, auth.password)
+	return nil
+}
+
+func (auth *basicAuthenticator) Refresh(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+type bearerTokenAuthenticator struct {
+	token string
+}
+
+// BearerToken returns an Authenticator that logs in with a static bearer token.
+func BearerToken(token string) Authenticator {
+	return &bearerTokenAuthenticator{token: token}
+}
+
+func (auth *bearerTokenAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+auth.token)
+	return nil
+}
+
+func (auth *bearerTokenAuthenticator) Refresh(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+// credentials are either a user name and password or a bearer token
+type credentials struct {
+	username string
+	password string
+	token    string
+}
+
+// reloadingAuthenticator reads the credentials for every login, so rotated credentials (e.g. a
+// mounted Kubernetes secret) are picked up without restarting the client.
+type reloadingAuthenticator struct {
+	read func() (credentials, error)
+
+	mu   sync.Mutex
+	last credentials
+}
+
+func (auth *reloadingAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	creds, err := auth.read()
+	if err != nil {
+		return err
+	}
+	auth.mu.Lock()
+	auth.last = creds
+	auth.mu.Unlock()
+	if creds.token != "" {
+		req.Header.Set("Authorization", "Bearer "+creds.token)
+	} else {
+		req.SetBasicAuth(creds.username, creds.password)
+	}
+	return nil
+}
+
+// Refresh reports whether the credentials changed since the rejected login.
+func (auth *reloadingAuthenticator) Refresh(ctx context.Context) (bool, error) {
+	creds, err := auth.read()
+	if err != nil {
+		return false, err
+	}
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+	return creds != auth.last, nil
+}
+
+func readSecretFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read credentials due to %s", err.Error())
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+func readSecretEnv(name string) (string, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
+
+// BasicAuthFromFiles returns an Authenticator that reads the user name and password from the
+// given files for every login. Trailing newlines are ignored.
+func BasicAuthFromFiles(usernameFile, passwordFile string) Authenticator {
+	return &reloadingAuthenticator{read: func() (credentials, error) {
+		username, err := readSecretFile(usernameFile)
+		if err != nil {
+			return credentials{}, err
+		}
+		password, err := readSecretFile(passwordFile)
+		if err != nil {
+			return credentials{}, err
+		}
+		return credentials{username: username, password: password}, nil
+	}}
+}
+
+// BasicAuthFromEnv returns an Authenticator that reads the user name and password from the given
+// environment variables for every login.
+func BasicAuthFromEnv(usernameVar, passwordVar string) Authenticator {
+	return &reloadingAuthenticator{read: func() (credentials, error) {
+		username, err := readSecretEnv(usernameVar)
+		if err != nil {
+			return credentials{}, err
+		}
+		password, err := readSecretEnv(passwordVar)
+		if err != nil {
+			return credentials{}, err
+		}
+		return credentials{username: username, password: password}, nil
+	}}
+}
+
+// BearerTokenFromFile returns an Authenticator that reads a bearer token from the given file for
+// every login. Trailing newlines are ignored.
+func BearerTokenFromFile(path string) Authenticator {
+	return &reloadingAuthenticator{read: func() (credentials, error) {
+		token, err := readSecretFile(path)
+		return credentials{token: token}, err
+	}}
+}
+
+// BearerTokenFromEnv returns an Authenticator that reads a bearer token from the given
+// environment variable for every login.
+func BearerTokenFromEnv(name string) Authenticator {
+	return &reloadingAuthenticator{read: func() (credentials, error) {
+		token, err := readSecretEnv(name)
+		return credentials{token: token}, err
+	}}
+}
+
+// oidcAuthenticator logs in with an access token obtained by the OAuth 2.0 client credentials grant.
+type oidcAuthenticator struct {
+	config     SystemConfiguration_OpenIdConnectConfig
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	tokenEndpoint string
+	token         string
+	expiresAt     time.Time
+}
+
+// OIDCClientCredentials returns an Authenticator that obtains access tokens from the OpenID
+// provider with the client credentials grant. It uses the same provider and client settings that
+// are configured for the API service in SystemConfiguration.Oidc. If the token endpoint is not
+// set, it is looked up through OpenID Connect discovery at the issuer. httpClient is used to talk
+// to the provider, nil means http.DefaultClient.
+func OIDCClientCredentials(config SystemConfiguration_OpenIdConnectConfig, httpClient *http.Client) Authenticator {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &oidcAuthenticator{
+		config:        config,
+		httpClient:    httpClient,
+		tokenEndpoint: config.Provider.TokenEndpoint,
+	}
+}
+
+func (auth *oidcAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+	if auth.token == "" || (!auth.expiresAt.IsZero() && time.Now().After(auth.expiresAt.Add(-tokenExpiryMargin))) {
+		if err := auth.fetchToken(ctx); err != nil {
+			return err
+		}
+	}
+	req.Header.Set("Authorization", "Bearer "+auth.token)
+	return nil
+}
+
+// Refresh drops the rejected token, the next login fetches a new one.
+func (auth *oidcAuthenticator) Refresh(ctx context.Context) (bool, error) {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+	hadToken := auth.token != ""
+	auth.token = ""
+	return hadToken, nil
+}
+
+// fetchToken must be called with mu held.
+func (auth *oidcAuthenticator) fetchToken(ctx context.Context) error {
+	if auth.tokenEndpoint == "" {
+		if err := auth.discover(ctx); err != nil {
+			return err
+		}
+	}
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(auth.config.Client.Scope) > 0 {
+		form.Set("scope", strings.Join(auth.config.Client.Scope, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", auth.tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(auth.config.Client.ClientId), url.QueryEscape(auth.config.Client.ClientSecret))
+	var token struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := auth.getJSON(req, &token); err != nil {
+		return fmt.Errorf("could not obtain OIDC token due to %s", err.Error())
+	}
+	if token.AccessToken == "" {
+		return errors.New("could not obtain OIDC token: empty access token")
+	}
+	auth.token = token.AccessToken
+	auth.expiresAt = time.Time{}
+	if token.ExpiresIn > 0 {
+		auth.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return nil
+}
+
+func (auth *oidcAuthenticator) discover(ctx context.Context) error {
+	if auth.config.Provider.Issuer == "" {
+		return errors.New("OIDC provider has neither token endpoint nor issuer")
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET",
+		strings.TrimSuffix(auth.config.Provider.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return err
+	}
+	var metadata SystemConfiguration_OpenIdConnectConfig_Provider
+	if err := auth.getJSON(req, &metadata); err != nil {
+		return fmt.Errorf("could not discover OIDC provider due to %s", err.Error())
+	}
+	if metadata.TokenEndpoint == "" {
+		return errors.New("OIDC provider metadata has no token endpoint")
+	}
+	auth.tokenEndpoint = metadata.TokenEndpoint
+	return nil
+}
+
+func (auth *oidcAuthenticator) getJSON(req *http.Request, result interface{}) error {
+	resp, err := auth.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("status %d %s", resp.StatusCode, string(body))
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// SetAuthenticator replaces the authenticator used to log in to the API service. The current
+// sessions stay valid until the API service rejects them.
+func (client *QuobyteClient) SetAuthenticator(authenticator Authenticator) {
+	client.authenticator = authenticator
+}
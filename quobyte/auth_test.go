@@ -0,0 +1,167 @@
+package quobyte
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newAuthServer returns an API server that accepts logins for which valid returns true.
+func newAuthServer(t *testing.T, valid func(req *http.Request) bool) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "" {
+			if !valid(req) {
+				w.WriteHeader(401)
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		} else if _, err := req.Cookie("session"); err != nil {
+			w.WriteHeader(401)
+			return
+		}
+		w.Write(rpcResponse(req, `{"result":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	srv := newAuthServer(t, func(req *http.Request) bool {
+		return req.Header.Get("Authorization") == "Bearer secret-token"
+	})
+	client, err := NewClient(srv.URL, WithAuthenticator(BearerToken("secret-token")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	client.SetAuthenticator(BearerToken("wrong"))
+	client.endpoints.primary().session.invalidate()
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); !errors.Is(err, ErrAuthentication) {
+		t.Fatalf("Expected ErrAuthentication, got %v", err)
+	}
+}
+
+func TestBasicAuthFromFilesPicksUpRotation(t *testing.T) {
+	dir := t.TempDir()
+	usernameFile := filepath.Join(dir, "username")
+	passwordFile := filepath.Join(dir, "password")
+	os.WriteFile(usernameFile, []byte("admin\n"), 0600)
+	os.WriteFile(passwordFile, []byte("old\n"), 0600)
+
+	var password atomic.Value
+	password.Store("old")
+	srv := newAuthServer(t, func(req *http.Request) bool {
+		username, pw, ok := req.BasicAuth()
+		return ok && username == "admin" && pw == password.Load().(string)
+	})
+	client, err := NewClient(srv.URL, WithAuthenticator(BasicAuthFromFiles(usernameFile, passwordFile)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// password is rotated on the server and in the mounted secret, the session expires
+	password.Store("new")
+	os.WriteFile(passwordFile, []byte("new\n"), 0600)
+	client.endpoints.primary().session.invalidate()
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Rotated credentials were not used: %v", err)
+	}
+}
+
+func TestReloadingAuthenticatorRetriesWithChangedCredentials(t *testing.T) {
+	t.Setenv("QUOBYTE_TOKEN", "old")
+	var logins int64
+	srv := newAuthServer(t, func(req *http.Request) bool {
+		if atomic.AddInt64(&logins, 1) == 1 {
+			// secret is rotated while the first login is in flight
+			os.Setenv("QUOBYTE_TOKEN", "new")
+		}
+		return req.Header.Get("Authorization") == "Bearer new"
+	})
+	client, err := NewClient(srv.URL, WithAuthenticator(BearerTokenFromEnv("QUOBYTE_TOKEN")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt64(&logins); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+
+	// unchanged credentials are not retried
+	atomic.StoreInt64(&logins, 0)
+	client.SetAuthenticator(BasicAuthFromEnv("QUOBYTE_USER", "QUOBYTE_TOKEN"))
+	t.Setenv("QUOBYTE_USER", "admin")
+	client.endpoints.primary().session.invalidate()
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); !errors.Is(err, ErrAuthentication) {
+		t.Fatalf("Expected ErrAuthentication, got %v", err)
+	}
+	if got := atomic.LoadInt64(&logins); got != 1 {
+		t.Errorf("Expected 1 login, got %d", got)
+	}
+}
+
+func TestOIDCClientCredentials(t *testing.T) {
+	var issued int64
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{
+				"issuer":         "http://" + req.Host,
+				"token_endpoint": "http://" + req.Host + "/token",
+			})
+		case "/token":
+			clientID, secret, _ := req.BasicAuth()
+			if req.PostFormValue("grant_type") != "client_credentials" || clientID != "quobyte-cli" ||
+				secret != "s3cr3t" || req.PostFormValue("scope") != "openid quobyte" {
+				w.WriteHeader(400)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": "token-" + strconv.FormatInt(atomic.AddInt64(&issued, 1), 10),
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer provider.Close()
+
+	// the first token is rejected by the API service, e.g. because it was revoked
+	srv := newAuthServer(t, func(req *http.Request) bool {
+		return req.Header.Get("Authorization") == "Bearer token-2"
+	})
+	config := SystemConfiguration_OpenIdConnectConfig{
+		Name:     "corporate",
+		Provider: SystemConfiguration_OpenIdConnectConfig_Provider{Issuer: provider.URL},
+		Client: SystemConfiguration_OpenIdConnectConfig_Client{
+			ClientId:     "quobyte-cli",
+			ClientSecret: "s3cr3t",
+			Scope:        []string{"openid", "quobyte"},
+		},
+	}
+	client, err := NewClient(srv.URL, WithAuthenticator(OIDCClientCredentials(config, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt64(&issued); got != 2 {
+		t.Errorf("Expected 2 issued tokens, got %d", got)
+	}
+}
@@ -0,0 +1,191 @@
+package quobyte
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// BatchMethod is the method name under which interceptors and metrics see a batch request.
+const BatchMethod = "batch"
+
+var errBatchRejected = errors.New("batch request was rejected by Quobyte API service")
+
+// Batch queues calls and sends them as one JSON-RPC 2.0 batch request. If the API service does
+// not support batches, the calls are sent one after another.
+type Batch struct {
+	client *QuobyteClient
+	calls  []*BatchCall
+	// pending are the calls that passed validation and are sent by Send
+	pending []*BatchCall
+}
+
+// BatchCall is a call queued in a batch. Response and Err are set when the batch was sent.
+type BatchCall struct {
+	Method   string
+	Request  interface{}
+	Response interface{}
+	Err      error
+}
+
+// NewBatch creates an empty batch for the client.
+func (client *QuobyteClient) NewBatch() *Batch {
+	return &Batch{client: client}
+}
+
+// Add queues a call of the JSON-RPC method. request is the typed request (e.g.
+// *ResolveVolumeNameRequest) and response the typed response to decode the result into (e.g.
+// *ResolveVolumeNameResponse, nil for calls without result).
+func (batch *Batch) Add(method string, request interface{}, response interface{}) *BatchCall {
+	call := &BatchCall{Method: method, Request: request, Response: response}
+	batch.calls = append(batch.calls, call)
+	return call
+}
+
+// Calls returns the queued calls in the order they were added.
+func (batch *Batch) Calls() []*BatchCall {
+	return batch.calls
+}
+
+// Len returns the number of queued calls.
+func (batch *Batch) Len() int {
+	return len(batch.calls)
+}
+
+// Send sends all queued calls. Failures of single calls are reported in their Err, the returned
+// error is only set if the batch as a whole failed, e.g. because the API service is unreachable.
+// Calls with invalid requests or methods that the server does not support fail in their Err
+// without being sent. Interceptors see a batch as one call of BatchMethod with the *Batch as request.
+func (batch *Batch) Send(ctx context.Context) error {
+	client := batch.client
+	batch.pending = batch.pending[:0]
+	for _, call := range batch.calls {
+		call.Err = client.validateRequest(call.Method, call.Request)
+		if call.Err == nil {
+			call.Err = client.detectSupported(ctx, call.Method)
+		}
+		if call.Err != nil {
+			continue
+		}
+		client.setRetryPolicy(call.Request)
+		batch.pending = append(batch.pending, call)
+	}
+	if len(batch.pending) == 0 {
+		return nil
+	}
+	info := callInfoFrom(ctx)
+	if info == nil {
+		info = &CallInfo{}
+		ctx = WithCallInfo(ctx, info)
+	}
+	err := chainInterceptors(client.interceptors, client.invokeBatch)(ctx, BatchMethod, batch, nil)
+	if err == nil {
+		// like sendRequest, calls of methods the server does not have fail as unsupported
+		for _, call := range batch.pending {
+			if errors.Is(call.Err, ErrMethodNotFound) {
+				call.Err = client.unsupported(info.Endpoint, call.Method, call.Err)
+			}
+		}
+	}
+	if !batchRejected(err) {
+		return err
+	}
+	for _, call := range batch.pending {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		call.Err = client.sendRequest(ctx, call.Method, call.Request, call.Response)
+	}
+	return nil
+}
+
+// invokeBatch is the innermost Invoker of the interceptor chain for batches.
+func (client QuobyteClient) invokeBatch(ctx context.Context, method string, batchRequest interface{}, response interface{}) error {
+	batch := batchRequest.(*Batch)
+	requests := make([]*request, len(batch.pending))
+	idempotent := true
+	// the batch takes the limits of all its calls
+	calls := map[MethodClass]int{}
+	for i, call := range batch.pending {
+		requests[i] = &request{
+			ID:      strconv.Itoa(i),
+			Version: "2.0",
+			Method:  call.Method,
+			Params:  call.Request,
+		}
+		callIdempotent := client.methodIdempotency(call.Method) == Idempotent
+		calls[client.methodClass(call.Method, callIdempotent)]++
+		idempotent = idempotent && callIdempotent
+	}
+	message, err := json.Marshal(requests)
+	if err != nil {
+		return err
+	}
+	return client.send(withCalls(ctx, calls), method, idempotent, message, batch.decode)
+}
+
+// decode matches the responses of a batch with its calls by their ID.
+func (batch *Batch) decode(body io.Reader) error {
+	var raw json.RawMessage
+	if err := json.NewDecoder(body).Decode(&raw); err != nil {
+		return err
+	}
+	var responses []json.RawMessage
+	err := json.Unmarshal(raw, &responses)
+	if err != nil {
+		// a single response instead of an array, the server does not understand batches
+		return errBatchRejected
+	}
+	received := make([]bool, len(batch.pending))
+	for _, message := range responses {
+		var envelope response
+		if batch.client.strict {
+			err = decodeStrict(message, &envelope)
+		} else {
+			err = json.Unmarshal(message, &envelope)
+		}
+		if err != nil {
+			return fmt.Errorf("method %s: %w", BatchMethod, err)
+		}
+		i, err := strconv.Atoi(envelope.ID)
+		if err != nil || i < 0 || i >= len(batch.pending) || received[i] {
+			return fmt.Errorf("%w: batch response has unexpected id %q", ErrProtocol, envelope.ID)
+		}
+		received[i] = true
+		call := batch.pending[i]
+		if call.Err = checkEnvelope(call.Method, envelope.ID, &envelope, batch.client.strict); call.Err == nil {
+			call.Err = decodeEnvelope(call.Method, &envelope, call.Response, batch.client.strict)
+		}
+		if call.Err == nil && batch.client.validateEnums {
+			call.Err = validateEnums(call.Method, call.Response)
+		}
+	}
+	for i, call := range batch.pending {
+		if !received[i] {
+			call.Err = fmt.Errorf(errorMessageFormat, call.Method, "No response in batch")
+		}
+	}
+	return nil
+}
+
+// batchRejected returns true if err shows that the API service does not support batches.
+func batchRejected(err error) bool {
+	if errors.Is(err, errBatchRejected) {
+		return true
+	}
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != 0 {
+		return false
+	}
+	switch rpcErr.HTTPStatus {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return false
+	case http.StatusNotImplemented:
+		return true
+	}
+	return rpcErr.HTTPStatus >= 400 && rpcErr.HTTPStatus < 500
+}
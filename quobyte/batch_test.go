@@ -0,0 +1,174 @@
+package quobyte
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// newBatchServer returns an API server that resolves volume names to "uuid-<name>" and rejects
+// volumes named "missing". If batches is false, it rejects batch requests like older versions.
+func newBatchServer(t *testing.T, batches bool, posts *int64) *httptest.Server {
+	handle := func(message json.RawMessage) map[string]interface{} {
+		var req struct {
+			ID     string                   `json:"id"`
+			Method string                   `json:"method"`
+			Params ResolveVolumeNameRequest `json:"params"`
+		}
+		json.Unmarshal(message, &req)
+		if req.Params.VolumeName == "missing" {
+			return map[string]interface{}{"id": req.ID, "jsonrpc": "2.0",
+				"error": map[string]interface{}{"code": -32602, "message": "unknown volume"}}
+		}
+		return map[string]interface{}{"id": req.ID, "jsonrpc": "2.0",
+			"result": map[string]string{"volume_uuid": "uuid-" + req.Params.VolumeName}}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(posts, 1)
+		var message json.RawMessage
+		json.NewDecoder(req.Body).Decode(&message)
+		var messages []json.RawMessage
+		if json.Unmarshal(message, &messages) != nil {
+			json.NewEncoder(w).Encode(handle(message))
+			return
+		}
+		if !batches {
+			w.Write([]byte(`{"id":null,"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"}}`))
+			return
+		}
+		// answer in reverse order, responses are matched by ID
+		var responses []interface{}
+		for i := len(messages) - 1; i >= 0; i-- {
+			responses = append(responses, handle(messages[i]))
+		}
+		json.NewEncoder(w).Encode(responses)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func testBatch(t *testing.T, batches bool, expectedPosts int64) {
+	var posts int64
+	client, err := NewClient(newBatchServer(t, batches, &posts).URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := client.NewBatch()
+	names := []string{"a", "missing", "c"}
+	calls := make([]*BatchCall, len(names))
+	for i, name := range names {
+		calls[i] = batch.Add("resolveVolumeName", &ResolveVolumeNameRequest{VolumeName: name}, &ResolveVolumeNameResponse{})
+	}
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt64(&posts); got != expectedPosts {
+		t.Errorf("Expected %d requests, got %d", expectedPosts, got)
+	}
+	for i, name := range []string{"a", "", "c"} {
+		if name == "" {
+			if !errors.Is(calls[i].Err, ErrNotFound) {
+				t.Errorf("Expected ErrNotFound for call %d, got %v", i, calls[i].Err)
+			}
+			continue
+		}
+		if calls[i].Err != nil {
+			t.Errorf("Unexpected error for call %d: %v", i, calls[i].Err)
+		} else if uuid := calls[i].Response.(*ResolveVolumeNameResponse).VolumeUuid; uuid != "uuid-"+name {
+			t.Errorf("Unexpected result for call %d: %s", i, uuid)
+		}
+	}
+}
+
+func TestBatch(t *testing.T) {
+	testBatch(t, true, 1)
+}
+
+func TestBatchFallsBackToSequentialCalls(t *testing.T) {
+	testBatch(t, false, 4)
+}
+
+func TestBatchMissingResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`[{"id":"1","jsonrpc":"2.0","result":{}}]`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := client.NewBatch()
+	first := batch.Add("getTenant", &GetTenantRequest{}, &GetTenantResponse{})
+	second := batch.Add("getTenant", &GetTenantRequest{}, &GetTenantResponse{})
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first.Err == nil || second.Err != nil {
+		t.Errorf("Unexpected errors: %v, %v", first.Err, second.Err)
+	}
+}
+
+func TestBatchDoesNotSendInvalidOrUnsupportedCalls(t *testing.T) {
+	var posts int64
+	client, err := NewClient(newBatchServer(t, true, &posts).URL, WithRequestValidation())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.unsupported("", MethodGetPolicyRules, ErrMethodNotFound)
+	batch := client.NewBatch()
+	valid := batch.Add(MethodResolveVolumeName, &ResolveVolumeNameRequest{VolumeName: "a"}, &ResolveVolumeNameResponse{})
+	invalid := batch.Add(MethodResolveVolumeName, &ResolveVolumeNameRequest{}, &ResolveVolumeNameResponse{})
+	unsupported := batch.Add(MethodGetPolicyRules, &GetPolicyRulesRequest{}, &GetPolicyRulesResponse{})
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if valid.Err != nil || valid.Response.(*ResolveVolumeNameResponse).VolumeUuid != "uuid-a" {
+		t.Errorf("Unexpected result %v, %+v", valid.Err, valid.Response)
+	}
+	if !errors.Is(invalid.Err, ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", invalid.Err)
+	}
+	if !errors.Is(unsupported.Err, ErrUnsupportedByServer) {
+		t.Errorf("Expected ErrUnsupportedByServer, got %v", unsupported.Err)
+	}
+
+	// nothing is sent if no call is valid
+	batch = client.NewBatch()
+	batch.Add(MethodResolveVolumeName, &ResolveVolumeNameRequest{}, &ResolveVolumeNameResponse{})
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// only the batch with the valid call
+	if got := atomic.LoadInt64(&posts); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+func TestBatchRecordsUnsupportedMethods(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`[{"id":"0","jsonrpc":"2.0","result":{}},` +
+			`{"id":"1","jsonrpc":"2.0","error":{"code":-32601,"message":"Method not found"}}]`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := client.NewBatch()
+	batch.Add(MethodGetTenant, &GetTenantRequest{}, &GetTenantResponse{})
+	missing := batch.Add(MethodGetConfiguration, &GetConfigurationRequest{}, &GetConfigurationResponse{})
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var unsupported *UnsupportedError
+	if !errors.As(missing.Err, &unsupported) || !errors.Is(missing.Err, ErrMethodNotFound) {
+		t.Errorf("Expected UnsupportedError, got %v", missing.Err)
+	}
+	if client.Supports(MethodGetConfiguration) {
+		t.Errorf("Unsupported method was not recorded")
+	}
+}
@@ -0,0 +1,261 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen matches the errors of calls rejected by an open circuit breaker.
+var ErrCircuitOpen = errors.New("quobyte: circuit breaker is open")
+
+// BreakerState is the state of the circuit breaker of a client.
+type BreakerState int
+
+const (
+	// BreakerClosed lets all calls pass.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects all calls until a probe reaches the API service.
+	BreakerOpen
+)
+
+func (state BreakerState) String() string {
+	switch state {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	}
+	return fmt.Sprintf("BreakerState(%d)", int(state))
+}
+
+// BreakerConfig configures the circuit breaker of a client.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive transport failures that open the breaker.
+	FailureThreshold int
+	// ProbeInterval is the time between the whoAmI probes while the breaker is open.
+	ProbeInterval time.Duration
+	// OnStateChange is called after the state of the breaker changed.
+	OnStateChange func(from, to BreakerState)
+}
+
+// DefaultBreakerConfig returns the default configuration of the circuit breaker.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold: 5,
+		ProbeInterval:    5 * time.Second,
+	}
+}
+
+// CircuitOpenError is returned for calls that were rejected without contacting the API service
+// because the circuit breaker is open.
+type CircuitOpenError struct {
+	// Method is the JSON-RPC method of the rejected call.
+	Method string
+	// Since is the time the breaker opened.
+	Since time.Time
+	// LastError is the transport failure that opened the breaker.
+	LastError error
+}
+
+func (err *CircuitOpenError) Error() string {
+	return fmt.Sprintf("method: %s error message: circuit breaker open since %s, last error: %v",
+		err.Method, err.Since.Format(time.RFC3339), err.LastError)
+}
+
+// Is reports whether target is ErrCircuitOpen.
+func (err *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+type circuitBreaker struct {
+	// client sends the probes, so that they see later changes of the client settings
+	client *QuobyteClient
+
+	mu      sync.Mutex
+	enabled bool
+	config  BreakerConfig
+	// done is canceled when the breaker is reconfigured or the client is closed, it stops the probe
+	done context.Context
+	stop context.CancelFunc
+	// probing is set while a probe runs, so that a breaker that opens again does not start another
+	probing   bool
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	lastError error
+}
+
+// SetCircuitBreaker enables a circuit breaker that opens after consecutive transport failures.
+// While it is open, calls fail fast with a *CircuitOpenError and the API service is probed with
+// whoAmI in the background until it responds again. Zero values of config are replaced with the
+// values of DefaultBreakerConfig. Setting the breaker again closes it and stops its probe.
+func (client *QuobyteClient) SetCircuitBreaker(config BreakerConfig) {
+	defaults := DefaultBreakerConfig()
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaults.FailureThreshold
+	}
+	if config.ProbeInterval <= 0 {
+		config.ProbeInterval = defaults.ProbeInterval
+	}
+	breaker := client.breaker
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	if breaker.stop != nil {
+		breaker.stop()
+	}
+	breaker.done, breaker.stop = context.WithCancel(context.Background())
+	breaker.enabled = true
+	breaker.config = config
+	breaker.probing = false
+	breaker.state = BreakerClosed
+	breaker.failures = 0
+	breaker.lastError = nil
+}
+
+// Close stops the background probes of the circuit breaker. The client can still be used, but an
+// open circuit breaker is not closed anymore.
+func (client *QuobyteClient) Close() {
+	breaker := client.breaker
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	if breaker.stop != nil {
+		breaker.stop()
+	}
+}
+
+// WithCircuitBreaker enables a circuit breaker, see SetCircuitBreaker.
+func WithCircuitBreaker(config BreakerConfig) Option {
+	return func(opts *clientOptions) error {
+		opts.breaker = &config
+		return nil
+	}
+}
+
+// BreakerState returns the state of the circuit breaker, BreakerClosed if it is disabled.
+func (client *QuobyteClient) BreakerState() BreakerState {
+	client.breaker.mu.Lock()
+	defer client.breaker.mu.Unlock()
+	return client.breaker.state
+}
+
+// isTransportFailure returns true if err shows that the API service could not be reached.
+func isTransportFailure(err error) bool {
+	return err != nil && isRetryable(err, true)
+}
+
+// withBreaker calls send unless the circuit breaker is open.
+func (client QuobyteClient) withBreaker(ctx context.Context, method string, send func() error) error {
+	breaker := client.breaker
+	breaker.mu.Lock()
+	if !breaker.enabled {
+		breaker.mu.Unlock()
+		return send()
+	}
+	if breaker.state == BreakerOpen {
+		err := &CircuitOpenError{Method: method, Since: breaker.openedAt, LastError: breaker.lastError}
+		breaker.mu.Unlock()
+		return err
+	}
+	breaker.mu.Unlock()
+
+	err := send()
+	switch {
+	case isTransportFailure(err):
+		breaker.failure(err)
+	case ctx.Err() == nil:
+		breaker.success()
+	}
+	return err
+}
+
+// failure records a transport failure. If it opens the breaker, the probe is started unless it
+// still runs from a previous opening.
+func (breaker *circuitBreaker) failure(err error) {
+	breaker.mu.Lock()
+	breaker.failures++
+	breaker.lastError = err
+	if breaker.state == BreakerOpen || breaker.failures < breaker.config.FailureThreshold {
+		breaker.mu.Unlock()
+		return
+	}
+	breaker.state = BreakerOpen
+	breaker.openedAt = time.Now()
+	if !breaker.probing && breaker.done.Err() == nil {
+		breaker.probing = true
+		go breaker.probe(breaker.done, breaker.config.ProbeInterval)
+	}
+	config := breaker.config
+	breaker.mu.Unlock()
+	notify(config, BreakerClosed, BreakerOpen)
+}
+
+// success records a response of the API service and closes the breaker.
+func (breaker *circuitBreaker) success() {
+	breaker.mu.Lock()
+	breaker.failures = 0
+	if breaker.state == BreakerClosed {
+		breaker.mu.Unlock()
+		return
+	}
+	breaker.state = BreakerClosed
+	config := breaker.config
+	breaker.mu.Unlock()
+	notify(config, BreakerOpen, BreakerClosed)
+}
+
+func notify(config BreakerConfig, from, to BreakerState) {
+	if config.OnStateChange != nil {
+		config.OnStateChange(from, to)
+	}
+}
+
+// probe calls whoAmI on all endpoints every probe interval until one of them responds and then
+// closes the breaker. It returns early when done is canceled or a call closed the breaker.
+func (breaker *circuitBreaker) probe(done context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done.Done():
+			return
+		case <-ticker.C:
+		}
+		breaker.mu.Lock()
+		if breaker.state == BreakerClosed {
+			breaker.probing = false
+			breaker.mu.Unlock()
+			return
+		}
+		breaker.mu.Unlock()
+		if breaker.client.probeEndpoints(done, interval) {
+			breaker.mu.Lock()
+			breaker.probing = false
+			breaker.mu.Unlock()
+			breaker.success()
+			return
+		}
+	}
+}
+
+// probeEndpoints returns true if any endpoint responded to whoAmI within timeout.
+func (client QuobyteClient) probeEndpoints(ctx context.Context, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, ep := range client.endpoints.endpoints {
+		var response WhoAmIResponse
+		id := newRequestID()
+		message, err := encodeRequestWithID(id, "whoAmI", &WhoAmIRequest{})
+		if err != nil {
+			return false
+		}
+		err = client.sendMessage(ctx, ep, "whoAmI", message, client.decodeInto("whoAmI", id, &response))
+		if ctx.Err() == nil && !isTransportFailure(err) {
+			client.endpoints.markHealthy(ep, false)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package quobyte
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	status := int32(http.StatusServiceUnavailable)
+	srv, requests := newEndpointServer(t, &status)
+
+	var mu sync.Mutex
+	var changes []string
+	client, err := NewClient(srv.URL, WithCircuitBreaker(BreakerConfig{
+		FailureThreshold: 2,
+		ProbeInterval:    10 * time.Millisecond,
+		OnStateChange: func(from, to BreakerState) {
+			mu.Lock()
+			defer mu.Unlock()
+			changes = append(changes, from.String()+"->"+to.String())
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryConfig(NoRetries())
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetVolumeList(&GetVolumeListRequest{}); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("Breaker opened too early: %v", err)
+		}
+	}
+	if state := client.BreakerState(); state != BreakerOpen {
+		t.Fatalf("Expected open breaker, got %s", state)
+	}
+	sent := atomic.LoadInt64(requests)
+	_, err = client.GetVolumeList(&GetVolumeListRequest{})
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) || !errors.Is(err, ErrCircuitOpen) || openErr.Method != "getVolumeList" {
+		t.Fatalf("Expected CircuitOpenError, got %v", err)
+	}
+
+	// the cluster is back, a probe closes the breaker
+	atomic.StoreInt32(&status, http.StatusOK)
+	for deadline := time.Now().Add(time.Second); client.BreakerState() != BreakerClosed; {
+		if time.Now().After(deadline) {
+			t.Fatal("Breaker was not closed by probe")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if atomic.LoadInt64(requests) == sent {
+		t.Errorf("No probe was sent")
+	}
+	if _, err := client.GetVolumeList(&GetVolumeListRequest{}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if expected := []string{"closed->open", "open->closed"}; !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Unexpected state changes: got %v, want %v", changes, expected)
+	}
+}
+
+func TestCircuitBreakerIgnoresApplicationErrors(t *testing.T) {
+	srv := newAuthServer(t, func(req *http.Request) bool { return false })
+	client, err := NewClient(srv.URL, WithCircuitBreaker(BreakerConfig{FailureThreshold: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := client.WhoAmI(&WhoAmIRequest{}); !errors.Is(err, ErrAuthentication) {
+			t.Fatalf("Expected ErrAuthentication, got %v", err)
+		}
+	}
+	if state := client.BreakerState(); state != BreakerClosed {
+		t.Errorf("Expected closed breaker, got %s", state)
+	}
+}
+
+func TestCircuitBreakerProbeStops(t *testing.T) {
+	for _, stop := range []func(client *QuobyteClient){
+		(*QuobyteClient).Close,
+		func(client *QuobyteClient) { client.SetCircuitBreaker(DefaultBreakerConfig()) },
+	} {
+		status := int32(http.StatusServiceUnavailable)
+		srv, requests := newEndpointServer(t, &status)
+		client, err := NewClient(srv.URL, WithCircuitBreaker(BreakerConfig{
+			FailureThreshold: 1,
+			ProbeInterval:    time.Millisecond,
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.SetRetryConfig(NoRetries())
+		client.GetVolumeList(&GetVolumeListRequest{})
+		if state := client.BreakerState(); state != BreakerOpen {
+			t.Fatalf("Expected open breaker, got %s", state)
+		}
+		for deadline := time.Now().Add(time.Second); atomic.LoadInt64(requests) < 2; {
+			if time.Now().After(deadline) {
+				t.Fatal("No probe was sent")
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		stop(client)
+		time.Sleep(10 * time.Millisecond)
+		sent := atomic.LoadInt64(requests)
+		time.Sleep(20 * time.Millisecond)
+		if got := atomic.LoadInt64(requests); got != sent {
+			t.Errorf("Probe continued after stop, %d requests were sent", got-sent)
+		}
+	}
+}
+
+func TestCircuitBreakerRunsOneProbe(t *testing.T) {
+	status := int32(http.StatusServiceUnavailable)
+	srv, requests := newEndpointServer(t, &status)
+	client, err := NewClient(srv.URL, WithCircuitBreaker(BreakerConfig{
+		FailureThreshold: 1,
+		ProbeInterval:    20 * time.Millisecond,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	client.SetRetryConfig(NoRetries())
+	client.GetVolumeList(&GetVolumeListRequest{})
+	// calls that were in flight while the breaker opened close and open it again
+	failure := &RPCError{HTTPStatus: http.StatusServiceUnavailable}
+	for i := 0; i < 10; i++ {
+		client.breaker.success()
+		client.breaker.failure(failure)
+	}
+	if state := client.BreakerState(); state != BreakerOpen {
+		t.Fatalf("Expected open breaker, got %s", state)
+	}
+	sent := atomic.LoadInt64(requests)
+	time.Sleep(110 * time.Millisecond)
+	// a single probe sends about 5 requests in this time
+	if got := atomic.LoadInt64(requests) - sent; got > 8 {
+		t.Errorf("Expected one probe, got %d requests", got)
+	}
+}
@@ -0,0 +1,33 @@
+package quobyte
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Call calls the JSON-RPC method with params and decodes the result into result, e.g. for methods
+// of a newer API service that are not in types.go yet. result may be nil if the result is not
+// needed.
+//
+// The call is sent like the generated methods: it uses the session, retries, limits and
+// interceptors of the client and fails with the same errors, e.g. *RPCError or *UnsupportedError.
+// params that are pointers to structs with a RetryPolicy string field (JSON name "retry") get the
+// API retry policy of the client. nil params are sent as an empty object.
+func (client *QuobyteClient) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	if params == nil {
+		params = struct{}{}
+	}
+	return client.sendRequest(ctx, method, params, result)
+}
+
+// CallRaw is like Call but returns the undecoded result, null for methods that return nothing.
+func (client *QuobyteClient) CallRaw(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	var result json.RawMessage
+	if err := client.Call(ctx, method, params, &result); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = json.RawMessage("null")
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package quobyte
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	var params []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var rpcRequest struct {
+			ID     string          `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		json.NewDecoder(req.Body).Decode(&rpcRequest)
+		params = append(params, string(rpcRequest.Params))
+		switch rpcRequest.Method {
+		case "getNewThing":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":{"thing":"new","count":2}}`))
+		case "setNewThing":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":null}`))
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","error":{"code":-32601,"message":"Method not found"}}`))
+		}
+	}))
+	defer srv.Close()
+	var intercepted []string
+	client, err := NewClient(srv.URL, WithInterceptors(func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+		intercepted = append(intercepted, method)
+		return invoker(ctx, method, request, response)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := &struct {
+		Name        string `json:"name"`
+		RetryPolicy string `json:"retry,omitempty"`
+	}{Name: "a"}
+	var result struct {
+		Thing string `json:"thing"`
+		Count int    `json:"count"`
+	}
+	if err := client.Call(context.Background(), "getNewThing", request, &result); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Thing != "new" || result.Count != 2 || params[0] != `{"name":"a","retry":"INTERACTIVE"}` {
+		t.Errorf("Unexpected result %+v for params %s", result, params[0])
+	}
+
+	raw, err := client.CallRaw(context.Background(), "getNewThing", map[string]int{"limit": 1})
+	if err != nil || string(raw) != `{"thing":"new","count":2}` || params[1] != `{"limit":1}` {
+		t.Errorf("Unexpected raw result %s, %v for params %s", raw, err, params[1])
+	}
+	if err := client.Call(context.Background(), "getNewThing", nil, nil); err != nil || params[2] != `{}` {
+		t.Errorf("Unexpected error %v for params %s", err, params[2])
+	}
+
+	_, err = client.CallRaw(context.Background(), "setOtherThing", nil)
+	var unsupported *UnsupportedError
+	if !errors.As(err, &unsupported) || !errors.Is(err, ErrMethodNotFound) || unsupported.Method != "setOtherThing" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(intercepted) != 4 || intercepted[3] != "setOtherThing" {
+		t.Errorf("Unexpected intercepted calls %v", intercepted)
+	}
+
+	// methods without result
+	raw, err = client.CallRaw(context.Background(), "setNewThing", nil)
+	if err != nil || string(raw) != "null" {
+		t.Errorf("Unexpected raw result %s, %v of void method", raw, err)
+	}
+}
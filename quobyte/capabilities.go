@@ -0,0 +1,256 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Version is a Quobyte release such as 3.14.2.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+var versionPattern = regexp.MustCompile(`([0-9]+)(?:\.([0-9]+))?(?:\.([0-9]+))?`)
+
+// ParseVersion returns the first version number in value, e.g. 3.14.2 for "3.14.2-rc1".
+func ParseVersion(value string) (Version, error) {
+	match := versionPattern.FindStringSubmatch(value)
+	if match == nil {
+		return Version{}, fmt.Errorf("no version in %q", value)
+	}
+	var parts [3]int
+	for i, part := range match[1:] {
+		if part == "" {
+			continue
+		}
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return Version{}, fmt.Errorf("version %q: %w", value, err)
+		}
+		parts[i] = number
+	}
+	return Version{Major: parts[0], Minor: parts[1], Patch: parts[2]}, nil
+}
+
+// IsZero returns true for the zero Version, which stands for an unknown version.
+func (version Version) IsZero() bool {
+	return version == Version{}
+}
+
+// Less returns true if version is an older release than other.
+func (version Version) Less(other Version) bool {
+	if version.Major != other.Major {
+		return version.Major < other.Major
+	}
+	if version.Minor != other.Minor {
+		return version.Minor < other.Minor
+	}
+	return version.Patch < other.Patch
+}
+
+func (version Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Patch)
+}
+
+// ServerInfo describes the API service of the current session.
+type ServerInfo struct {
+	// Version is zero if the server does not report it, e.g. for users without access to the
+	// license.
+	Version Version
+	// ProductVersion is the version as reported by the server.
+	ProductVersion string
+	// FeatureSet is the feature set of the license.
+	FeatureSet string
+}
+
+// UnsupportedError is returned for calls of methods that the server does not have. It matches
+// ErrUnsupportedByServer and, if the server answered the call, ErrMethodNotFound.
+type UnsupportedError struct {
+	Method string
+	// MinimumVersion is the first release with the method, empty if unknown.
+	MinimumVersion string
+	// ServerVersion is empty if the server version is unknown.
+	ServerVersion string
+	// Err is the error of the server, nil if the call was not sent.
+	Err error
+}
+
+func (err *UnsupportedError) Error() string {
+	server := "the server"
+	if err.ServerVersion != "" {
+		server = "Quobyte " + err.ServerVersion
+	}
+	if err.MinimumVersion == "" {
+		return fmt.Sprintf("method %s is not supported by %s", err.Method, server)
+	}
+	return fmt.Sprintf("method %s requires Quobyte %s or newer, not supported by %s", err.Method, err.MinimumVersion, server)
+}
+
+func (err *UnsupportedError) Is(target error) bool {
+	return target == ErrUnsupportedByServer
+}
+
+func (err *UnsupportedError) Unwrap() error {
+	return err.Err
+}
+
+// ServerInfo returns the version of the API service. It is requested once per session with
+// getLicense and cached. A server that denies access to the license or does not have getLicense
+// keeps an unknown version for the session, other errors are returned and not cached.
+func (client *QuobyteClient) ServerInfo() (ServerInfo, error) {
+	return client.ServerInfoContext(context.Background())
+}
+
+// ServerInfoContext is like ServerInfo but uses ctx for the request.
+func (client *QuobyteClient) ServerInfoContext(ctx context.Context) (ServerInfo, error) {
+	if info := client.endpoints.primary().session.serverInfo(); info != nil {
+		return *info, nil
+	}
+	call := &CallInfo{}
+	license, err := client.GetLicenseContext(WithCallInfo(ctx, call), &GetLicenseRequest{})
+	info, definite := licenseServerInfo(license, err)
+	if !definite {
+		return ServerInfo{}, err
+	}
+	// the version belongs to the session of the endpoint that answered
+	session := client.endpoints.lookup(call.Endpoint).session
+	session.mu.Lock()
+	session.server = &info
+	session.mu.Unlock()
+	return info, nil
+}
+
+// licenseServerInfo returns the server info of the result of getLicense and false if err leaves
+// the version open. A denied license and a server without getLicense have an unknown version.
+func licenseServerInfo(license *GetLicenseResponse, err error) (ServerInfo, bool) {
+	var info ServerInfo
+	switch {
+	case err == nil:
+		info.ProductVersion = license.ProductVersion
+		info.FeatureSet = license.FeatureSet
+		info.Version, _ = ParseVersion(license.ProductVersion)
+	case !errors.Is(err, ErrPermissionDenied) && !errors.Is(err, ErrMethodNotFound):
+		return ServerInfo{}, false
+	}
+	return info, true
+}
+
+// Supports returns false if the server does not have the JSON-RPC method, e.g.
+// Supports(MethodCreateMirroredVolume). The server version is detected once per session. Methods
+// are assumed to be supported unless the server is older than their minimum version or answered
+// them with method not found before.
+func (client *QuobyteClient) Supports(method string) bool {
+	return client.SupportsContext(context.Background(), method)
+}
+
+// SupportsContext is like Supports but uses ctx to detect the server version.
+func (client *QuobyteClient) SupportsContext(ctx context.Context, method string) bool {
+	return client.detectSupported(ctx, method) == nil
+}
+
+// detectSupported is checkSupported after detecting the server version for methods with a
+// minimum version.
+func (client QuobyteClient) detectSupported(ctx context.Context, method string) error {
+	if _, ok := minimumVersions[method]; ok {
+		client.detectVersion(ctx)
+	}
+	return client.checkSupported(method)
+}
+
+// detectVersion requests the version of the active endpoint once per session. Concurrent calls
+// wait for the running detection. Failures leave the version unknown for the session, the calls
+// themselves will tell if a method is missing.
+func (client QuobyteClient) detectVersion(ctx context.Context) {
+	ep := client.endpoints.primary()
+	session := ep.session
+	for {
+		session.mu.Lock()
+		if session.server != nil || session.detected {
+			session.mu.Unlock()
+			return
+		}
+		if session.detecting == nil {
+			session.detecting = make(chan struct{})
+			session.mu.Unlock()
+			break
+		}
+		detecting := session.detecting
+		session.mu.Unlock()
+		select {
+		case <-detecting:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	info, definite := licenseServerInfo(client.requestLicense(ctx, ep))
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if definite {
+		session.server = &info
+	}
+	// a canceled caller does not tell anything about the server
+	session.detected = ctx.Err() == nil
+	close(session.detecting)
+	session.detecting = nil
+}
+
+// requestLicense calls getLicense on ep. Like the probes of the circuit breaker, the call is not
+// seen by interceptors, metrics and limits, which only see the calls of the user.
+func (client QuobyteClient) requestLicense(ctx context.Context, ep *endpoint) (*GetLicenseResponse, error) {
+	request := &GetLicenseRequest{}
+	client.setRetryPolicy(request)
+	id := newRequestID()
+	message, err := encodeRequestWithID(id, MethodGetLicense, request)
+	if err != nil {
+		return nil, err
+	}
+	var response GetLicenseResponse
+	if err := client.sendMessage(ctx, ep, MethodGetLicense, message, client.decodeInto(MethodGetLicense, id, &response)); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// checkSupported fails for methods that are known to be unsupported in the session of the active
+// endpoint, without detecting the server version.
+func (client QuobyteClient) checkSupported(method string) error {
+	session := client.endpoints.primary().session
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	err := &UnsupportedError{Method: method, MinimumVersion: minimumVersions[method]}
+	if session.server != nil {
+		err.ServerVersion = session.server.ProductVersion
+	}
+	if session.unsupported[method] {
+		return err
+	}
+	if err.MinimumVersion == "" || session.server == nil || session.server.Version.IsZero() {
+		return nil
+	}
+	minimum, _ := ParseVersion(err.MinimumVersion)
+	if session.server.Version.Less(minimum) {
+		return err
+	}
+	return nil
+}
+
+// unsupported remembers that the server at endpoint answered method with method not found and
+// returns err as UnsupportedError.
+func (client QuobyteClient) unsupported(endpoint string, method string, err error) error {
+	session := client.endpoints.lookup(endpoint).session
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.unsupported == nil {
+		session.unsupported = map[string]bool{}
+	}
+	session.unsupported[method] = true
+	unsupported := &UnsupportedError{Method: method, MinimumVersion: minimumVersions[method], Err: err}
+	if session.server != nil {
+		unsupported.ServerVersion = session.server.ProductVersion
+	}
+	return unsupported
+}
@@ -0,0 +1,245 @@
+package quobyte
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Version
+	}{
+		{"3.14.2", Version{3, 14, 2}},
+		{"3.14", Version{3, 14, 0}},
+		{"4.0.0-rc1", Version{4, 0, 0}},
+		{"Quobyte 2.24.1 (build 17)", Version{2, 24, 1}},
+	}
+	for _, test := range tests {
+		if version, err := ParseVersion(test.value); err != nil || version != test.expected {
+			t.Errorf("%s: expected %v, got %v, %v", test.value, test.expected, version, err)
+		}
+	}
+	if _, err := ParseVersion("unknown"); err == nil {
+		t.Error("Expected error for unknown version")
+	}
+	if !(Version{2, 24, 1}).Less(Version{3, 0, 0}) || (Version{3, 1, 0}).Less(Version{3, 0, 9}) {
+		t.Error("Unexpected order of versions")
+	}
+}
+
+// withMinimumVersion sets the minimum version of the methods for the test.
+func withMinimumVersion(t *testing.T, version string, methods ...string) {
+	previous := map[string]string{}
+	for _, method := range methods {
+		previous[method] = minimumVersions[method]
+		minimumVersions[method] = version
+	}
+	t.Cleanup(func() {
+		for method, version := range previous {
+			if version == "" {
+				delete(minimumVersions, method)
+			} else {
+				minimumVersions[method] = version
+			}
+		}
+	})
+}
+
+func TestSupports(t *testing.T) {
+	withMinimumVersion(t, "3.0", MethodGetPolicyRules, MethodCreatePolicyRule)
+	calls := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		var rpcRequest request
+		json.NewDecoder(req.Body).Decode(&rpcRequest)
+		calls[rpcRequest.Method]++
+		switch rpcRequest.Method {
+		case MethodGetLicense:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":{"product_version":"2.24.1"}}`))
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","error":{"code":-32601,"message":"Method not found"}}`))
+		}
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL, WithCredentials("admin", "quobyte"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the version is only detected on demand
+	_, err = client.GetConfiguration(&GetConfigurationRequest{})
+	var unsupported *UnsupportedError
+	if !errors.Is(err, ErrUnsupportedByServer) || !errors.Is(err, ErrMethodNotFound) || !errors.As(err, &unsupported) ||
+		unsupported.Method != MethodGetConfiguration || unsupported.ServerVersion != "" {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.Supports(MethodGetConfiguration) || !client.Supports(MethodGetLicense) {
+		t.Error("Unexpected support of methods")
+	}
+	if calls[MethodGetLicense] != 0 {
+		t.Errorf("Version was detected for methods without minimum version")
+	}
+
+	// a method with a minimum version detects the version
+	_, err = client.GetPolicyRules(&GetPolicyRulesRequest{})
+	if err == nil || err.Error() != "method getPolicyRules requires Quobyte 3.0 or newer, not supported by Quobyte 2.24.1" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if calls[MethodGetPolicyRules] != 0 || calls[MethodGetLicense] != 1 {
+		t.Errorf("Unsupported method was sent")
+	}
+	if client.Supports(MethodCreatePolicyRule) || !client.Supports(MethodCreateMirroredVolume) {
+		t.Error("Unexpected support of methods by 2.24.1")
+	}
+	info, err := client.ServerInfo()
+	if err != nil || info.Version != (Version{2, 24, 1}) || calls[MethodGetLicense] != 1 {
+		t.Errorf("Unexpected server info %+v, %v after %d calls", info, err, calls[MethodGetLicense])
+	}
+
+	// a new session may talk to an upgraded server
+	client.endpoints.primary().session.invalidate()
+	client.ServerInfo()
+	if calls[MethodGetLicense] != 2 || !client.Supports(MethodGetConfiguration) {
+		t.Errorf("Server info was not reset with the session")
+	}
+}
+
+func TestServerInfoIsOnlyCachedForDefiniteAnswers(t *testing.T) {
+	var status int32 = http.StatusServiceUnavailable
+	var message string
+	licenseCalls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var rpcRequest request
+		json.NewDecoder(req.Body).Decode(&rpcRequest)
+		licenseCalls++
+		switch {
+		case status != http.StatusOK:
+			w.WriteHeader(int(status))
+		case message != "":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","error":{"code":-32000,"message":"` + message + `"}}`))
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":{"product_version":"3.1"}}`))
+		}
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryConfig(NoRetries())
+
+	// a transient failure is not cached
+	if _, err := client.ServerInfo(); err == nil {
+		t.Fatal("Expected error for unavailable server")
+	}
+	status = http.StatusOK
+	message = "Permission denied"
+	if info, err := client.ServerInfo(); err != nil || !info.Version.IsZero() {
+		t.Fatalf("Unexpected server info %+v, %v", info, err)
+	}
+	// a denied license is cached as unknown version
+	message = ""
+	if info, err := client.ServerInfo(); err != nil || !info.Version.IsZero() || licenseCalls != 2 {
+		t.Errorf("Unexpected server info %+v, %v after %d calls", info, err, licenseCalls)
+	}
+}
+
+func TestUnsupportedIsRecordedForServingEndpoint(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var rpcRequest request
+		json.NewDecoder(req.Body).Decode(&rpcRequest)
+		if rpcRequest.Method == MethodGetLicense {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":{"product_version":"3.1"}}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","error":{"code":-32601,"message":"Method not found"}}`))
+	}))
+	defer srv.Close()
+	var client *QuobyteClient
+	// concurrent calls may make another endpoint active while the answer is processed
+	reactivateFirst := func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+		err := invoker(ctx, method, request, response)
+		client.endpoints.markHealthy(client.endpoints.endpoints[0], true)
+		return err
+	}
+	client, err := NewClient(closedEndpoint(t), WithEndpoints(srv.URL), WithInterceptors(reactivateFirst))
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, second := client.endpoints.endpoints[0], client.endpoints.endpoints[1]
+
+	if _, err := client.GetConfiguration(&GetConfigurationRequest{}); !errors.Is(err, ErrUnsupportedByServer) {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.ServerInfo(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first.session.unsupported[MethodGetConfiguration] || !second.session.unsupported[MethodGetConfiguration] {
+		t.Error("Unsupported method was not recorded for the endpoint that served the call")
+	}
+	if first.session.serverInfo() != nil || second.session.serverInfo() == nil {
+		t.Error("Server info was not cached for the endpoint that served the call")
+	}
+}
+
+func TestVersionIsDetectedOncePerSession(t *testing.T) {
+	withMinimumVersion(t, "3.0", MethodGetPolicyRules)
+	var licenseCalls int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var rpcRequest request
+		json.NewDecoder(req.Body).Decode(&rpcRequest)
+		if rpcRequest.Method == MethodGetLicense {
+			atomic.AddInt64(&licenseCalls, 1)
+			time.Sleep(20 * time.Millisecond)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":{}}`))
+	}))
+	defer srv.Close()
+	var mu sync.Mutex
+	var intercepted []string
+	client, err := NewClient(srv.URL, WithInterceptors(func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+		mu.Lock()
+		intercepted = append(intercepted, method)
+		mu.Unlock()
+		return invoker(ctx, method, request, response)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// concurrent first calls share one detection, its failure is cached for the session
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetPolicyRules(&GetPolicyRulesRequest{}); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := client.GetPolicyRules(&GetPolicyRulesRequest{}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt64(&licenseCalls); got != 1 {
+		t.Errorf("Expected 1 version detection, got %d", got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for _, method := range intercepted {
+		if method != MethodGetPolicyRules {
+			t.Errorf("Interceptor saw %s", method)
+		}
+	}
+}
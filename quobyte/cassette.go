@@ -0,0 +1,304 @@
+package quobyte
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Interaction is a recorded JSON-RPC call. Values of fields carrying passwords, secrets or key
+// material are redacted in Params and Result.
+type Interaction struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+	// Status and Body are set for calls that failed on HTTP level.
+	Status int    `json:"status,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Cassette is a list of recorded JSON-RPC calls.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// LoadCassette reads a cassette file written by Recorder.Save.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to path.
+func (cassette *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// normalizeParams returns params with sorted keys and redacted secrets, so that calls can be
+// matched independent of the formatting and secrets do not end up in cassettes.
+func normalizeParams(params json.RawMessage) json.RawMessage {
+	if len(params) == 0 {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(redact(params))
+}
+
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  json.RawMessage `json:"error"`
+}
+
+// decodeMessages decodes a single JSON-RPC message or a batch.
+func decodeMessages(data []byte) ([]rpcMessage, bool, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var messages []rpcMessage
+		err := json.Unmarshal(data, &messages)
+		return messages, true, err
+	}
+	var message rpcMessage
+	err := json.Unmarshal(data, &message)
+	return []rpcMessage{message}, false, err
+}
+
+func readBody(body io.ReadCloser) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+// Recorder is an http.RoundTripper that records the JSON-RPC calls sent through it. Use it with
+// SetTransport and write the cassette with Save.
+type Recorder struct {
+	next http.RoundTripper
+	path string
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder creates a recorder that sends requests with next (http.DefaultTransport if nil)
+// and saves the calls to the cassette file at path.
+func NewRecorder(path string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{next: next, path: path}
+}
+
+// RoundTrip sends the request and records the JSON-RPC calls in it.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(requestBody))
+	resp, err := recorder.next.RoundTrip(clone)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := readBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	// rejected sessions are retried by the client with credentials
+	if resp.StatusCode == http.StatusUnauthorized {
+		return resp, nil
+	}
+	calls, _, err := decodeMessages(requestBody)
+	if err != nil {
+		return resp, nil
+	}
+	interactions := make([]Interaction, len(calls))
+	for i, call := range calls {
+		interactions[i] = Interaction{Method: call.Method, Params: normalizeParams(call.Params)}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			interactions[i].Status = resp.StatusCode
+			interactions[i].Body = string(responseBody)
+		}
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		responses, _, err := decodeMessages(responseBody)
+		if err != nil {
+			return resp, nil
+		}
+		for _, response := range responses {
+			for i, call := range calls {
+				if !bytes.Equal(call.ID, response.ID) {
+					continue
+				}
+				if len(response.Result) > 0 {
+					interactions[i].Result = json.RawMessage(redact(response.Result))
+				}
+				if len(response.Error) > 0 && string(response.Error) != "null" {
+					interactions[i].Error = response.Error
+				}
+			}
+		}
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, interactions...)
+	return resp, nil
+}
+
+// Cassette returns a copy of the recorded calls.
+func (recorder *Recorder) Cassette() *Cassette {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	return &Cassette{Interactions: append([]Interaction(nil), recorder.cassette.Interactions...)}
+}
+
+// Save writes the recorded calls to the cassette file.
+func (recorder *Recorder) Save() error {
+	return recorder.Cassette().Save(recorder.path)
+}
+
+// CassetteMismatchError is returned by Replayer for calls that were not recorded.
+type CassetteMismatchError struct {
+	Method string
+	Params string
+	// Recorded are the params of the recorded calls of the method.
+	Recorded []string
+}
+
+func (err *CassetteMismatchError) Error() string {
+	if len(err.Recorded) == 0 {
+		return fmt.Sprintf("cassette has no call of %s, requested params: %s", err.Method, err.Params)
+	}
+	return fmt.Sprintf("cassette has no call of %s with params %s, recorded params:\n  %s",
+		err.Method, err.Params, strings.Join(err.Recorded, "\n  "))
+}
+
+// Replayer is an http.RoundTripper that answers JSON-RPC calls from a cassette without network
+// access. Calls are matched by method and params, the random request IDs are ignored. Repeated
+// calls are answered in the recorded order, the last recorded answer is repeated.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions map[string][]Interaction
+	replayed     map[string]int
+}
+
+// NewReplayer creates a replayer for the cassette file at path.
+func NewReplayer(path string) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewCassetteReplayer(cassette), nil
+}
+
+// NewCassetteReplayer creates a replayer for the cassette.
+func NewCassetteReplayer(cassette *Cassette) *Replayer {
+	replayer := &Replayer{interactions: map[string][]Interaction{}, replayed: map[string]int{}}
+	for _, interaction := range cassette.Interactions {
+		key := interactionKey(interaction.Method, normalizeParams(interaction.Params))
+		replayer.interactions[key] = append(replayer.interactions[key], interaction)
+	}
+	return replayer
+}
+
+func interactionKey(method string, params json.RawMessage) string {
+	return method + " " + string(params)
+}
+
+// next returns the recorded answer for the call.
+func (replayer *Replayer) next(method string, params json.RawMessage) (Interaction, error) {
+	replayer.mu.Lock()
+	defer replayer.mu.Unlock()
+	params = normalizeParams(params)
+	key := interactionKey(method, params)
+	interactions := replayer.interactions[key]
+	if len(interactions) == 0 {
+		var recorded []string
+		for _, candidates := range replayer.interactions {
+			if candidates[0].Method == method {
+				recorded = append(recorded, string(normalizeParams(candidates[0].Params)))
+			}
+		}
+		sort.Strings(recorded)
+		return Interaction{}, &CassetteMismatchError{Method: method, Params: string(params), Recorded: recorded}
+	}
+	i := replayer.replayed[key]
+	if i < len(interactions)-1 {
+		replayer.replayed[key]++
+	}
+	return interactions[i], nil
+}
+
+// RoundTrip answers the JSON-RPC calls of the request from the cassette.
+func (replayer *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	calls, batch, err := decodeMessages(body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: request is no JSON-RPC call: %w", err)
+	}
+	responses := make([]map[string]json.RawMessage, len(calls))
+	for i, call := range calls {
+		interaction, err := replayer.next(call.Method, call.Params)
+		if err != nil {
+			return nil, err
+		}
+		if interaction.Status != 0 {
+			return replayResponse(req, interaction.Status, []byte(interaction.Body)), nil
+		}
+		responses[i] = map[string]json.RawMessage{"jsonrpc": json.RawMessage(`"2.0"`), "id": call.ID}
+		if len(interaction.Error) > 0 {
+			responses[i]["error"] = interaction.Error
+		} else {
+			responses[i]["result"] = interaction.Result
+		}
+	}
+	var data []byte
+	if batch {
+		data, err = json.Marshal(responses)
+	} else {
+		data, err = json.Marshal(responses[0])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return replayResponse(req, http.StatusOK, data), nil
+}
+
+func replayResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,102 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		w.Write(rpcResponse(req, `{"result":{"volume_uuid":"1234"}}`))
+	}))
+	defer srv.Close()
+
+	cassettePath := filepath.Join(t.TempDir(), "volumes.json")
+	recorder := NewRecorder(cassettePath, nil)
+	client, err := NewClient(srv.URL, WithCredentials("admin", "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetTransport(recorder)
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol", TenantId: "tenant"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.CreateUser(&CreateUserRequest{UserName: "alice", Password: "user-password"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "user-password") {
+		t.Errorf("Secret was recorded:\n%s", data)
+	}
+
+	replayer, err := NewReplayer(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the server is gone, all calls are answered from the cassette
+	client, err = NewClient("http://quobyte-api.invalid:7860", WithCredentials("admin", "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetTransport(replayer)
+	client.SetRetryConfig(NoRetries())
+	for i := 0; i < 2; i++ {
+		response, err := client.CreateVolume(&CreateVolumeRequest{TenantId: "tenant", Name: "vol"})
+		if err != nil || response.VolumeUuid != "1234" {
+			t.Fatalf("Unexpected result: %+v, %v", response, err)
+		}
+	}
+	// secrets are redacted before matching
+	if _, err := client.CreateUser(&CreateUserRequest{UserName: "alice", Password: "another-password"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = client.CreateVolume(&CreateVolumeRequest{TenantId: "tenant", Name: "other"})
+	var mismatch *CassetteMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("Expected CassetteMismatchError, got %v", err)
+	}
+	if mismatch.Method != "createVolume" || len(mismatch.Recorded) != 1 ||
+		!strings.Contains(err.Error(), `"name":"other"`) || !strings.Contains(err.Error(), `"name":"vol"`) {
+		t.Errorf("Unexpected mismatch report: %v", err)
+	}
+}
+
+func TestReplayBatch(t *testing.T) {
+	replayer := NewCassetteReplayer(&Cassette{Interactions: []Interaction{
+		{Method: "resolveVolumeName", Params: []byte(`{"volume_name":"a","retry":"INTERACTIVE"}`), Result: []byte(`{"volume_uuid":"uuid-a"}`)},
+		{Method: "resolveVolumeName", Params: []byte(`{"retry":"INTERACTIVE","volume_name":"b"}`), Error: []byte(`{"code":-32602,"message":"unknown volume"}`)},
+	}})
+	client, err := NewClient("http://quobyte-api.invalid:7860")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetTransport(replayer)
+	batch := client.NewBatch()
+	a := batch.Add("resolveVolumeName", &ResolveVolumeNameRequest{VolumeName: "a"}, &ResolveVolumeNameResponse{})
+	b := batch.Add("resolveVolumeName", &ResolveVolumeNameRequest{VolumeName: "b"}, &ResolveVolumeNameResponse{})
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if a.Err != nil || a.Response.(*ResolveVolumeNameResponse).VolumeUuid != "uuid-a" {
+		t.Errorf("Unexpected result: %+v, %v", a.Response, a.Err)
+	}
+	if !errors.Is(b.Err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", b.Err)
+	}
+}
@@ -0,0 +1,393 @@
+package quobyte
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// DumpOptions configures the download of a support or service dump.
+type DumpOptions struct {
+	// Progress is called with the number of dump bytes written so far after every write.
+	Progress func(written int64)
+	// Size is the expected size of the dump in bytes, 0 if unknown. Nothing beyond Size is written.
+	Size int64
+	// SHA256 is the expected hex encoded SHA-256 checksum of the dump, empty if unknown.
+	SHA256 string
+}
+
+// DumpInfo describes a downloaded dump.
+type DumpInfo struct {
+	// Size is the number of bytes written.
+	Size int64
+	// SHA256 is the hex encoded SHA-256 checksum of the bytes written.
+	SHA256 string
+	// S3UploadResponseCode is only set for service dumps, see GetServiceDumpResponse.
+	S3UploadResponseCode int32
+}
+
+// DownloadSupportDump writes the support dump to w. Unlike GetSupportDump, it decodes the
+// response while it is received and does not hold the dump in memory.
+//
+// The dump is only complete if no error is returned. It fails with ErrDumpMismatch if the dump
+// does not have the size or checksum given in options. The mismatch is only known once the dump
+// was written, so the bytes written to w must be discarded on any error. Interceptors see the
+// response without Bytes.
+func (client *QuobyteClient) DownloadSupportDump(request *GetSupportDumpRequest, w io.Writer, options DumpOptions) (DumpInfo, error) {
+	return client.DownloadSupportDumpContext(context.Background(), request, w, options)
+}
+
+// DownloadSupportDumpContext is like DownloadSupportDump but uses ctx for the request.
+func (client *QuobyteClient) DownloadSupportDumpContext(ctx context.Context, request *GetSupportDumpRequest, w io.Writer, options DumpOptions) (DumpInfo, error) {
+	var response GetSupportDumpResponse
+	return client.downloadDump(ctx, MethodGetSupportDump, request, &response, w, options)
+}
+
+// DownloadServiceDump writes the service dump to w, see DownloadSupportDump.
+func (client *QuobyteClient) DownloadServiceDump(request *GetServiceDumpRequest, w io.Writer, options DumpOptions) (DumpInfo, error) {
+	return client.DownloadServiceDumpContext(context.Background(), request, w, options)
+}
+
+// DownloadServiceDumpContext is like DownloadServiceDump but uses ctx for the request.
+func (client *QuobyteClient) DownloadServiceDumpContext(ctx context.Context, request *GetServiceDumpRequest, w io.Writer, options DumpOptions) (DumpInfo, error) {
+	var response GetServiceDumpResponse
+	info, err := client.downloadDump(ctx, MethodGetServiceDump, request, &response, w, options)
+	info.S3UploadResponseCode = response.S3UploadResponseCode
+	return info, err
+}
+
+// downloadDump sends the request like sendRequest and streams the base64 encoded "bytes" field
+// of the result to w. The other fields are decoded into response.
+func (client *QuobyteClient) downloadDump(ctx context.Context, method string, request interface{}, response interface{}, w io.Writer, options DumpOptions) (DumpInfo, error) {
+	dump := &dumpWriter{w: w, hash: sha256.New(), progress: options.Progress, limit: options.Size}
+	invoke := func(ctx context.Context, method string, request interface{}, response interface{}) error {
+		id := newRequestID()
+		message, err := encodeRequestWithID(id, method, request)
+		if err != nil {
+			return err
+		}
+		// the call is not idempotent for the client, the dump must not be written twice
+		return client.send(withStreamedResponse(ctx), method, false, message, func(body io.Reader) error {
+			data, err := decodeDumpResponse(body, "bytes", dump)
+			if err != nil {
+				return fmt.Errorf("method %s: %w", method, err)
+			}
+			return client.decodeData(method, id, data, response)
+		})
+	}
+	if err := client.sendRequestWith(ctx, method, request, response, invoke); err != nil {
+		return DumpInfo{}, err
+	}
+	info := DumpInfo{Size: dump.written, SHA256: hex.EncodeToString(dump.hash.Sum(nil))}
+	if options.Size > 0 && info.Size != options.Size {
+		return info, fmt.Errorf("%w: method %s: got %d bytes, expected %d", ErrDumpMismatch, method, info.Size, options.Size)
+	}
+	if options.SHA256 != "" && !strings.EqualFold(info.SHA256, options.SHA256) {
+		return info, fmt.Errorf("%w: method %s: got SHA-256 %s, expected %s", ErrDumpMismatch, method, info.SHA256, options.SHA256)
+	}
+	return info, nil
+}
+
+// dumpWriter writes to w and counts and hashes the bytes written.
+type dumpWriter struct {
+	w        io.Writer
+	hash     hash.Hash
+	written  int64
+	progress func(written int64)
+	// limit is the expected size, 0 if unknown
+	limit int64
+}
+
+func (dump *dumpWriter) Write(p []byte) (int, error) {
+	if dump.limit > 0 && dump.written+int64(len(p)) > dump.limit {
+		return 0, fmt.Errorf("%w: dump is larger than %d bytes", ErrDumpMismatch, dump.limit)
+	}
+	n, err := dump.w.Write(p)
+	dump.hash.Write(p[:n])
+	dump.written += int64(n)
+	if dump.progress != nil && n > 0 {
+		dump.progress(dump.written)
+	}
+	return n, err
+}
+
+// decodeDumpResponse reads the JSON-RPC response from body and base64 decodes the string field
+// of the result into w. It returns the response without the field.
+func decodeDumpResponse(body io.Reader, field string, w io.Writer) ([]byte, error) {
+	scanner := &jsonScanner{r: bufio.NewReader(body)}
+	envelope := map[string]json.RawMessage{}
+	err := scanner.object(func(key string) error {
+		if key != "result" {
+			return scanner.value(envelope, key)
+		}
+		if next, err := scanner.peek(); err != nil || next != '{' {
+			return scanner.value(envelope, key)
+		}
+		result := map[string]json.RawMessage{}
+		err := scanner.object(func(key string) error {
+			if key != field {
+				return scanner.value(result, key)
+			}
+			if next, err := scanner.peek(); err != nil || next != '"' {
+				return scanner.value(result, key)
+			}
+			scanner.r.ReadByte()
+			str := &jsonString{r: scanner.r}
+			if _, err := io.Copy(w, base64.NewDecoder(base64.StdEncoding, str)); err != nil {
+				return fmt.Errorf("field %s: %w", field, err)
+			}
+			if !str.done {
+				return fmt.Errorf("field %s: %w", field, io.ErrUnexpectedEOF)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(result)
+		envelope[key] = data
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(envelope)
+}
+
+// jsonScanner reads a JSON document piecewise, so that a single value can be streamed.
+type jsonScanner struct {
+	r *bufio.Reader
+}
+
+// peek returns the next byte that is not white space without consuming it.
+func (scanner *jsonScanner) peek() (byte, error) {
+	for {
+		next, err := scanner.r.ReadByte()
+		if err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		switch next {
+		case ' ', '\t', '\n', '\r':
+			continue
+		}
+		return next, scanner.r.UnreadByte()
+	}
+}
+
+func (scanner *jsonScanner) expect(expected byte) error {
+	next, err := scanner.peek()
+	if err != nil {
+		return err
+	}
+	if next != expected {
+		return fmt.Errorf("invalid character %q, expected %q", next, expected)
+	}
+	scanner.r.ReadByte()
+	return nil
+}
+
+// object reads an object and calls member for every key to read its value.
+func (scanner *jsonScanner) object(member func(key string) error) error {
+	if err := scanner.expect('{'); err != nil {
+		return err
+	}
+	if next, err := scanner.peek(); err != nil {
+		return err
+	} else if next == '}' {
+		scanner.r.ReadByte()
+		return nil
+	}
+	for {
+		var key string
+		if err := scanner.decode(&key); err != nil {
+			return err
+		}
+		if err := scanner.expect(':'); err != nil {
+			return err
+		}
+		if err := member(key); err != nil {
+			return err
+		}
+		next, err := scanner.peek()
+		if err != nil {
+			return err
+		}
+		scanner.r.ReadByte()
+		switch next {
+		case ',':
+		case '}':
+			return nil
+		default:
+			return fmt.Errorf("invalid character %q after object member", next)
+		}
+	}
+}
+
+// value reads the next value into values[key].
+func (scanner *jsonScanner) value(values map[string]json.RawMessage, key string) error {
+	var value json.RawMessage
+	if err := scanner.decode(&value); err != nil {
+		return err
+	}
+	values[key] = value
+	return nil
+}
+
+// decode reads the next value into v.
+func (scanner *jsonScanner) decode(v interface{}) error {
+	data, err := scanner.raw()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// raw returns the next value.
+func (scanner *jsonScanner) raw() ([]byte, error) {
+	if _, err := scanner.peek(); err != nil {
+		return nil, err
+	}
+	var data []byte
+	depth := 0
+	inString := false
+	for {
+		next, err := scanner.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if !inString && depth == 0 && len(data) > 0 && strings.IndexByte(",:}] \t\n\r", next) >= 0 {
+			// end of a number or literal
+			return data, scanner.r.UnreadByte()
+		}
+		data = append(data, next)
+		switch {
+		case inString && next == '\\':
+			escaped, err := scanner.r.ReadByte()
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			data = append(data, escaped)
+			continue
+		case next == '"':
+			inString = !inString
+		case inString:
+			continue
+		case next == '{' || next == '[':
+			depth++
+		case next == '}' || next == ']':
+			depth--
+		default:
+			continue
+		}
+		if depth == 0 && !inString {
+			return data, nil
+		}
+	}
+}
+
+// jsonString reads the unescaped content of a JSON string whose opening quote was read.
+type jsonString struct {
+	r    *bufio.Reader
+	done bool
+}
+
+func (str *jsonString) Read(p []byte) (int, error) {
+	n := 0
+	for n+utf8.UTFMax <= len(p) || (n == 0 && len(p) > 0) {
+		if str.done {
+			break
+		}
+		if n > 0 && str.r.Buffered() == 0 {
+			// do not block with data to return
+			break
+		}
+		next, err := str.r.ReadByte()
+		if err != nil {
+			return n, unexpectedEOF(err)
+		}
+		switch next {
+		case '"':
+			str.done = true
+		case '\\':
+			r, err := str.escape()
+			if err != nil {
+				return n, err
+			}
+			if n+utf8.RuneLen(r) > len(p) {
+				return n, errors.New("read buffer too small")
+			}
+			n += utf8.EncodeRune(p[n:], r)
+		default:
+			p[n] = next
+			n++
+		}
+	}
+	if str.done && n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// escape reads an escape sequence after the backslash.
+func (str *jsonString) escape() (rune, error) {
+	next, err := str.r.ReadByte()
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	switch next {
+	case '"', '\\', '/':
+		return rune(next), nil
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'u':
+		r, err := str.hex()
+		if err != nil || !utf16.IsSurrogate(r) {
+			return r, err
+		}
+		if next, err := str.r.Peek(2); err != nil || string(next) != `\u` {
+			return utf8.RuneError, nil
+		}
+		str.r.Discard(2)
+		low, err := str.hex()
+		return utf16.DecodeRune(r, low), err
+	}
+	return 0, fmt.Errorf("invalid escape sequence \\%c in string", next)
+}
+
+func (str *jsonString) hex() (rune, error) {
+	var digits [4]byte
+	if _, err := io.ReadFull(str.r, digits[:]); err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	r, err := strconv.ParseUint(string(digits[:]), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid escape sequence \\u%s in string", digits)
+	}
+	return rune(r), nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,117 @@
+package quobyte
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeDumpResponse(t *testing.T) {
+	body := `{"id":"1", "result" : {"s3_upload_response_code":200,"bytes":"aGVs\/G8\r\n=","x":[1,{"a":"}"}]}, "jsonrpc":"2.0"}`
+	var dump bytes.Buffer
+	data, err := decodeDumpResponse(strings.NewReader(body), "bytes", &dump)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected, _ := base64.StdEncoding.DecodeString("aGVs/G8="); !bytes.Equal(dump.Bytes(), expected) {
+		t.Errorf("Unexpected dump %q", dump.Bytes())
+	}
+	expected := `{"id":"1","jsonrpc":"2.0","result":{"s3_upload_response_code":200,"x":[1,{"a":"}"}]}}`
+	if string(data) != expected {
+		t.Errorf("Unexpected response %s", data)
+	}
+
+	for _, body := range []string{`{"id":"1","result":{"bytes":"aGVs`, `{"id":"1","result":{"bytes":"a$=="}}`, `{"id":"1"`} {
+		if _, err := decodeDumpResponse(strings.NewReader(body), "bytes", &dump); err == nil {
+			t.Errorf("Expected error for %s", body)
+		}
+	}
+}
+
+func TestDownloadDump(t *testing.T) {
+	dump := make([]byte, 1<<20)
+	rand.New(rand.NewSource(1)).Read(dump)
+	encoded := base64.StdEncoding.EncodeToString(dump)
+	sum := sha256.Sum256(dump)
+	checksum := hex.EncodeToString(sum[:])
+	received := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var rpcRequest request
+		json.NewDecoder(req.Body).Decode(&rpcRequest)
+		if rpcRequest.Method == MethodGetSupportDump {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","error":{"code":-32602,"message":"Unknown dump"}}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":{"s3_upload_response_code":200,"bytes":"` + encoded[:len(encoded)/2]))
+		w.(http.Flusher).Flush()
+		// the rest is sent once the client wrote the first part of the dump
+		select {
+		case <-received:
+		case <-time.After(5 * time.Second):
+			return
+		}
+		w.Write([]byte(encoded[len(encoded)/2:] + `"}}`))
+	}))
+	defer srv.Close()
+	// debug logging must not buffer the dump
+	var logged bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logged, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := NewClient(srv.URL, WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	var progress []int64
+	options := DumpOptions{
+		Progress: func(written int64) {
+			if len(progress) == 0 {
+				close(received)
+			}
+			progress = append(progress, written)
+		},
+		Size:   int64(len(dump)),
+		SHA256: strings.ToUpper(checksum),
+	}
+	info, err := client.DownloadServiceDump(&GetServiceDumpRequest{ServiceUuid: "data"}, &out, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), dump) || info.Size != int64(len(dump)) || info.SHA256 != checksum || info.S3UploadResponseCode != 200 {
+		t.Errorf("Unexpected dump of %d bytes, info %+v", out.Len(), info)
+	}
+	if len(progress) < 2 || progress[len(progress)-1] != int64(len(dump)) {
+		t.Errorf("Unexpected progress %v", progress)
+	}
+	if !strings.Contains(logged.String(), "[streamed, not logged]") || strings.Contains(logged.String(), encoded[:100]) {
+		t.Errorf("Unexpected log of the dump:\n%.1000s", logged.String())
+	}
+
+	options.Progress = nil
+	options.SHA256 = strings.Repeat("0", 64)
+	if _, err := client.DownloadServiceDump(&GetServiceDumpRequest{ServiceUuid: "data"}, &out, options); !errors.Is(err, ErrDumpMismatch) {
+		t.Errorf("Expected checksum mismatch, got %v", err)
+	}
+
+	// nothing beyond the expected size is written
+	out.Reset()
+	options.Size = 1000
+	if _, err := client.DownloadServiceDump(&GetServiceDumpRequest{ServiceUuid: "data"}, &out, options); !errors.Is(err, ErrDumpMismatch) || out.Len() > 1000 {
+		t.Errorf("Expected size mismatch after at most 1000 bytes, got %v after %d bytes", err, out.Len())
+	}
+
+	_, err = client.DownloadSupportDump(&GetSupportDumpRequest{SupportDumpId: "1"}, &out, DumpOptions{})
+	if !errors.Is(err, ErrInvalidParams) {
+		t.Errorf("Expected RPC error, got %v", err)
+	}
+}
@@ -0,0 +1,253 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const defaultEndpointRetryInterval = 30 * time.Second
+
+// EndpointStatus reports the health of one API endpoint of a client.
+type EndpointStatus struct {
+	URL string
+	// Active is true for the endpoint that currently serves the calls.
+	Active  bool
+	Healthy bool
+	// LastError is the error of the last failed call or health check.
+	LastError error
+	// LastChange is the time the endpoint last changed its health.
+	LastChange time.Time
+	Session    SessionInfo
+}
+
+// CallInfo is filled with details about a call. Register it with WithCallInfo.
+type CallInfo struct {
+	// Endpoint is the URL of the API endpoint that served the call.
+	Endpoint string
+	// Attempts is the number of requests sent for the call, including retries and failovers.
+	Attempts int
+}
+
+type callInfoKey struct{}
+
+// WithCallInfo returns a context that makes the client record details about calls made with
+// it in info. The context should only be used for one call at a time.
+func WithCallInfo(ctx context.Context, info *CallInfo) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+func callInfoFrom(ctx context.Context) *CallInfo {
+	info, _ := ctx.Value(callInfoKey{}).(*CallInfo)
+	return info
+}
+
+// endpoint is one API service the client can talk to. Every endpoint keeps its own session.
+type endpoint struct {
+	url     *url.URL
+	session *session
+
+	healthy    bool
+	lastError  error
+	lastChange time.Time
+}
+
+// endpointPool keeps track of the endpoints of a client and which of them is active.
+type endpointPool struct {
+	mu            sync.Mutex
+	endpoints     []*endpoint
+	active        int
+	retryInterval time.Duration
+}
+
+func newEndpointPool(urls []*url.URL, jar http.CookieJar) *endpointPool {
+	pool := &endpointPool{retryInterval: defaultEndpointRetryInterval}
+	for _, url := range urls {
+		pool.endpoints = append(pool.endpoints, &endpoint{
+			url:     url,
+			session: newSession(jar, url),
+			healthy: true,
+		})
+	}
+	return pool
+}
+
+// candidates returns the endpoints in the order they should be tried: the active one first, then
+// the healthy ones and last those that failed recently.
+func (pool *endpointPool) candidates() []*endpoint {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	now := time.Now()
+	result := make([]*endpoint, 0, len(pool.endpoints))
+	var unhealthy []*endpoint
+	for i := range pool.endpoints {
+		ep := pool.endpoints[(pool.active+i)%len(pool.endpoints)]
+		if ep.healthy || now.Sub(ep.lastChange) >= pool.retryInterval {
+			result = append(result, ep)
+		} else {
+			unhealthy = append(unhealthy, ep)
+		}
+	}
+	return append(result, unhealthy...)
+}
+
+func (pool *endpointPool) primary() *endpoint {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.endpoints[pool.active]
+}
+
+// lookup returns the endpoint with the URL, the active one if there is none.
+func (pool *endpointPool) lookup(url string) *endpoint {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	for _, ep := range pool.endpoints {
+		if ep.url.String() == url {
+			return ep
+		}
+	}
+	return pool.endpoints[pool.active]
+}
+
+// markHealthy records a response of ep. If activate is true, ep becomes the active endpoint.
+func (pool *endpointPool) markHealthy(ep *endpoint, activate bool) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if !ep.healthy {
+		ep.healthy = true
+		ep.lastChange = time.Now()
+	}
+	ep.lastError = nil
+	if activate {
+		for i, candidate := range pool.endpoints {
+			if candidate == ep {
+				pool.active = i
+			}
+		}
+	}
+}
+
+func (pool *endpointPool) markUnhealthy(ep *endpoint, err error) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	ep.healthy = false
+	ep.lastChange = time.Now()
+	ep.lastError = err
+	// move away from a failed active endpoint to the next healthy one
+	if pool.endpoints[pool.active] == ep {
+		for i := 1; i < len(pool.endpoints); i++ {
+			next := (pool.active + i) % len(pool.endpoints)
+			if pool.endpoints[next].healthy {
+				pool.active = next
+				break
+			}
+		}
+	}
+}
+
+func (pool *endpointPool) status() []EndpointStatus {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	result := make([]EndpointStatus, 0, len(pool.endpoints))
+	for i, ep := range pool.endpoints {
+		result = append(result, EndpointStatus{
+			URL:        ep.url.String(),
+			Active:     i == pool.active,
+			Healthy:    ep.healthy,
+			LastError:  ep.lastError,
+			LastChange: ep.lastChange,
+			Session:    ep.session.snapshot(),
+		})
+	}
+	return result
+}
+
+func shouldFailover(err error, idempotent bool) bool {
+	var rpcErr *RPCError
+	if idempotent && errors.As(err, &rpcErr) && rpcErr.Code == 0 && rpcErr.HTTPStatus >= 500 {
+		return true
+	}
+	return isRetryable(err, idempotent)
+}
+
+// withFailover calls send with the active endpoint and fails over to the other endpoints on
+// connection errors or, for idempotent calls, on 5xx responses.
+func (client *QuobyteClient) withFailover(ctx context.Context, idempotent bool, send func(*endpoint) error) error {
+	info := callInfoFrom(ctx)
+	var err error
+	for _, ep := range client.endpoints.candidates() {
+		if info != nil {
+			info.Endpoint = ep.url.String()
+			info.Attempts++
+		}
+		err = send(ep)
+		if err == nil || ctx.Err() != nil || !shouldFailover(err, idempotent) {
+			if ctx.Err() == nil {
+				client.endpoints.markHealthy(ep, true)
+			}
+			return err
+		}
+		client.endpoints.markUnhealthy(ep, err)
+	}
+	return err
+}
+
+// Endpoints returns the health of all API endpoints of the client.
+func (client *QuobyteClient) Endpoints() []EndpointStatus {
+	return client.endpoints.status()
+}
+
+// SetEndpointRetryInterval sets how long a failed endpoint is skipped before calls are sent to it
+// again without a successful health check.
+func (client *QuobyteClient) SetEndpointRetryInterval(interval time.Duration) {
+	client.endpoints.mu.Lock()
+	defer client.endpoints.mu.Unlock()
+	client.endpoints.retryInterval = interval
+}
+
+// CheckEndpoints probes every API endpoint with a whoAmI call and updates its health.
+func (client *QuobyteClient) CheckEndpoints(ctx context.Context) []EndpointStatus {
+	var wg sync.WaitGroup
+	for _, ep := range client.endpoints.endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+			var response WhoAmIResponse
+			id := newRequestID()
+			message, err := encodeRequestWithID(id, "whoAmI", &WhoAmIRequest{})
+			if err == nil {
+				err = client.sendMessage(ctx, ep, "whoAmI", message, client.decodeInto("whoAmI", id, &response))
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			var rpcErr *RPCError
+			if err == nil || (errors.As(err, &rpcErr) && rpcErr.HTTPStatus < 500) {
+				client.endpoints.markHealthy(ep, false)
+			} else {
+				client.endpoints.markUnhealthy(ep, err)
+			}
+		}(ep)
+	}
+	wg.Wait()
+	return client.endpoints.status()
+}
+
+// StartHealthChecks runs CheckEndpoints every interval until ctx is done.
+func (client *QuobyteClient) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				client.CheckEndpoints(ctx)
+			}
+		}
+	}()
+}
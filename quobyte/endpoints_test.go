@@ -0,0 +1,145 @@
+package quobyte
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func newEndpointServer(t *testing.T, status *int32) (*httptest.Server, *int64) {
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		w.Write(rpcResponse(req, `{"result":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func closedEndpoint(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	return "http://" + listener.Addr().String()
+}
+
+func TestFailoverOn5xx(t *testing.T) {
+	firstStatus, secondStatus := int32(http.StatusServiceUnavailable), int32(http.StatusOK)
+	first, firstRequests := newEndpointServer(t, &firstStatus)
+	second, secondRequests := newEndpointServer(t, &secondStatus)
+
+	client, err := NewQuobyteClientWithEndpoints([]string{first.URL, second.URL}, "user", "pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryConfig(NoRetries())
+
+	var info CallInfo
+	if _, err := client.GetVolumeListContext(WithCallInfo(context.Background(), &info), &GetVolumeListRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if info.Endpoint != second.URL || info.Attempts != 2 {
+		t.Errorf("Unexpected call info: %+v", info)
+	}
+
+	// second endpoint stays active
+	info = CallInfo{}
+	if _, err := client.GetVolumeListContext(WithCallInfo(context.Background(), &info), &GetVolumeListRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if info.Endpoint != second.URL || info.Attempts != 1 {
+		t.Errorf("Unexpected call info: %+v", info)
+	}
+	if got := atomic.LoadInt64(firstRequests); got != 1 {
+		t.Errorf("Expected 1 request to the failed endpoint, got %d", got)
+	}
+	if got := atomic.LoadInt64(secondRequests); got != 2 {
+		t.Errorf("Expected 2 requests to the active endpoint, got %d", got)
+	}
+
+	status := client.Endpoints()
+	if status[0].Healthy || status[0].Active || !status[1].Healthy || !status[1].Active {
+		t.Errorf("Unexpected endpoint status: %+v", status)
+	}
+}
+
+func TestNoFailoverOfNonIdempotentCallOn5xx(t *testing.T) {
+	firstStatus, secondStatus := int32(http.StatusServiceUnavailable), int32(http.StatusOK)
+	first, _ := newEndpointServer(t, &firstStatus)
+	second, secondRequests := newEndpointServer(t, &secondStatus)
+
+	client, err := NewQuobyteClientWithEndpoints([]string{first.URL, second.URL}, "user", "pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err == nil {
+		t.Fatal("Expected error")
+	}
+	if got := atomic.LoadInt64(secondRequests); got != 0 {
+		t.Errorf("Non-idempotent call was replayed on another endpoint")
+	}
+}
+
+func TestFailoverOnConnectionError(t *testing.T) {
+	status := int32(http.StatusOK)
+	srv, _ := newEndpointServer(t, &status)
+
+	client, err := NewQuobyteClientWithEndpoints([]string{closedEndpoint(t), srv.URL}, "user", "pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryConfig(NoRetries())
+
+	var info CallInfo
+	if _, err := client.CreateVolumeContext(WithCallInfo(context.Background(), &info), &CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if info.Endpoint != srv.URL {
+		t.Errorf("Expected call to be served by %s, got %s", srv.URL, info.Endpoint)
+	}
+}
+
+func TestCheckEndpoints(t *testing.T) {
+	firstStatus, secondStatus := int32(http.StatusOK), int32(http.StatusOK)
+	first, _ := newEndpointServer(t, &firstStatus)
+	second, _ := newEndpointServer(t, &secondStatus)
+
+	client, err := NewQuobyteClientWithEndpoints([]string{first.URL, second.URL, closedEndpoint(t)}, "user", "pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&firstStatus, http.StatusBadGateway)
+
+	status := client.CheckEndpoints(context.Background())
+	if status[0].Healthy || !status[1].Healthy || status[2].Healthy {
+		t.Fatalf("Unexpected endpoint status: %+v", status)
+	}
+	if !status[1].Active {
+		t.Errorf("Expected healthy endpoint to become active: %+v", status)
+	}
+	if status[1].Session.State != SessionActive {
+		t.Errorf("Expected session with healthy endpoint: %+v", status[1].Session)
+	}
+
+	atomic.StoreInt32(&firstStatus, http.StatusOK)
+	status = client.CheckEndpoints(context.Background())
+	if !status[0].Healthy {
+		t.Errorf("Expected recovered endpoint to be healthy: %+v", status[0])
+	}
+}
+
+func TestNewQuobyteClientWithoutEndpoints(t *testing.T) {
+	if _, err := NewQuobyteClientWithEndpoints(nil, "user", "pw"); err == nil {
+		t.Fatal("Expected error")
+	}
+}
@@ -0,0 +1,107 @@
+package quobyte
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// EnumValueError reports a value that is not defined for an enum in types.go, e.g. a new value
+// sent by a newer API service. It matches ErrUnknownEnumValue.
+type EnumValueError struct {
+	// Type is the enum type, e.g. "TaskState".
+	Type  string
+	Value string
+	// Method and Path locate the value in a response, e.g. "tasks[0].state". They are empty for
+	// errors of the Parse functions.
+	Method string
+	Path   string
+}
+
+func (err *EnumValueError) Error() string {
+	if err.Path == "" {
+		return fmt.Sprintf("unknown %s value %q", err.Type, err.Value)
+	}
+	return fmt.Sprintf("method %s: unknown %s value %q at %s", err.Method, err.Type, err.Value, err.Path)
+}
+
+func (err *EnumValueError) Is(target error) bool {
+	return target == ErrUnknownEnumValue
+}
+
+// SetEnumValidation makes the client reject responses with enum values that are unknown to
+// types.go. The error lists all unknown values as *EnumValueError and matches
+// ErrUnknownEnumValue.
+func (client *QuobyteClient) SetEnumValidation(validate bool) {
+	client.validateEnums = validate
+}
+
+// WithEnumValidation makes the client reject responses with unknown enum values, see
+// SetEnumValidation.
+func WithEnumValidation() Option {
+	return func(opts *clientOptions) error {
+		opts.validateEnums = true
+		return nil
+	}
+}
+
+// enum is implemented by the generated enum types.
+type enum interface {
+	IsValid() bool
+}
+
+var enumType = reflect.TypeOf((*enum)(nil)).Elem()
+
+// validateEnums returns the unknown enum values in the decoded response v.
+func validateEnums(method string, v interface{}) error {
+	var errs []error
+	walkEnums(reflect.ValueOf(v), "", func(path string, value reflect.Value) {
+		errs = append(errs, &EnumValueError{
+			Type:   value.Type().Name(),
+			Value:  value.String(),
+			Method: method,
+			Path:   path,
+		})
+	})
+	return errors.Join(errs...)
+}
+
+// walkEnums calls unknown for all non-empty enum values in value that are not valid.
+func walkEnums(value reflect.Value, path string, unknown func(path string, value reflect.Value)) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			walkEnums(value.Elem(), path, unknown)
+		}
+	case reflect.String:
+		// fields are omitted if empty, so the empty value means absent
+		if value.Type().Implements(enumType) && value.Len() > 0 && !value.Interface().(enum).IsValid() {
+			unknown(path, value)
+		}
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			// bytes such as json.RawMessage results of CallRaw
+			return
+		}
+		for i := 0; i < value.Len(); i++ {
+			walkEnums(value.Index(i), path+"["+strconv.Itoa(i)+"]", unknown)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" {
+				name = field.Name
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			walkEnums(value.Field(i), name, unknown)
+		}
+	}
+}
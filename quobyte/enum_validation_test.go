@@ -0,0 +1,51 @@
+package quobyte
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseEnum(t *testing.T) {
+	state, err := ParseTaskState("RUNNING")
+	if err != nil || state != TaskState_RUNNING {
+		t.Errorf("Unexpected result %q, %v", state, err)
+	}
+	if _, err := ParseTaskState("PAUSED"); !errors.Is(err, ErrUnknownEnumValue) {
+		t.Errorf("Expected ErrUnknownEnumValue, got %v", err)
+	}
+	for _, value := range TaskState("").Values() {
+		if !value.IsValid() {
+			t.Errorf("%q is not valid", value)
+		}
+	}
+	if TaskState("").IsValid() || TaskState("running").IsValid() {
+		t.Error("Unexpected valid TaskState")
+	}
+}
+
+func TestEnumValidation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write(rpcResponse(req, `{"result":{"tasks":[{"state":"RUNNING"},{"state":"PAUSED"},{}]}}`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetTaskList(&GetTaskListRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	client.SetEnumValidation(true)
+	_, err = client.GetTaskList(&GetTaskListRequest{})
+	var valueErr *EnumValueError
+	if !errors.Is(err, ErrUnknownEnumValue) || !errors.As(err, &valueErr) {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if valueErr.Method != MethodGetTaskList || valueErr.Path != "tasks[1].state" ||
+		valueErr.Type != "TaskState" || valueErr.Value != "PAUSED" {
+		t.Errorf("Unexpected error: %+v", valueErr)
+	}
+}
@@ -0,0 +1,3694 @@
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
+
+package quobyte
+
+// Values returns all values of AccessKeyType.
+func (AccessKeyType) Values() []AccessKeyType {
+	return []AccessKeyType{
+		AccessKeyType_DATA_ACCESS_KEY,
+		AccessKeyType_GENERAL_ACCESS_KEY,
+		AccessKeyType_MANAGEMENT_ACCESS_KEY,
+		AccessKeyType_S3,
+	}
+}
+
+// IsValid returns true if value is a value of AccessKeyType.
+func (value AccessKeyType) IsValid() bool {
+	switch value {
+	case AccessKeyType_DATA_ACCESS_KEY,
+		AccessKeyType_GENERAL_ACCESS_KEY,
+		AccessKeyType_MANAGEMENT_ACCESS_KEY,
+		AccessKeyType_S3:
+		return true
+	}
+	return false
+}
+
+// ParseAccessKeyType returns the AccessKeyType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseAccessKeyType(value string) (AccessKeyType, error) {
+	if parsed := AccessKeyType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "AccessKeyType", Value: value}
+}
+
+// Values returns all values of AlertState.
+func (AlertState) Values() []AlertState {
+	return []AlertState{
+		AlertState_DISABLED,
+		AlertState_DORMANT,
+		AlertState_FIRING,
+		AlertState_INHIBITED,
+		AlertState_SIGNALLED,
+		AlertState_SILENCED,
+	}
+}
+
+// IsValid returns true if value is a value of AlertState.
+func (value AlertState) IsValid() bool {
+	switch value {
+	case AlertState_DISABLED,
+		AlertState_DORMANT,
+		AlertState_FIRING,
+		AlertState_INHIBITED,
+		AlertState_SIGNALLED,
+		AlertState_SILENCED:
+		return true
+	}
+	return false
+}
+
+// ParseAlertState returns the AlertState with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseAlertState(value string) (AlertState, error) {
+	if parsed := AlertState(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "AlertState", Value: value}
+}
+
+// Values returns all values of ConfigurationType.
+func (ConfigurationType) Values() []ConfigurationType {
+	return []ConfigurationType{
+		ConfigurationType_FAILURE_DOMAINS,
+		ConfigurationType_QUOTA_POOL,
+		ConfigurationType_RULE_CONFIGURATION,
+		ConfigurationType_SYSTEM_CONFIGURATION,
+		ConfigurationType_TENANT_DOMAIN,
+		ConfigurationType_USER,
+		ConfigurationType_VIRTUAL_IP,
+	}
+}
+
+// IsValid returns true if value is a value of ConfigurationType.
+func (value ConfigurationType) IsValid() bool {
+	switch value {
+	case ConfigurationType_FAILURE_DOMAINS,
+		ConfigurationType_QUOTA_POOL,
+		ConfigurationType_RULE_CONFIGURATION,
+		ConfigurationType_SYSTEM_CONFIGURATION,
+		ConfigurationType_TENANT_DOMAIN,
+		ConfigurationType_USER,
+		ConfigurationType_VIRTUAL_IP:
+		return true
+	}
+	return false
+}
+
+// ParseConfigurationType returns the ConfigurationType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseConfigurationType(value string) (ConfigurationType, error) {
+	if parsed := ConfigurationType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ConfigurationType", Value: value}
+}
+
+// Values returns all values of CrlReason.
+func (CrlReason) Values() []CrlReason {
+	return []CrlReason{
+		CrlReason_AA_COMPROMISE,
+		CrlReason_AFFILIATION_CHANGED,
+		CrlReason_CA_COMPROMISE,
+		CrlReason_CERTIFICATE_HOLD,
+		CrlReason_CESSATION_OF_OPERATION,
+		CrlReason_KEY_COMPROMISE,
+		CrlReason_PRIVILEGE_WITHDRAWN,
+		CrlReason_REMOVE_FROM_CRL,
+		CrlReason_SUPERSEDED,
+		CrlReason_UNSPECIFIED,
+	}
+}
+
+// IsValid returns true if value is a value of CrlReason.
+func (value CrlReason) IsValid() bool {
+	switch value {
+	case CrlReason_AA_COMPROMISE,
+		CrlReason_AFFILIATION_CHANGED,
+		CrlReason_CA_COMPROMISE,
+		CrlReason_CERTIFICATE_HOLD,
+		CrlReason_CESSATION_OF_OPERATION,
+		CrlReason_KEY_COMPROMISE,
+		CrlReason_PRIVILEGE_WITHDRAWN,
+		CrlReason_REMOVE_FROM_CRL,
+		CrlReason_SUPERSEDED,
+		CrlReason_UNSPECIFIED:
+		return true
+	}
+	return false
+}
+
+// ParseCrlReason returns the CrlReason with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCrlReason(value string) (CrlReason, error) {
+	if parsed := CrlReason(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CrlReason", Value: value}
+}
+
+// Values returns all values of CsrState.
+func (CsrState) Values() []CsrState {
+	return []CsrState{
+		CsrState_APPROVED,
+		CsrState_PENDING,
+		CsrState_REJECTED,
+	}
+}
+
+// IsValid returns true if value is a value of CsrState.
+func (value CsrState) IsValid() bool {
+	switch value {
+	case CsrState_APPROVED,
+		CsrState_PENDING,
+		CsrState_REJECTED:
+		return true
+	}
+	return false
+}
+
+// ParseCsrState returns the CsrState with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCsrState(value string) (CsrState, error) {
+	if parsed := CsrState(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CsrState", Value: value}
+}
+
+// Values returns all values of DeviceHardwareType.
+func (DeviceHardwareType) Values() []DeviceHardwareType {
+	return []DeviceHardwareType{
+		DeviceHardwareType_ROTATING_DISK,
+		DeviceHardwareType_SHINGLED_DISK,
+		DeviceHardwareType_SOLID_STATE_DISK,
+		DeviceHardwareType_SOLID_STATE_DISK_NVME,
+		DeviceHardwareType_UNKNOWN,
+	}
+}
+
+// IsValid returns true if value is a value of DeviceHardwareType.
+func (value DeviceHardwareType) IsValid() bool {
+	switch value {
+	case DeviceHardwareType_ROTATING_DISK,
+		DeviceHardwareType_SHINGLED_DISK,
+		DeviceHardwareType_SOLID_STATE_DISK,
+		DeviceHardwareType_SOLID_STATE_DISK_NVME,
+		DeviceHardwareType_UNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseDeviceHardwareType returns the DeviceHardwareType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDeviceHardwareType(value string) (DeviceHardwareType, error) {
+	if parsed := DeviceHardwareType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "DeviceHardwareType", Value: value}
+}
+
+// Values returns all values of FailureDomainType.
+func (FailureDomainType) Values() []FailureDomainType {
+	return []FailureDomainType{
+		FailureDomainType_CLUSTER,
+		FailureDomainType_MACHINE,
+		FailureDomainType_METRO,
+		FailureDomainType_POWER_1,
+		FailureDomainType_POWER_2,
+		FailureDomainType_RACK,
+		FailureDomainType_ROOM,
+	}
+}
+
+// IsValid returns true if value is a value of FailureDomainType.
+func (value FailureDomainType) IsValid() bool {
+	switch value {
+	case FailureDomainType_CLUSTER,
+		FailureDomainType_MACHINE,
+		FailureDomainType_METRO,
+		FailureDomainType_POWER_1,
+		FailureDomainType_POWER_2,
+		FailureDomainType_RACK,
+		FailureDomainType_ROOM:
+		return true
+	}
+	return false
+}
+
+// ParseFailureDomainType returns the FailureDomainType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFailureDomainType(value string) (FailureDomainType, error) {
+	if parsed := FailureDomainType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FailureDomainType", Value: value}
+}
+
+// Values returns all values of HashMethod.
+func (HashMethod) Values() []HashMethod {
+	return []HashMethod{
+		HashMethod_SALTED_PBKDF2_SHA512,
+		HashMethod_SALTED_SHA512,
+	}
+}
+
+// IsValid returns true if value is a value of HashMethod.
+func (value HashMethod) IsValid() bool {
+	switch value {
+	case HashMethod_SALTED_PBKDF2_SHA512,
+		HashMethod_SALTED_SHA512:
+		return true
+	}
+	return false
+}
+
+// ParseHashMethod returns the HashMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseHashMethod(value string) (HashMethod, error) {
+	if parsed := HashMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "HashMethod", Value: value}
+}
+
+// Values returns all values of ImplicitLockingMode.
+func (ImplicitLockingMode) Values() []ImplicitLockingMode {
+	return []ImplicitLockingMode{
+		ImplicitLockingMode_FILE_BLOCKING,
+		ImplicitLockingMode_FILE_NON_BLOCKING,
+		ImplicitLockingMode_IO_BLOCKING,
+		ImplicitLockingMode_IO_NON_BLOCKING,
+		ImplicitLockingMode_NO_LOCKING,
+	}
+}
+
+// IsValid returns true if value is a value of ImplicitLockingMode.
+func (value ImplicitLockingMode) IsValid() bool {
+	switch value {
+	case ImplicitLockingMode_FILE_BLOCKING,
+		ImplicitLockingMode_FILE_NON_BLOCKING,
+		ImplicitLockingMode_IO_BLOCKING,
+		ImplicitLockingMode_IO_NON_BLOCKING,
+		ImplicitLockingMode_NO_LOCKING:
+		return true
+	}
+	return false
+}
+
+// ParseImplicitLockingMode returns the ImplicitLockingMode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseImplicitLockingMode(value string) (ImplicitLockingMode, error) {
+	if parsed := ImplicitLockingMode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ImplicitLockingMode", Value: value}
+}
+
+// Values returns all values of KeystoreSlotOwner.
+func (KeystoreSlotOwner) Values() []KeystoreSlotOwner {
+	return []KeystoreSlotOwner{
+		KeystoreSlotOwner_SYSTEM_SLOT,
+		KeystoreSlotOwner_USER_SLOT,
+	}
+}
+
+// IsValid returns true if value is a value of KeystoreSlotOwner.
+func (value KeystoreSlotOwner) IsValid() bool {
+	switch value {
+	case KeystoreSlotOwner_SYSTEM_SLOT,
+		KeystoreSlotOwner_USER_SLOT:
+		return true
+	}
+	return false
+}
+
+// ParseKeystoreSlotOwner returns the KeystoreSlotOwner with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseKeystoreSlotOwner(value string) (KeystoreSlotOwner, error) {
+	if parsed := KeystoreSlotOwner(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "KeystoreSlotOwner", Value: value}
+}
+
+// Values returns all values of LostLockBehavior.
+func (LostLockBehavior) Values() []LostLockBehavior {
+	return []LostLockBehavior{
+		LostLockBehavior_BLOCK_IO,
+		LostLockBehavior_IO_ERROR,
+		LostLockBehavior_KILL_APPLICATION,
+	}
+}
+
+// IsValid returns true if value is a value of LostLockBehavior.
+func (value LostLockBehavior) IsValid() bool {
+	switch value {
+	case LostLockBehavior_BLOCK_IO,
+		LostLockBehavior_IO_ERROR,
+		LostLockBehavior_KILL_APPLICATION:
+		return true
+	}
+	return false
+}
+
+// ParseLostLockBehavior returns the LostLockBehavior with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseLostLockBehavior(value string) (LostLockBehavior, error) {
+	if parsed := LostLockBehavior(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "LostLockBehavior", Value: value}
+}
+
+// Values returns all values of ModeOverride.
+func (ModeOverride) Values() []ModeOverride {
+	return []ModeOverride{
+		ModeOverride_AS_REQUESTED,
+		ModeOverride_DISABLE_ALWAYS,
+		ModeOverride_ENABLE_ALWAYS,
+	}
+}
+
+// IsValid returns true if value is a value of ModeOverride.
+func (value ModeOverride) IsValid() bool {
+	switch value {
+	case ModeOverride_AS_REQUESTED,
+		ModeOverride_DISABLE_ALWAYS,
+		ModeOverride_ENABLE_ALWAYS:
+		return true
+	}
+	return false
+}
+
+// ParseModeOverride returns the ModeOverride with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseModeOverride(value string) (ModeOverride, error) {
+	if parsed := ModeOverride(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ModeOverride", Value: value}
+}
+
+// Values returns all values of PageCacheMode.
+func (PageCacheMode) Values() []PageCacheMode {
+	return []PageCacheMode{
+		PageCacheMode_FLUSH_ALWAYS,
+		PageCacheMode_KEEP_ALWAYS,
+		PageCacheMode_USE_HEURISTIC,
+	}
+}
+
+// IsValid returns true if value is a value of PageCacheMode.
+func (value PageCacheMode) IsValid() bool {
+	switch value {
+	case PageCacheMode_FLUSH_ALWAYS,
+		PageCacheMode_KEEP_ALWAYS,
+		PageCacheMode_USE_HEURISTIC:
+		return true
+	}
+	return false
+}
+
+// ParsePageCacheMode returns the PageCacheMode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParsePageCacheMode(value string) (PageCacheMode, error) {
+	if parsed := PageCacheMode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "PageCacheMode", Value: value}
+}
+
+// Values returns all values of QuorumReadMode.
+func (QuorumReadMode) Values() []QuorumReadMode {
+	return []QuorumReadMode{
+		QuorumReadMode_FIRST_READ,
+		QuorumReadMode_OFF,
+	}
+}
+
+// IsValid returns true if value is a value of QuorumReadMode.
+func (value QuorumReadMode) IsValid() bool {
+	switch value {
+	case QuorumReadMode_FIRST_READ,
+		QuorumReadMode_OFF:
+		return true
+	}
+	return false
+}
+
+// ParseQuorumReadMode returns the QuorumReadMode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseQuorumReadMode(value string) (QuorumReadMode, error) {
+	if parsed := QuorumReadMode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "QuorumReadMode", Value: value}
+}
+
+// Values returns all values of RpcRetryMode.
+func (RpcRetryMode) Values() []RpcRetryMode {
+	return []RpcRetryMode{
+		RpcRetryMode_RETRY_FOREVER,
+		RpcRetryMode_RETRY_FOREVER_UNLESS_FULL,
+		RpcRetryMode_RETRY_INTERACTIVE,
+		RpcRetryMode_RETRY_NEVER,
+	}
+}
+
+// IsValid returns true if value is a value of RpcRetryMode.
+func (value RpcRetryMode) IsValid() bool {
+	switch value {
+	case RpcRetryMode_RETRY_FOREVER,
+		RpcRetryMode_RETRY_FOREVER_UNLESS_FULL,
+		RpcRetryMode_RETRY_INTERACTIVE,
+		RpcRetryMode_RETRY_NEVER:
+		return true
+	}
+	return false
+}
+
+// ParseRpcRetryMode returns the RpcRetryMode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseRpcRetryMode(value string) (RpcRetryMode, error) {
+	if parsed := RpcRetryMode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "RpcRetryMode", Value: value}
+}
+
+// Values returns all values of ServiceType.
+func (ServiceType) Values() []ServiceType {
+	return []ServiceType{
+		ServiceType_API_PROXY,
+		ServiceType_CLIENT,
+		ServiceType_DIRECTORY_SERVICE,
+		ServiceType_METADATA_SERVICE,
+		ServiceType_NFS_PROXY,
+		ServiceType_S3_PROXY,
+		ServiceType_STORAGE_SERVICE,
+		ServiceType_WEBCONSOLE,
+	}
+}
+
+// IsValid returns true if value is a value of ServiceType.
+func (value ServiceType) IsValid() bool {
+	switch value {
+	case ServiceType_API_PROXY,
+		ServiceType_CLIENT,
+		ServiceType_DIRECTORY_SERVICE,
+		ServiceType_METADATA_SERVICE,
+		ServiceType_NFS_PROXY,
+		ServiceType_S3_PROXY,
+		ServiceType_STORAGE_SERVICE,
+		ServiceType_WEBCONSOLE:
+		return true
+	}
+	return false
+}
+
+// ParseServiceType returns the ServiceType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseServiceType(value string) (ServiceType, error) {
+	if parsed := ServiceType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ServiceType", Value: value}
+}
+
+// Values returns all values of TaskPriority.
+func (TaskPriority) Values() []TaskPriority {
+	return []TaskPriority{
+		TaskPriority_HIGH,
+		TaskPriority_LOW,
+		TaskPriority_NORMAL,
+		TaskPriority_VERY_HIGH,
+		TaskPriority_VERY_LOW,
+	}
+}
+
+// IsValid returns true if value is a value of TaskPriority.
+func (value TaskPriority) IsValid() bool {
+	switch value {
+	case TaskPriority_HIGH,
+		TaskPriority_LOW,
+		TaskPriority_NORMAL,
+		TaskPriority_VERY_HIGH,
+		TaskPriority_VERY_LOW:
+		return true
+	}
+	return false
+}
+
+// ParseTaskPriority returns the TaskPriority with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseTaskPriority(value string) (TaskPriority, error) {
+	if parsed := TaskPriority(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "TaskPriority", Value: value}
+}
+
+// Values returns all values of TaskState.
+func (TaskState) Values() []TaskState {
+	return []TaskState{
+		TaskState_CANCELED,
+		TaskState_CANCELLING,
+		TaskState_FAILED,
+		TaskState_FINISHED,
+		TaskState_QUEUED,
+		TaskState_RUNNING,
+		TaskState_SCHEDULED,
+	}
+}
+
+// IsValid returns true if value is a value of TaskState.
+func (value TaskState) IsValid() bool {
+	switch value {
+	case TaskState_CANCELED,
+		TaskState_CANCELLING,
+		TaskState_FAILED,
+		TaskState_FINISHED,
+		TaskState_QUEUED,
+		TaskState_RUNNING,
+		TaskState_SCHEDULED:
+		return true
+	}
+	return false
+}
+
+// ParseTaskState returns the TaskState with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseTaskState(value string) (TaskState, error) {
+	if parsed := TaskState(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "TaskState", Value: value}
+}
+
+// Values returns all values of TaskType.
+func (TaskType) Values() []TaskType {
+	return []TaskType{
+		TaskType_ANALYZE_VOLUMES,
+		TaskType_CATCH_UP,
+		TaskType_CHECK_FILES,
+		TaskType_CLEANUP,
+		TaskType_COPY_FILES,
+		TaskType_DELETE_FILES_IN_VOLUMES,
+		TaskType_DRAIN,
+		TaskType_ENFORCE_PLACEMENT,
+		TaskType_ENFORCE_VOLUME_PLACEMENT,
+		TaskType_ERASE_SNAPSHOTS,
+		TaskType_ERASE_VOLUMES,
+		TaskType_FSTRIM,
+		TaskType_MAKE_DEVICE,
+		TaskType_MOVE_FILES,
+		TaskType_REACCOUNT_VOLUMES,
+		TaskType_REBALANCE,
+		TaskType_REBALANCE_METADATA_DEVICES,
+		TaskType_RECODE_FILES,
+		TaskType_REGENERATE,
+		TaskType_RELEASE_ROLLOUT,
+		TaskType_RETENTION_CLEANUP,
+		TaskType_SCRUB,
+		TaskType_TIERING,
+		TaskType_UPDATE_DATABASE_SCHEMA,
+		TaskType_UPDATE_FILE_SIZES,
+	}
+}
+
+// IsValid returns true if value is a value of TaskType.
+func (value TaskType) IsValid() bool {
+	switch value {
+	case TaskType_ANALYZE_VOLUMES,
+		TaskType_CATCH_UP,
+		TaskType_CHECK_FILES,
+		TaskType_CLEANUP,
+		TaskType_COPY_FILES,
+		TaskType_DELETE_FILES_IN_VOLUMES,
+		TaskType_DRAIN,
+		TaskType_ENFORCE_PLACEMENT,
+		TaskType_ENFORCE_VOLUME_PLACEMENT,
+		TaskType_ERASE_SNAPSHOTS,
+		TaskType_ERASE_VOLUMES,
+		TaskType_FSTRIM,
+		TaskType_MAKE_DEVICE,
+		TaskType_MOVE_FILES,
+		TaskType_REACCOUNT_VOLUMES,
+		TaskType_REBALANCE,
+		TaskType_REBALANCE_METADATA_DEVICES,
+		TaskType_RECODE_FILES,
+		TaskType_REGENERATE,
+		TaskType_RELEASE_ROLLOUT,
+		TaskType_RETENTION_CLEANUP,
+		TaskType_SCRUB,
+		TaskType_TIERING,
+		TaskType_UPDATE_DATABASE_SCHEMA,
+		TaskType_UPDATE_FILE_SIZES:
+		return true
+	}
+	return false
+}
+
+// ParseTaskType returns the TaskType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseTaskType(value string) (TaskType, error) {
+	if parsed := TaskType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "TaskType", Value: value}
+}
+
+// Values returns all values of UserDatabase.
+func (UserDatabase) Values() []UserDatabase {
+	return []UserDatabase{
+		UserDatabase_DB,
+		UserDatabase_KEYSTONE,
+		UserDatabase_LDAP,
+		UserDatabase_OIDC,
+	}
+}
+
+// IsValid returns true if value is a value of UserDatabase.
+func (value UserDatabase) IsValid() bool {
+	switch value {
+	case UserDatabase_DB,
+		UserDatabase_KEYSTONE,
+		UserDatabase_LDAP,
+		UserDatabase_OIDC:
+		return true
+	}
+	return false
+}
+
+// ParseUserDatabase returns the UserDatabase with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseUserDatabase(value string) (UserDatabase, error) {
+	if parsed := UserDatabase(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "UserDatabase", Value: value}
+}
+
+// Values returns all values of UserRole.
+func (UserRole) Values() []UserRole {
+	return []UserRole{
+		UserRole_FILESYSTEM_ADMIN,
+		UserRole_FILESYSTEM_ADMIN_READONLY,
+		UserRole_HARDWARE_OPERATOR,
+		UserRole_OBSOLETE_DOMAIN_ADMIN,
+		UserRole_OBSOLETE_DOMAIN_ADMIN_READONLY,
+		UserRole_SUPER_USER,
+		UserRole_SUPER_USER_READONLY,
+		UserRole_UNPRIVILEGED_USER,
+	}
+}
+
+// IsValid returns true if value is a value of UserRole.
+func (value UserRole) IsValid() bool {
+	switch value {
+	case UserRole_FILESYSTEM_ADMIN,
+		UserRole_FILESYSTEM_ADMIN_READONLY,
+		UserRole_HARDWARE_OPERATOR,
+		UserRole_OBSOLETE_DOMAIN_ADMIN,
+		UserRole_OBSOLETE_DOMAIN_ADMIN_READONLY,
+		UserRole_SUPER_USER,
+		UserRole_SUPER_USER_READONLY,
+		UserRole_UNPRIVILEGED_USER:
+		return true
+	}
+	return false
+}
+
+// ParseUserRole returns the UserRole with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseUserRole(value string) (UserRole, error) {
+	if parsed := UserRole(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "UserRole", Value: value}
+}
+
+// Values returns all values of AccessControlList_AceFlags.
+func (AccessControlList_AceFlags) Values() []AccessControlList_AceFlags {
+	return []AccessControlList_AceFlags{
+		AccessControlList_AceFlags_DIR_INHERIT,
+		AccessControlList_AceFlags_FILE_INHERIT,
+		AccessControlList_AceFlags_GROUP,
+		AccessControlList_AceFlags_INHERIT_ONLY,
+		AccessControlList_AceFlags_NO_PROPAGATE_INHERIT,
+	}
+}
+
+// IsValid returns true if value is a value of AccessControlList_AceFlags.
+func (value AccessControlList_AceFlags) IsValid() bool {
+	switch value {
+	case AccessControlList_AceFlags_DIR_INHERIT,
+		AccessControlList_AceFlags_FILE_INHERIT,
+		AccessControlList_AceFlags_GROUP,
+		AccessControlList_AceFlags_INHERIT_ONLY,
+		AccessControlList_AceFlags_NO_PROPAGATE_INHERIT:
+		return true
+	}
+	return false
+}
+
+// ParseAccessControlList_AceFlags returns the AccessControlList_AceFlags with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseAccessControlList_AceFlags(value string) (AccessControlList_AceFlags, error) {
+	if parsed := AccessControlList_AceFlags(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "AccessControlList_AceFlags", Value: value}
+}
+
+// Values returns all values of AccessControlList_AcePermissionMask.
+func (AccessControlList_AcePermissionMask) Values() []AccessControlList_AcePermissionMask {
+	return []AccessControlList_AcePermissionMask{
+		AccessControlList_AcePermissionMask_APPEND,
+		AccessControlList_AcePermissionMask_CHOWN,
+		AccessControlList_AcePermissionMask_DELETE,
+		AccessControlList_AcePermissionMask_DELETE_CHILD,
+		AccessControlList_AcePermissionMask_EXECUTE,
+		AccessControlList_AcePermissionMask_READ,
+		AccessControlList_AcePermissionMask_READ_ACL,
+		AccessControlList_AcePermissionMask_READ_ATTRIBUTES,
+		AccessControlList_AcePermissionMask_READ_NAMED_ATTRS,
+		AccessControlList_AcePermissionMask_SYNCHRONIZE_FILE,
+		AccessControlList_AcePermissionMask_WRITE,
+		AccessControlList_AcePermissionMask_WRITE_ACL,
+		AccessControlList_AcePermissionMask_WRITE_ATTRIBUTES,
+		AccessControlList_AcePermissionMask_WRITE_NAMED_ATTRS,
+	}
+}
+
+// IsValid returns true if value is a value of AccessControlList_AcePermissionMask.
+func (value AccessControlList_AcePermissionMask) IsValid() bool {
+	switch value {
+	case AccessControlList_AcePermissionMask_APPEND,
+		AccessControlList_AcePermissionMask_CHOWN,
+		AccessControlList_AcePermissionMask_DELETE,
+		AccessControlList_AcePermissionMask_DELETE_CHILD,
+		AccessControlList_AcePermissionMask_EXECUTE,
+		AccessControlList_AcePermissionMask_READ,
+		AccessControlList_AcePermissionMask_READ_ACL,
+		AccessControlList_AcePermissionMask_READ_ATTRIBUTES,
+		AccessControlList_AcePermissionMask_READ_NAMED_ATTRS,
+		AccessControlList_AcePermissionMask_SYNCHRONIZE_FILE,
+		AccessControlList_AcePermissionMask_WRITE,
+		AccessControlList_AcePermissionMask_WRITE_ACL,
+		AccessControlList_AcePermissionMask_WRITE_ATTRIBUTES,
+		AccessControlList_AcePermissionMask_WRITE_NAMED_ATTRS:
+		return true
+	}
+	return false
+}
+
+// ParseAccessControlList_AcePermissionMask returns the AccessControlList_AcePermissionMask with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseAccessControlList_AcePermissionMask(value string) (AccessControlList_AcePermissionMask, error) {
+	if parsed := AccessControlList_AcePermissionMask(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "AccessControlList_AcePermissionMask", Value: value}
+}
+
+// Values returns all values of AccessControlList_AceType.
+func (AccessControlList_AceType) Values() []AccessControlList_AceType {
+	return []AccessControlList_AceType{
+		AccessControlList_AceType_ALLOW,
+		AccessControlList_AceType_DENY,
+	}
+}
+
+// IsValid returns true if value is a value of AccessControlList_AceType.
+func (value AccessControlList_AceType) IsValid() bool {
+	switch value {
+	case AccessControlList_AceType_ALLOW,
+		AccessControlList_AceType_DENY:
+		return true
+	}
+	return false
+}
+
+// ParseAccessControlList_AceType returns the AccessControlList_AceType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseAccessControlList_AceType(value string) (AccessControlList_AceType, error) {
+	if parsed := AccessControlList_AceType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "AccessControlList_AceType", Value: value}
+}
+
+// Values returns all values of AccountingResource_Type.
+func (AccountingResource_Type) Values() []AccountingResource_Type {
+	return []AccountingResource_Type{
+		AccountingResource_Type_DIRECTORY_COUNT,
+		AccountingResource_Type_FILE_COUNT,
+		AccountingResource_Type_FILE_WITH_ERROR_COUNT,
+		AccountingResource_Type_HDD_ALLOCATED_DISK_SPACE,
+		AccountingResource_Type_HDD_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_LOGICAL_DISK_SPACE,
+		AccountingResource_Type_NVME_ALLOCATED_DISK_SPACE,
+		AccountingResource_Type_NVME_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_SSD_ALLOCATED_DISK_SPACE,
+		AccountingResource_Type_SSD_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_VOLUME_COUNT,
+	}
+}
+
+// IsValid returns true if value is a value of AccountingResource_Type.
+func (value AccountingResource_Type) IsValid() bool {
+	switch value {
+	case AccountingResource_Type_DIRECTORY_COUNT,
+		AccountingResource_Type_FILE_COUNT,
+		AccountingResource_Type_FILE_WITH_ERROR_COUNT,
+		AccountingResource_Type_HDD_ALLOCATED_DISK_SPACE,
+		AccountingResource_Type_HDD_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_LOGICAL_DISK_SPACE,
+		AccountingResource_Type_NVME_ALLOCATED_DISK_SPACE,
+		AccountingResource_Type_NVME_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_SSD_ALLOCATED_DISK_SPACE,
+		AccountingResource_Type_SSD_PHYSICAL_DISK_SPACE,
+		AccountingResource_Type_VOLUME_COUNT:
+		return true
+	}
+	return false
+}
+
+// ParseAccountingResource_Type returns the AccountingResource_Type with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseAccountingResource_Type(value string) (AccountingResource_Type, error) {
+	if parsed := AccountingResource_Type(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "AccountingResource_Type", Value: value}
+}
+
+// Values returns all values of AuditEvent_SubjectType.
+func (AuditEvent_SubjectType) Values() []AuditEvent_SubjectType {
+	return []AuditEvent_SubjectType{
+		AuditEvent_SubjectType_CONFIGURATION,
+		AuditEvent_SubjectType_DEVICE,
+		AuditEvent_SubjectType_KEY_STORE,
+		AuditEvent_SubjectType_POLICY_RULE,
+		AuditEvent_SubjectType_QUOTA,
+		AuditEvent_SubjectType_RULE,
+		AuditEvent_SubjectType_TASK,
+		AuditEvent_SubjectType_USER,
+		AuditEvent_SubjectType_VOLUME,
+		AuditEvent_SubjectType_VOLUME_CONFIGURATION,
+	}
+}
+
+// IsValid returns true if value is a value of AuditEvent_SubjectType.
+func (value AuditEvent_SubjectType) IsValid() bool {
+	switch value {
+	case AuditEvent_SubjectType_CONFIGURATION,
+		AuditEvent_SubjectType_DEVICE,
+		AuditEvent_SubjectType_KEY_STORE,
+		AuditEvent_SubjectType_POLICY_RULE,
+		AuditEvent_SubjectType_QUOTA,
+		AuditEvent_SubjectType_RULE,
+		AuditEvent_SubjectType_TASK,
+		AuditEvent_SubjectType_USER,
+		AuditEvent_SubjectType_VOLUME,
+		AuditEvent_SubjectType_VOLUME_CONFIGURATION:
+		return true
+	}
+	return false
+}
+
+// ParseAuditEvent_SubjectType returns the AuditEvent_SubjectType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseAuditEvent_SubjectType(value string) (AuditEvent_SubjectType, error) {
+	if parsed := AuditEvent_SubjectType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "AuditEvent_SubjectType", Value: value}
+}
+
+// Values returns all values of ChangePolicyRulePriorityRequest_PriorityChange.
+func (ChangePolicyRulePriorityRequest_PriorityChange) Values() []ChangePolicyRulePriorityRequest_PriorityChange {
+	return []ChangePolicyRulePriorityRequest_PriorityChange{
+		ChangePolicyRulePriorityRequest_PriorityChange_DECREASE,
+		ChangePolicyRulePriorityRequest_PriorityChange_INCREASE,
+	}
+}
+
+// IsValid returns true if value is a value of ChangePolicyRulePriorityRequest_PriorityChange.
+func (value ChangePolicyRulePriorityRequest_PriorityChange) IsValid() bool {
+	switch value {
+	case ChangePolicyRulePriorityRequest_PriorityChange_DECREASE,
+		ChangePolicyRulePriorityRequest_PriorityChange_INCREASE:
+		return true
+	}
+	return false
+}
+
+// ParseChangePolicyRulePriorityRequest_PriorityChange returns the ChangePolicyRulePriorityRequest_PriorityChange with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseChangePolicyRulePriorityRequest_PriorityChange(value string) (ChangePolicyRulePriorityRequest_PriorityChange, error) {
+	if parsed := ChangePolicyRulePriorityRequest_PriorityChange(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ChangePolicyRulePriorityRequest_PriorityChange", Value: value}
+}
+
+// Values returns all values of ClientCachePolicy_Mode.
+func (ClientCachePolicy_Mode) Values() []ClientCachePolicy_Mode {
+	return []ClientCachePolicy_Mode{
+		ClientCachePolicy_Mode_DISABLE_ALWAYS,
+		ClientCachePolicy_Mode_DISABLE_FOR_O_DIRECT,
+		ClientCachePolicy_Mode_ENABLE_ALWAYS,
+	}
+}
+
+// IsValid returns true if value is a value of ClientCachePolicy_Mode.
+func (value ClientCachePolicy_Mode) IsValid() bool {
+	switch value {
+	case ClientCachePolicy_Mode_DISABLE_ALWAYS,
+		ClientCachePolicy_Mode_DISABLE_FOR_O_DIRECT,
+		ClientCachePolicy_Mode_ENABLE_ALWAYS:
+		return true
+	}
+	return false
+}
+
+// ParseClientCachePolicy_Mode returns the ClientCachePolicy_Mode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseClientCachePolicy_Mode(value string) (ClientCachePolicy_Mode, error) {
+	if parsed := ClientCachePolicy_Mode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ClientCachePolicy_Mode", Value: value}
+}
+
+// Values returns all values of ClientScope_ClientType.
+func (ClientScope_ClientType) Values() []ClientScope_ClientType {
+	return []ClientScope_ClientType{
+		ClientScope_ClientType_NATIVE,
+		ClientScope_ClientType_NFS,
+		ClientScope_ClientType_S3,
+	}
+}
+
+// IsValid returns true if value is a value of ClientScope_ClientType.
+func (value ClientScope_ClientType) IsValid() bool {
+	switch value {
+	case ClientScope_ClientType_NATIVE,
+		ClientScope_ClientType_NFS,
+		ClientScope_ClientType_S3:
+		return true
+	}
+	return false
+}
+
+// ParseClientScope_ClientType returns the ClientScope_ClientType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseClientScope_ClientType(value string) (ClientScope_ClientType, error) {
+	if parsed := ClientScope_ClientType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ClientScope_ClientType", Value: value}
+}
+
+// Values returns all values of ConcurrentAppendHandlingPolicy_Behavior.
+func (ConcurrentAppendHandlingPolicy_Behavior) Values() []ConcurrentAppendHandlingPolicy_Behavior {
+	return []ConcurrentAppendHandlingPolicy_Behavior{
+		ConcurrentAppendHandlingPolicy_Behavior_ALLOW_READ_MODIFY_WRITE,
+		ConcurrentAppendHandlingPolicy_Behavior_IO_ERROR,
+	}
+}
+
+// IsValid returns true if value is a value of ConcurrentAppendHandlingPolicy_Behavior.
+func (value ConcurrentAppendHandlingPolicy_Behavior) IsValid() bool {
+	switch value {
+	case ConcurrentAppendHandlingPolicy_Behavior_ALLOW_READ_MODIFY_WRITE,
+		ConcurrentAppendHandlingPolicy_Behavior_IO_ERROR:
+		return true
+	}
+	return false
+}
+
+// ParseConcurrentAppendHandlingPolicy_Behavior returns the ConcurrentAppendHandlingPolicy_Behavior with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseConcurrentAppendHandlingPolicy_Behavior(value string) (ConcurrentAppendHandlingPolicy_Behavior, error) {
+	if parsed := ConcurrentAppendHandlingPolicy_Behavior(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ConcurrentAppendHandlingPolicy_Behavior", Value: value}
+}
+
+// Values returns all values of ConsumingEntity_Type.
+func (ConsumingEntity_Type) Values() []ConsumingEntity_Type {
+	return []ConsumingEntity_Type{
+		ConsumingEntity_Type_DEVICE,
+		ConsumingEntity_Type_FAILURE_DOMAIN,
+		ConsumingEntity_Type_GROUP,
+		ConsumingEntity_Type_SYSTEM,
+		ConsumingEntity_Type_TENANT,
+		ConsumingEntity_Type_USER,
+		ConsumingEntity_Type_VOLUME,
+		ConsumingEntity_Type_VOLUME_SNAPSHOT,
+	}
+}
+
+// IsValid returns true if value is a value of ConsumingEntity_Type.
+func (value ConsumingEntity_Type) IsValid() bool {
+	switch value {
+	case ConsumingEntity_Type_DEVICE,
+		ConsumingEntity_Type_FAILURE_DOMAIN,
+		ConsumingEntity_Type_GROUP,
+		ConsumingEntity_Type_SYSTEM,
+		ConsumingEntity_Type_TENANT,
+		ConsumingEntity_Type_USER,
+		ConsumingEntity_Type_VOLUME,
+		ConsumingEntity_Type_VOLUME_SNAPSHOT:
+		return true
+	}
+	return false
+}
+
+// ParseConsumingEntity_Type returns the ConsumingEntity_Type with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseConsumingEntity_Type(value string) (ConsumingEntity_Type, error) {
+	if parsed := ConsumingEntity_Type(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ConsumingEntity_Type", Value: value}
+}
+
+// Values returns all values of CopyFilesSettings_Job_CommitAction.
+func (CopyFilesSettings_Job_CommitAction) Values() []CopyFilesSettings_Job_CommitAction {
+	return []CopyFilesSettings_Job_CommitAction{
+		CopyFilesSettings_Job_CommitAction_DELETE_SOURCE_FILE,
+	}
+}
+
+// IsValid returns true if value is a value of CopyFilesSettings_Job_CommitAction.
+func (value CopyFilesSettings_Job_CommitAction) IsValid() bool {
+	switch value {
+	case CopyFilesSettings_Job_CommitAction_DELETE_SOURCE_FILE:
+		return true
+	}
+	return false
+}
+
+// ParseCopyFilesSettings_Job_CommitAction returns the CopyFilesSettings_Job_CommitAction with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCopyFilesSettings_Job_CommitAction(value string) (CopyFilesSettings_Job_CommitAction, error) {
+	if parsed := CopyFilesSettings_Job_CommitAction(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CopyFilesSettings_Job_CommitAction", Value: value}
+}
+
+// Values returns all values of CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior.
+func (CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior) Values() []CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior {
+	return []CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior{
+		CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior_FAIL_IF_FILE_EXISTS,
+		CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior_OVERWRITE_EXISTING_FILE,
+		CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior_OVERWRITE_EXISTING_FILE_IF_OLDER,
+	}
+}
+
+// IsValid returns true if value is a value of CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior.
+func (value CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior) IsValid() bool {
+	switch value {
+	case CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior_FAIL_IF_FILE_EXISTS,
+		CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior_OVERWRITE_EXISTING_FILE,
+		CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior_OVERWRITE_EXISTING_FILE_IF_OLDER:
+		return true
+	}
+	return false
+}
+
+// ParseCopyFilesSettings_Job_DestinationFileSettings_CreateBehavior returns the CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCopyFilesSettings_Job_DestinationFileSettings_CreateBehavior(value string) (CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior, error) {
+	if parsed := CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CopyFilesSettings_Job_DestinationFileSettings_CreateBehavior", Value: value}
+}
+
+// Values returns all values of CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting.
+func (CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting) Values() []CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting {
+	return []CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting{
+		CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting_APPLY_DESTINATION_POLICY_RULES,
+	}
+}
+
+// IsValid returns true if value is a value of CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting.
+func (value CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting) IsValid() bool {
+	switch value {
+	case CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting_APPLY_DESTINATION_POLICY_RULES:
+		return true
+	}
+	return false
+}
+
+// ParseCopyFilesSettings_Job_DestinationFileSettings_RedundancySetting returns the CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCopyFilesSettings_Job_DestinationFileSettings_RedundancySetting(value string) (CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting, error) {
+	if parsed := CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CopyFilesSettings_Job_DestinationFileSettings_RedundancySetting", Value: value}
+}
+
+// Values returns all values of CopyFilesSettings_Job_Filter_Operator.
+func (CopyFilesSettings_Job_Filter_Operator) Values() []CopyFilesSettings_Job_Filter_Operator {
+	return []CopyFilesSettings_Job_Filter_Operator{
+		CopyFilesSettings_Job_Filter_Operator_EQUALS,
+		CopyFilesSettings_Job_Filter_Operator_LARGER_THAN,
+		CopyFilesSettings_Job_Filter_Operator_LARGER_THAN_OR_EQUAL_TO,
+		CopyFilesSettings_Job_Filter_Operator_SMALLER_THAN,
+		CopyFilesSettings_Job_Filter_Operator_SMALLER_THAN_OR_EQUAL_TO,
+	}
+}
+
+// IsValid returns true if value is a value of CopyFilesSettings_Job_Filter_Operator.
+func (value CopyFilesSettings_Job_Filter_Operator) IsValid() bool {
+	switch value {
+	case CopyFilesSettings_Job_Filter_Operator_EQUALS,
+		CopyFilesSettings_Job_Filter_Operator_LARGER_THAN,
+		CopyFilesSettings_Job_Filter_Operator_LARGER_THAN_OR_EQUAL_TO,
+		CopyFilesSettings_Job_Filter_Operator_SMALLER_THAN,
+		CopyFilesSettings_Job_Filter_Operator_SMALLER_THAN_OR_EQUAL_TO:
+		return true
+	}
+	return false
+}
+
+// ParseCopyFilesSettings_Job_Filter_Operator returns the CopyFilesSettings_Job_Filter_Operator with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCopyFilesSettings_Job_Filter_Operator(value string) (CopyFilesSettings_Job_Filter_Operator, error) {
+	if parsed := CopyFilesSettings_Job_Filter_Operator(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CopyFilesSettings_Job_Filter_Operator", Value: value}
+}
+
+// Values returns all values of CopyFilesSettings_Job_Filter_Type.
+func (CopyFilesSettings_Job_Filter_Type) Values() []CopyFilesSettings_Job_Filter_Type {
+	return []CopyFilesSettings_Job_Filter_Type{
+		CopyFilesSettings_Job_Filter_Type_CURRENT_FILE_SIZE,
+		CopyFilesSettings_Job_Filter_Type_LAST_ACCESS_AGE_S,
+		CopyFilesSettings_Job_Filter_Type_LAST_MODIFICATION_AGE_S,
+	}
+}
+
+// IsValid returns true if value is a value of CopyFilesSettings_Job_Filter_Type.
+func (value CopyFilesSettings_Job_Filter_Type) IsValid() bool {
+	switch value {
+	case CopyFilesSettings_Job_Filter_Type_CURRENT_FILE_SIZE,
+		CopyFilesSettings_Job_Filter_Type_LAST_ACCESS_AGE_S,
+		CopyFilesSettings_Job_Filter_Type_LAST_MODIFICATION_AGE_S:
+		return true
+	}
+	return false
+}
+
+// ParseCopyFilesSettings_Job_Filter_Type returns the CopyFilesSettings_Job_Filter_Type with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCopyFilesSettings_Job_Filter_Type(value string) (CopyFilesSettings_Job_Filter_Type, error) {
+	if parsed := CopyFilesSettings_Job_Filter_Type(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CopyFilesSettings_Job_Filter_Type", Value: value}
+}
+
+// Values returns all values of CreateVolumeRequest_VolumeEncryptionProfile.
+func (CreateVolumeRequest_VolumeEncryptionProfile) Values() []CreateVolumeRequest_VolumeEncryptionProfile {
+	return []CreateVolumeRequest_VolumeEncryptionProfile{
+		CreateVolumeRequest_VolumeEncryptionProfile_NONE,
+		CreateVolumeRequest_VolumeEncryptionProfile_SYSTEM_AES_128,
+		CreateVolumeRequest_VolumeEncryptionProfile_SYSTEM_AES_256,
+		CreateVolumeRequest_VolumeEncryptionProfile_USER_AES_128,
+		CreateVolumeRequest_VolumeEncryptionProfile_USER_AES_256,
+	}
+}
+
+// IsValid returns true if value is a value of CreateVolumeRequest_VolumeEncryptionProfile.
+func (value CreateVolumeRequest_VolumeEncryptionProfile) IsValid() bool {
+	switch value {
+	case CreateVolumeRequest_VolumeEncryptionProfile_NONE,
+		CreateVolumeRequest_VolumeEncryptionProfile_SYSTEM_AES_128,
+		CreateVolumeRequest_VolumeEncryptionProfile_SYSTEM_AES_256,
+		CreateVolumeRequest_VolumeEncryptionProfile_USER_AES_128,
+		CreateVolumeRequest_VolumeEncryptionProfile_USER_AES_256:
+		return true
+	}
+	return false
+}
+
+// ParseCreateVolumeRequest_VolumeEncryptionProfile returns the CreateVolumeRequest_VolumeEncryptionProfile with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseCreateVolumeRequest_VolumeEncryptionProfile(value string) (CreateVolumeRequest_VolumeEncryptionProfile, error) {
+	if parsed := CreateVolumeRequest_VolumeEncryptionProfile(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "CreateVolumeRequest_VolumeEncryptionProfile", Value: value}
+}
+
+// Values returns all values of Device_FileSystemCheckBeforeMount.
+func (Device_FileSystemCheckBeforeMount) Values() []Device_FileSystemCheckBeforeMount {
+	return []Device_FileSystemCheckBeforeMount{
+		Device_FileSystemCheckBeforeMount_DISABLED,
+		Device_FileSystemCheckBeforeMount_ENABLED,
+	}
+}
+
+// IsValid returns true if value is a value of Device_FileSystemCheckBeforeMount.
+func (value Device_FileSystemCheckBeforeMount) IsValid() bool {
+	switch value {
+	case Device_FileSystemCheckBeforeMount_DISABLED,
+		Device_FileSystemCheckBeforeMount_ENABLED:
+		return true
+	}
+	return false
+}
+
+// ParseDevice_FileSystemCheckBeforeMount returns the Device_FileSystemCheckBeforeMount with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDevice_FileSystemCheckBeforeMount(value string) (Device_FileSystemCheckBeforeMount, error) {
+	if parsed := Device_FileSystemCheckBeforeMount(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Device_FileSystemCheckBeforeMount", Value: value}
+}
+
+// Values returns all values of Device_LEDStatus.
+func (Device_LEDStatus) Values() []Device_LEDStatus {
+	return []Device_LEDStatus{
+		Device_LEDStatus_FAIL,
+		Device_LEDStatus_LOCATE,
+		Device_LEDStatus_OFF,
+	}
+}
+
+// IsValid returns true if value is a value of Device_LEDStatus.
+func (value Device_LEDStatus) IsValid() bool {
+	switch value {
+	case Device_LEDStatus_FAIL,
+		Device_LEDStatus_LOCATE,
+		Device_LEDStatus_OFF:
+		return true
+	}
+	return false
+}
+
+// ParseDevice_LEDStatus returns the Device_LEDStatus with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDevice_LEDStatus(value string) (Device_LEDStatus, error) {
+	if parsed := Device_LEDStatus(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Device_LEDStatus", Value: value}
+}
+
+// Values returns all values of Device_MountState.
+func (Device_MountState) Values() []Device_MountState {
+	return []Device_MountState{
+		Device_MountState_MOUNTED,
+		Device_MountState_UNMOUNTED,
+	}
+}
+
+// IsValid returns true if value is a value of Device_MountState.
+func (value Device_MountState) IsValid() bool {
+	switch value {
+	case Device_MountState_MOUNTED,
+		Device_MountState_UNMOUNTED:
+		return true
+	}
+	return false
+}
+
+// ParseDevice_MountState returns the Device_MountState with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDevice_MountState(value string) (Device_MountState, error) {
+	if parsed := Device_MountState(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Device_MountState", Value: value}
+}
+
+// Values returns all values of Device_Status.
+func (Device_Status) Values() []Device_Status {
+	return []Device_Status{
+		Device_Status_DECOMMISSIONED,
+		Device_Status_DRAIN,
+		Device_Status_OFFLINE,
+		Device_Status_ONLINE,
+		Device_Status_REGENERATE,
+	}
+}
+
+// IsValid returns true if value is a value of Device_Status.
+func (value Device_Status) IsValid() bool {
+	switch value {
+	case Device_Status_DECOMMISSIONED,
+		Device_Status_DRAIN,
+		Device_Status_OFFLINE,
+		Device_Status_ONLINE,
+		Device_Status_REGENERATE:
+		return true
+	}
+	return false
+}
+
+// ParseDevice_Status returns the Device_Status with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDevice_Status(value string) (Device_Status, error) {
+	if parsed := Device_Status(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Device_Status", Value: value}
+}
+
+// Values returns all values of Device_TrimDeviceMethod.
+func (Device_TrimDeviceMethod) Values() []Device_TrimDeviceMethod {
+	return []Device_TrimDeviceMethod{
+		Device_TrimDeviceMethod_DISCARD_MOUNT_OPTION,
+		Device_TrimDeviceMethod_FSTRIM_TASK,
+		Device_TrimDeviceMethod_NONE,
+	}
+}
+
+// IsValid returns true if value is a value of Device_TrimDeviceMethod.
+func (value Device_TrimDeviceMethod) IsValid() bool {
+	switch value {
+	case Device_TrimDeviceMethod_DISCARD_MOUNT_OPTION,
+		Device_TrimDeviceMethod_FSTRIM_TASK,
+		Device_TrimDeviceMethod_NONE:
+		return true
+	}
+	return false
+}
+
+// ParseDevice_TrimDeviceMethod returns the Device_TrimDeviceMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDevice_TrimDeviceMethod(value string) (Device_TrimDeviceMethod, error) {
+	if parsed := Device_TrimDeviceMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Device_TrimDeviceMethod", Value: value}
+}
+
+// Values returns all values of Device_DeviceHealth_DeviceHealthStatus.
+func (Device_DeviceHealth_DeviceHealthStatus) Values() []Device_DeviceHealth_DeviceHealthStatus {
+	return []Device_DeviceHealth_DeviceHealthStatus{
+		Device_DeviceHealth_DeviceHealthStatus_DEFECTIVE,
+		Device_DeviceHealth_DeviceHealthStatus_HEALTHY,
+	}
+}
+
+// IsValid returns true if value is a value of Device_DeviceHealth_DeviceHealthStatus.
+func (value Device_DeviceHealth_DeviceHealthStatus) IsValid() bool {
+	switch value {
+	case Device_DeviceHealth_DeviceHealthStatus_DEFECTIVE,
+		Device_DeviceHealth_DeviceHealthStatus_HEALTHY:
+		return true
+	}
+	return false
+}
+
+// ParseDevice_DeviceHealth_DeviceHealthStatus returns the Device_DeviceHealth_DeviceHealthStatus with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDevice_DeviceHealth_DeviceHealthStatus(value string) (Device_DeviceHealth_DeviceHealthStatus, error) {
+	if parsed := Device_DeviceHealth_DeviceHealthStatus(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Device_DeviceHealth_DeviceHealthStatus", Value: value}
+}
+
+// Values returns all values of DeviceContent_ContentType.
+func (DeviceContent_ContentType) Values() []DeviceContent_ContentType {
+	return []DeviceContent_ContentType{
+		DeviceContent_ContentType_DATA,
+		DeviceContent_ContentType_METADATA,
+		DeviceContent_ContentType_REGISTRY,
+	}
+}
+
+// IsValid returns true if value is a value of DeviceContent_ContentType.
+func (value DeviceContent_ContentType) IsValid() bool {
+	switch value {
+	case DeviceContent_ContentType_DATA,
+		DeviceContent_ContentType_METADATA,
+		DeviceContent_ContentType_REGISTRY:
+		return true
+	}
+	return false
+}
+
+// ParseDeviceContent_ContentType returns the DeviceContent_ContentType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDeviceContent_ContentType(value string) (DeviceContent_ContentType, error) {
+	if parsed := DeviceContent_ContentType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "DeviceContent_ContentType", Value: value}
+}
+
+// Values returns all values of DiskIoPolicy_Priority.
+func (DiskIoPolicy_Priority) Values() []DiskIoPolicy_Priority {
+	return []DiskIoPolicy_Priority{
+		DiskIoPolicy_Priority_HIGH,
+		DiskIoPolicy_Priority_LOW,
+		DiskIoPolicy_Priority_NORMAL,
+	}
+}
+
+// IsValid returns true if value is a value of DiskIoPolicy_Priority.
+func (value DiskIoPolicy_Priority) IsValid() bool {
+	switch value {
+	case DiskIoPolicy_Priority_HIGH,
+		DiskIoPolicy_Priority_LOW,
+		DiskIoPolicy_Priority_NORMAL:
+		return true
+	}
+	return false
+}
+
+// ParseDiskIoPolicy_Priority returns the DiskIoPolicy_Priority with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseDiskIoPolicy_Priority(value string) (DiskIoPolicy_Priority, error) {
+	if parsed := DiskIoPolicy_Priority(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "DiskIoPolicy_Priority", Value: value}
+}
+
+// Values returns all values of EcParityPolicy_Mode.
+func (EcParityPolicy_Mode) Values() []EcParityPolicy_Mode {
+	return []EcParityPolicy_Mode{
+		EcParityPolicy_Mode_RELAXED,
+		EcParityPolicy_Mode_STRONG,
+	}
+}
+
+// IsValid returns true if value is a value of EcParityPolicy_Mode.
+func (value EcParityPolicy_Mode) IsValid() bool {
+	switch value {
+	case EcParityPolicy_Mode_RELAXED,
+		EcParityPolicy_Mode_STRONG:
+		return true
+	}
+	return false
+}
+
+// ParseEcParityPolicy_Mode returns the EcParityPolicy_Mode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseEcParityPolicy_Mode(value string) (EcParityPolicy_Mode, error) {
+	if parsed := EcParityPolicy_Mode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "EcParityPolicy_Mode", Value: value}
+}
+
+// Values returns all values of EcRedundancyDetailsPolicy_EcMetadataFormat.
+func (EcRedundancyDetailsPolicy_EcMetadataFormat) Values() []EcRedundancyDetailsPolicy_EcMetadataFormat {
+	return []EcRedundancyDetailsPolicy_EcMetadataFormat{
+		EcRedundancyDetailsPolicy_EcMetadataFormat_EC_COMMIT_IDS,
+	}
+}
+
+// IsValid returns true if value is a value of EcRedundancyDetailsPolicy_EcMetadataFormat.
+func (value EcRedundancyDetailsPolicy_EcMetadataFormat) IsValid() bool {
+	switch value {
+	case EcRedundancyDetailsPolicy_EcMetadataFormat_EC_COMMIT_IDS:
+		return true
+	}
+	return false
+}
+
+// ParseEcRedundancyDetailsPolicy_EcMetadataFormat returns the EcRedundancyDetailsPolicy_EcMetadataFormat with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseEcRedundancyDetailsPolicy_EcMetadataFormat(value string) (EcRedundancyDetailsPolicy_EcMetadataFormat, error) {
+	if parsed := EcRedundancyDetailsPolicy_EcMetadataFormat(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "EcRedundancyDetailsPolicy_EcMetadataFormat", Value: value}
+}
+
+// Values returns all values of EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod.
+func (EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod) Values() []EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod {
+	return []EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod{
+		EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod_BLOCK_LEVEL,
+		EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod_OBJECT_LEVEL,
+	}
+}
+
+// IsValid returns true if value is a value of EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod.
+func (value EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod) IsValid() bool {
+	switch value {
+	case EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod_BLOCK_LEVEL,
+		EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod_OBJECT_LEVEL:
+		return true
+	}
+	return false
+}
+
+// ParseEcRedundancyDetailsPolicy_DistributionSchema_StripingMethod returns the EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseEcRedundancyDetailsPolicy_DistributionSchema_StripingMethod(value string) (EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod, error) {
+	if parsed := EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "EcRedundancyDetailsPolicy_DistributionSchema_StripingMethod", Value: value}
+}
+
+// Values returns all values of FailureDomainPlacementPolicy_Type.
+func (FailureDomainPlacementPolicy_Type) Values() []FailureDomainPlacementPolicy_Type {
+	return []FailureDomainPlacementPolicy_Type{
+		FailureDomainPlacementPolicy_Type_DISK,
+		FailureDomainPlacementPolicy_Type_MACHINE,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_CLUSTER,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_METRO,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_POWER_1,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_POWER_2,
+		FailureDomainPlacementPolicy_Type_RACK,
+		FailureDomainPlacementPolicy_Type_ROOM,
+	}
+}
+
+// IsValid returns true if value is a value of FailureDomainPlacementPolicy_Type.
+func (value FailureDomainPlacementPolicy_Type) IsValid() bool {
+	switch value {
+	case FailureDomainPlacementPolicy_Type_DISK,
+		FailureDomainPlacementPolicy_Type_MACHINE,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_CLUSTER,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_METRO,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_POWER_1,
+		FailureDomainPlacementPolicy_Type_OBSOLETE_POWER_2,
+		FailureDomainPlacementPolicy_Type_RACK,
+		FailureDomainPlacementPolicy_Type_ROOM:
+		return true
+	}
+	return false
+}
+
+// ParseFailureDomainPlacementPolicy_Type returns the FailureDomainPlacementPolicy_Type with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFailureDomainPlacementPolicy_Type(value string) (FailureDomainPlacementPolicy_Type, error) {
+	if parsed := FailureDomainPlacementPolicy_Type(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FailureDomainPlacementPolicy_Type", Value: value}
+}
+
+// Values returns all values of FileIoSettings_EcParityMode.
+func (FileIoSettings_EcParityMode) Values() []FileIoSettings_EcParityMode {
+	return []FileIoSettings_EcParityMode{
+		FileIoSettings_EcParityMode_RELAXED,
+		FileIoSettings_EcParityMode_STRONG,
+	}
+}
+
+// IsValid returns true if value is a value of FileIoSettings_EcParityMode.
+func (value FileIoSettings_EcParityMode) IsValid() bool {
+	switch value {
+	case FileIoSettings_EcParityMode_RELAXED,
+		FileIoSettings_EcParityMode_STRONG:
+		return true
+	}
+	return false
+}
+
+// ParseFileIoSettings_EcParityMode returns the FileIoSettings_EcParityMode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileIoSettings_EcParityMode(value string) (FileIoSettings_EcParityMode, error) {
+	if parsed := FileIoSettings_EcParityMode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileIoSettings_EcParityMode", Value: value}
+}
+
+// Values returns all values of FileIoSettings_IoPriority.
+func (FileIoSettings_IoPriority) Values() []FileIoSettings_IoPriority {
+	return []FileIoSettings_IoPriority{
+		FileIoSettings_IoPriority_HIGH_PRIORITY,
+		FileIoSettings_IoPriority_LOW_PRIORITY,
+		FileIoSettings_IoPriority_NORMAL_PRIORITY,
+	}
+}
+
+// IsValid returns true if value is a value of FileIoSettings_IoPriority.
+func (value FileIoSettings_IoPriority) IsValid() bool {
+	switch value {
+	case FileIoSettings_IoPriority_HIGH_PRIORITY,
+		FileIoSettings_IoPriority_LOW_PRIORITY,
+		FileIoSettings_IoPriority_NORMAL_PRIORITY:
+		return true
+	}
+	return false
+}
+
+// ParseFileIoSettings_IoPriority returns the FileIoSettings_IoPriority with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileIoSettings_IoPriority(value string) (FileIoSettings_IoPriority, error) {
+	if parsed := FileIoSettings_IoPriority(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileIoSettings_IoPriority", Value: value}
+}
+
+// Values returns all values of FileIoSettings_LockScope.
+func (FileIoSettings_LockScope) Values() []FileIoSettings_LockScope {
+	return []FileIoSettings_LockScope{
+		FileIoSettings_LockScope_CLIENT,
+		FileIoSettings_LockScope_GLOBAL,
+	}
+}
+
+// IsValid returns true if value is a value of FileIoSettings_LockScope.
+func (value FileIoSettings_LockScope) IsValid() bool {
+	switch value {
+	case FileIoSettings_LockScope_CLIENT,
+		FileIoSettings_LockScope_GLOBAL:
+		return true
+	}
+	return false
+}
+
+// ParseFileIoSettings_LockScope returns the FileIoSettings_LockScope with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileIoSettings_LockScope(value string) (FileIoSettings_LockScope, error) {
+	if parsed := FileIoSettings_LockScope(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileIoSettings_LockScope", Value: value}
+}
+
+// Values returns all values of FileLayoutSettings_CodingMethod.
+func (FileLayoutSettings_CodingMethod) Values() []FileLayoutSettings_CodingMethod {
+	return []FileLayoutSettings_CodingMethod{
+		FileLayoutSettings_CodingMethod_NONE,
+		FileLayoutSettings_CodingMethod_REED_SOLOMON,
+	}
+}
+
+// IsValid returns true if value is a value of FileLayoutSettings_CodingMethod.
+func (value FileLayoutSettings_CodingMethod) IsValid() bool {
+	switch value {
+	case FileLayoutSettings_CodingMethod_NONE,
+		FileLayoutSettings_CodingMethod_REED_SOLOMON:
+		return true
+	}
+	return false
+}
+
+// ParseFileLayoutSettings_CodingMethod returns the FileLayoutSettings_CodingMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLayoutSettings_CodingMethod(value string) (FileLayoutSettings_CodingMethod, error) {
+	if parsed := FileLayoutSettings_CodingMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLayoutSettings_CodingMethod", Value: value}
+}
+
+// Values returns all values of FileLayoutSettings_CrcMethod.
+func (FileLayoutSettings_CrcMethod) Values() []FileLayoutSettings_CrcMethod {
+	return []FileLayoutSettings_CrcMethod{
+		FileLayoutSettings_CrcMethod_CRC32C,
+		FileLayoutSettings_CrcMethod_CRC_32_ISCSI,
+		FileLayoutSettings_CrcMethod_NO_CRC,
+	}
+}
+
+// IsValid returns true if value is a value of FileLayoutSettings_CrcMethod.
+func (value FileLayoutSettings_CrcMethod) IsValid() bool {
+	switch value {
+	case FileLayoutSettings_CrcMethod_CRC32C,
+		FileLayoutSettings_CrcMethod_CRC_32_ISCSI,
+		FileLayoutSettings_CrcMethod_NO_CRC:
+		return true
+	}
+	return false
+}
+
+// ParseFileLayoutSettings_CrcMethod returns the FileLayoutSettings_CrcMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLayoutSettings_CrcMethod(value string) (FileLayoutSettings_CrcMethod, error) {
+	if parsed := FileLayoutSettings_CrcMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLayoutSettings_CrcMethod", Value: value}
+}
+
+// Values returns all values of FileLayoutSettings_PersistentFormat.
+func (FileLayoutSettings_PersistentFormat) Values() []FileLayoutSettings_PersistentFormat {
+	return []FileLayoutSettings_PersistentFormat{
+		FileLayoutSettings_PersistentFormat_V1,
+		FileLayoutSettings_PersistentFormat_V2,
+		FileLayoutSettings_PersistentFormat_V3,
+	}
+}
+
+// IsValid returns true if value is a value of FileLayoutSettings_PersistentFormat.
+func (value FileLayoutSettings_PersistentFormat) IsValid() bool {
+	switch value {
+	case FileLayoutSettings_PersistentFormat_V1,
+		FileLayoutSettings_PersistentFormat_V2,
+		FileLayoutSettings_PersistentFormat_V3:
+		return true
+	}
+	return false
+}
+
+// ParseFileLayoutSettings_PersistentFormat returns the FileLayoutSettings_PersistentFormat with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLayoutSettings_PersistentFormat(value string) (FileLayoutSettings_PersistentFormat, error) {
+	if parsed := FileLayoutSettings_PersistentFormat(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLayoutSettings_PersistentFormat", Value: value}
+}
+
+// Values returns all values of FileLayoutSettings_RedundancyMethod.
+func (FileLayoutSettings_RedundancyMethod) Values() []FileLayoutSettings_RedundancyMethod {
+	return []FileLayoutSettings_RedundancyMethod{
+		FileLayoutSettings_RedundancyMethod_AUTO_WRITE_SEQUENTIAL,
+		FileLayoutSettings_RedundancyMethod_CUSTOM,
+	}
+}
+
+// IsValid returns true if value is a value of FileLayoutSettings_RedundancyMethod.
+func (value FileLayoutSettings_RedundancyMethod) IsValid() bool {
+	switch value {
+	case FileLayoutSettings_RedundancyMethod_AUTO_WRITE_SEQUENTIAL,
+		FileLayoutSettings_RedundancyMethod_CUSTOM:
+		return true
+	}
+	return false
+}
+
+// ParseFileLayoutSettings_RedundancyMethod returns the FileLayoutSettings_RedundancyMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLayoutSettings_RedundancyMethod(value string) (FileLayoutSettings_RedundancyMethod, error) {
+	if parsed := FileLayoutSettings_RedundancyMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLayoutSettings_RedundancyMethod", Value: value}
+}
+
+// Values returns all values of FileLayoutSettings_StripingMethod.
+func (FileLayoutSettings_StripingMethod) Values() []FileLayoutSettings_StripingMethod {
+	return []FileLayoutSettings_StripingMethod{
+		FileLayoutSettings_StripingMethod_BLOCK_LEVEL,
+		FileLayoutSettings_StripingMethod_OBJECT_LEVEL,
+	}
+}
+
+// IsValid returns true if value is a value of FileLayoutSettings_StripingMethod.
+func (value FileLayoutSettings_StripingMethod) IsValid() bool {
+	switch value {
+	case FileLayoutSettings_StripingMethod_BLOCK_LEVEL,
+		FileLayoutSettings_StripingMethod_OBJECT_LEVEL:
+		return true
+	}
+	return false
+}
+
+// ParseFileLayoutSettings_StripingMethod returns the FileLayoutSettings_StripingMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLayoutSettings_StripingMethod(value string) (FileLayoutSettings_StripingMethod, error) {
+	if parsed := FileLayoutSettings_StripingMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLayoutSettings_StripingMethod", Value: value}
+}
+
+// Values returns all values of FileLockPolicy_ImplicitLockingMode.
+func (FileLockPolicy_ImplicitLockingMode) Values() []FileLockPolicy_ImplicitLockingMode {
+	return []FileLockPolicy_ImplicitLockingMode{
+		FileLockPolicy_ImplicitLockingMode_FILE_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_FILE_NON_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_IO_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_IO_NON_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_NO_LOCKING,
+	}
+}
+
+// IsValid returns true if value is a value of FileLockPolicy_ImplicitLockingMode.
+func (value FileLockPolicy_ImplicitLockingMode) IsValid() bool {
+	switch value {
+	case FileLockPolicy_ImplicitLockingMode_FILE_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_FILE_NON_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_IO_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_IO_NON_BLOCKING,
+		FileLockPolicy_ImplicitLockingMode_NO_LOCKING:
+		return true
+	}
+	return false
+}
+
+// ParseFileLockPolicy_ImplicitLockingMode returns the FileLockPolicy_ImplicitLockingMode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLockPolicy_ImplicitLockingMode(value string) (FileLockPolicy_ImplicitLockingMode, error) {
+	if parsed := FileLockPolicy_ImplicitLockingMode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLockPolicy_ImplicitLockingMode", Value: value}
+}
+
+// Values returns all values of FileLockPolicy_LockScope.
+func (FileLockPolicy_LockScope) Values() []FileLockPolicy_LockScope {
+	return []FileLockPolicy_LockScope{
+		FileLockPolicy_LockScope_CLIENT,
+		FileLockPolicy_LockScope_GLOBAL,
+	}
+}
+
+// IsValid returns true if value is a value of FileLockPolicy_LockScope.
+func (value FileLockPolicy_LockScope) IsValid() bool {
+	switch value {
+	case FileLockPolicy_LockScope_CLIENT,
+		FileLockPolicy_LockScope_GLOBAL:
+		return true
+	}
+	return false
+}
+
+// ParseFileLockPolicy_LockScope returns the FileLockPolicy_LockScope with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLockPolicy_LockScope(value string) (FileLockPolicy_LockScope, error) {
+	if parsed := FileLockPolicy_LockScope(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLockPolicy_LockScope", Value: value}
+}
+
+// Values returns all values of FileLockPolicy_LostLockBehavior.
+func (FileLockPolicy_LostLockBehavior) Values() []FileLockPolicy_LostLockBehavior {
+	return []FileLockPolicy_LostLockBehavior{
+		FileLockPolicy_LostLockBehavior_LOST_LOCK_BLOCK_IO,
+		FileLockPolicy_LostLockBehavior_LOST_LOCK_IO_ERROR,
+		FileLockPolicy_LostLockBehavior_LOST_LOCK_KILL_APPLICATION,
+	}
+}
+
+// IsValid returns true if value is a value of FileLockPolicy_LostLockBehavior.
+func (value FileLockPolicy_LostLockBehavior) IsValid() bool {
+	switch value {
+	case FileLockPolicy_LostLockBehavior_LOST_LOCK_BLOCK_IO,
+		FileLockPolicy_LostLockBehavior_LOST_LOCK_IO_ERROR,
+		FileLockPolicy_LostLockBehavior_LOST_LOCK_KILL_APPLICATION:
+		return true
+	}
+	return false
+}
+
+// ParseFileLockPolicy_LostLockBehavior returns the FileLockPolicy_LostLockBehavior with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileLockPolicy_LostLockBehavior(value string) (FileLockPolicy_LostLockBehavior, error) {
+	if parsed := FileLockPolicy_LostLockBehavior(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileLockPolicy_LostLockBehavior", Value: value}
+}
+
+// Values returns all values of FileRecodePolicy_TargetRedundancy.
+func (FileRecodePolicy_TargetRedundancy) Values() []FileRecodePolicy_TargetRedundancy {
+	return []FileRecodePolicy_TargetRedundancy{
+		FileRecodePolicy_TargetRedundancy_DO_NOT_RECODE,
+		FileRecodePolicy_TargetRedundancy_EC,
+		FileRecodePolicy_TargetRedundancy_REPLICATION,
+	}
+}
+
+// IsValid returns true if value is a value of FileRecodePolicy_TargetRedundancy.
+func (value FileRecodePolicy_TargetRedundancy) IsValid() bool {
+	switch value {
+	case FileRecodePolicy_TargetRedundancy_DO_NOT_RECODE,
+		FileRecodePolicy_TargetRedundancy_EC,
+		FileRecodePolicy_TargetRedundancy_REPLICATION:
+		return true
+	}
+	return false
+}
+
+// ParseFileRecodePolicy_TargetRedundancy returns the FileRecodePolicy_TargetRedundancy with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileRecodePolicy_TargetRedundancy(value string) (FileRecodePolicy_TargetRedundancy, error) {
+	if parsed := FileRecodePolicy_TargetRedundancy(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileRecodePolicy_TargetRedundancy", Value: value}
+}
+
+// Values returns all values of FileRedundancyPolicy_Redundancy.
+func (FileRedundancyPolicy_Redundancy) Values() []FileRedundancyPolicy_Redundancy {
+	return []FileRedundancyPolicy_Redundancy{
+		FileRedundancyPolicy_Redundancy_AUTO_WRITE_SEQUENTIAL,
+		FileRedundancyPolicy_Redundancy_EC,
+		FileRedundancyPolicy_Redundancy_REPLICATION,
+	}
+}
+
+// IsValid returns true if value is a value of FileRedundancyPolicy_Redundancy.
+func (value FileRedundancyPolicy_Redundancy) IsValid() bool {
+	switch value {
+	case FileRedundancyPolicy_Redundancy_AUTO_WRITE_SEQUENTIAL,
+		FileRedundancyPolicy_Redundancy_EC,
+		FileRedundancyPolicy_Redundancy_REPLICATION:
+		return true
+	}
+	return false
+}
+
+// ParseFileRedundancyPolicy_Redundancy returns the FileRedundancyPolicy_Redundancy with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileRedundancyPolicy_Redundancy(value string) (FileRedundancyPolicy_Redundancy, error) {
+	if parsed := FileRedundancyPolicy_Redundancy(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileRedundancyPolicy_Redundancy", Value: value}
+}
+
+// Values returns all values of FileRetentionPolicy_RetentionProperty.
+func (FileRetentionPolicy_RetentionProperty) Values() []FileRetentionPolicy_RetentionProperty {
+	return []FileRetentionPolicy_RetentionProperty{
+		FileRetentionPolicy_RetentionProperty_DELETE_AFTER,
+		FileRetentionPolicy_RetentionProperty_IMMUTABLE,
+		FileRetentionPolicy_RetentionProperty_MAY_EXTEND_RETENTION,
+		FileRetentionPolicy_RetentionProperty_MAY_SHORTEN_RETENTION,
+		FileRetentionPolicy_RetentionProperty_RETAIN_UNTIL,
+	}
+}
+
+// IsValid returns true if value is a value of FileRetentionPolicy_RetentionProperty.
+func (value FileRetentionPolicy_RetentionProperty) IsValid() bool {
+	switch value {
+	case FileRetentionPolicy_RetentionProperty_DELETE_AFTER,
+		FileRetentionPolicy_RetentionProperty_IMMUTABLE,
+		FileRetentionPolicy_RetentionProperty_MAY_EXTEND_RETENTION,
+		FileRetentionPolicy_RetentionProperty_MAY_SHORTEN_RETENTION,
+		FileRetentionPolicy_RetentionProperty_RETAIN_UNTIL:
+		return true
+	}
+	return false
+}
+
+// ParseFileRetentionPolicy_RetentionProperty returns the FileRetentionPolicy_RetentionProperty with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileRetentionPolicy_RetentionProperty(value string) (FileRetentionPolicy_RetentionProperty, error) {
+	if parsed := FileRetentionPolicy_RetentionProperty(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileRetentionPolicy_RetentionProperty", Value: value}
+}
+
+// Values returns all values of FileScope_FilterType.
+func (FileScope_FilterType) Values() []FileScope_FilterType {
+	return []FileScope_FilterType{
+		FileScope_FilterType_CURRENT_FILE_SIZE,
+		FileScope_FilterType_DIRECTORY_XATTR,
+		FileScope_FilterType_EXPECTED_SIZE_OF_NEW_FILE,
+		FileScope_FilterType_FILE_XATTR,
+		FileScope_FilterType_LAST_ACCESS_AGE_S,
+		FileScope_FilterType_LAST_MODIFICATION_AGE_S,
+		FileScope_FilterType_NAME,
+		FileScope_FilterType_OWNER_USER_NAME,
+		FileScope_FilterType_RECURSIVE_DIRECTORY_XATTR,
+	}
+}
+
+// IsValid returns true if value is a value of FileScope_FilterType.
+func (value FileScope_FilterType) IsValid() bool {
+	switch value {
+	case FileScope_FilterType_CURRENT_FILE_SIZE,
+		FileScope_FilterType_DIRECTORY_XATTR,
+		FileScope_FilterType_EXPECTED_SIZE_OF_NEW_FILE,
+		FileScope_FilterType_FILE_XATTR,
+		FileScope_FilterType_LAST_ACCESS_AGE_S,
+		FileScope_FilterType_LAST_MODIFICATION_AGE_S,
+		FileScope_FilterType_NAME,
+		FileScope_FilterType_OWNER_USER_NAME,
+		FileScope_FilterType_RECURSIVE_DIRECTORY_XATTR:
+		return true
+	}
+	return false
+}
+
+// ParseFileScope_FilterType returns the FileScope_FilterType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileScope_FilterType(value string) (FileScope_FilterType, error) {
+	if parsed := FileScope_FilterType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileScope_FilterType", Value: value}
+}
+
+// Values returns all values of FileScope_Operator.
+func (FileScope_Operator) Values() []FileScope_Operator {
+	return []FileScope_Operator{
+		FileScope_Operator_CONTAINS,
+		FileScope_Operator_ENDS_WITH,
+		FileScope_Operator_EQUALS,
+		FileScope_Operator_EXTENSION_MATCHES,
+		FileScope_Operator_LARGER_THAN,
+		FileScope_Operator_LARGER_THAN_OR_EQUAL_TO,
+		FileScope_Operator_REGEX_MATCHES,
+		FileScope_Operator_SMALLER_THAN,
+		FileScope_Operator_SMALLER_THAN_OR_EQUAL_TO,
+		FileScope_Operator_STARTS_WITH,
+	}
+}
+
+// IsValid returns true if value is a value of FileScope_Operator.
+func (value FileScope_Operator) IsValid() bool {
+	switch value {
+	case FileScope_Operator_CONTAINS,
+		FileScope_Operator_ENDS_WITH,
+		FileScope_Operator_EQUALS,
+		FileScope_Operator_EXTENSION_MATCHES,
+		FileScope_Operator_LARGER_THAN,
+		FileScope_Operator_LARGER_THAN_OR_EQUAL_TO,
+		FileScope_Operator_REGEX_MATCHES,
+		FileScope_Operator_SMALLER_THAN,
+		FileScope_Operator_SMALLER_THAN_OR_EQUAL_TO,
+		FileScope_Operator_STARTS_WITH:
+		return true
+	}
+	return false
+}
+
+// ParseFileScope_Operator returns the FileScope_Operator with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFileScope_Operator(value string) (FileScope_Operator, error) {
+	if parsed := FileScope_Operator(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FileScope_Operator", Value: value}
+}
+
+// Values returns all values of FiringRule_RuleSeverity.
+func (FiringRule_RuleSeverity) Values() []FiringRule_RuleSeverity {
+	return []FiringRule_RuleSeverity{
+		FiringRule_RuleSeverity_ERROR,
+		FiringRule_RuleSeverity_INFO,
+		FiringRule_RuleSeverity_WARNING,
+	}
+}
+
+// IsValid returns true if value is a value of FiringRule_RuleSeverity.
+func (value FiringRule_RuleSeverity) IsValid() bool {
+	switch value {
+	case FiringRule_RuleSeverity_ERROR,
+		FiringRule_RuleSeverity_INFO,
+		FiringRule_RuleSeverity_WARNING:
+		return true
+	}
+	return false
+}
+
+// ParseFiringRule_RuleSeverity returns the FiringRule_RuleSeverity with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFiringRule_RuleSeverity(value string) (FiringRule_RuleSeverity, error) {
+	if parsed := FiringRule_RuleSeverity(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FiringRule_RuleSeverity", Value: value}
+}
+
+// Values returns all values of FsyncBehaviorPolicy_Mode.
+func (FsyncBehaviorPolicy_Mode) Values() []FsyncBehaviorPolicy_Mode {
+	return []FsyncBehaviorPolicy_Mode{
+		FsyncBehaviorPolicy_Mode_ALWAYS_FLUSH_METADATA,
+		FsyncBehaviorPolicy_Mode_FLUSH_METADATA_AS_REQUESTED,
+		FsyncBehaviorPolicy_Mode_NEVER_FLUSH_METADATA,
+	}
+}
+
+// IsValid returns true if value is a value of FsyncBehaviorPolicy_Mode.
+func (value FsyncBehaviorPolicy_Mode) IsValid() bool {
+	switch value {
+	case FsyncBehaviorPolicy_Mode_ALWAYS_FLUSH_METADATA,
+		FsyncBehaviorPolicy_Mode_FLUSH_METADATA_AS_REQUESTED,
+		FsyncBehaviorPolicy_Mode_NEVER_FLUSH_METADATA:
+		return true
+	}
+	return false
+}
+
+// ParseFsyncBehaviorPolicy_Mode returns the FsyncBehaviorPolicy_Mode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseFsyncBehaviorPolicy_Mode(value string) (FsyncBehaviorPolicy_Mode, error) {
+	if parsed := FsyncBehaviorPolicy_Mode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "FsyncBehaviorPolicy_Mode", Value: value}
+}
+
+// Values returns all values of GetAnalyzeReportsRequest_Format.
+func (GetAnalyzeReportsRequest_Format) Values() []GetAnalyzeReportsRequest_Format {
+	return []GetAnalyzeReportsRequest_Format{
+		GetAnalyzeReportsRequest_Format_HTML,
+	}
+}
+
+// IsValid returns true if value is a value of GetAnalyzeReportsRequest_Format.
+func (value GetAnalyzeReportsRequest_Format) IsValid() bool {
+	switch value {
+	case GetAnalyzeReportsRequest_Format_HTML:
+		return true
+	}
+	return false
+}
+
+// ParseGetAnalyzeReportsRequest_Format returns the GetAnalyzeReportsRequest_Format with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseGetAnalyzeReportsRequest_Format(value string) (GetAnalyzeReportsRequest_Format, error) {
+	if parsed := GetAnalyzeReportsRequest_Format(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "GetAnalyzeReportsRequest_Format", Value: value}
+}
+
+// Values returns all values of GetNetworkTestResultResponse_Status.
+func (GetNetworkTestResultResponse_Status) Values() []GetNetworkTestResultResponse_Status {
+	return []GetNetworkTestResultResponse_Status{
+		GetNetworkTestResultResponse_Status_DONE,
+		GetNetworkTestResultResponse_Status_IN_PROGRESS,
+		GetNetworkTestResultResponse_Status_NOT_SCHEDULED,
+	}
+}
+
+// IsValid returns true if value is a value of GetNetworkTestResultResponse_Status.
+func (value GetNetworkTestResultResponse_Status) IsValid() bool {
+	switch value {
+	case GetNetworkTestResultResponse_Status_DONE,
+		GetNetworkTestResultResponse_Status_IN_PROGRESS,
+		GetNetworkTestResultResponse_Status_NOT_SCHEDULED:
+		return true
+	}
+	return false
+}
+
+// ParseGetNetworkTestResultResponse_Status returns the GetNetworkTestResultResponse_Status with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseGetNetworkTestResultResponse_Status(value string) (GetNetworkTestResultResponse_Status, error) {
+	if parsed := GetNetworkTestResultResponse_Status(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "GetNetworkTestResultResponse_Status", Value: value}
+}
+
+// Values returns all values of GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType.
+func (GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType) Values() []GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType {
+	return []GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType{
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_NOT_SCHEDULED,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_PING_FAILED,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_RESPONSE_ERROR,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_RESPONSE_SUCCESS,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_RESPONSE_TIME_OUT,
+	}
+}
+
+// IsValid returns true if value is a value of GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType.
+func (value GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType) IsValid() bool {
+	switch value {
+	case GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_NOT_SCHEDULED,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_PING_FAILED,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_RESPONSE_ERROR,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_RESPONSE_SUCCESS,
+		GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType_RESPONSE_TIME_OUT:
+		return true
+	}
+	return false
+}
+
+// ParseGetNetworkTestResultResponse_ConnectionTestJobResult_ResultType returns the GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseGetNetworkTestResultResponse_ConnectionTestJobResult_ResultType(value string) (GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType, error) {
+	if parsed := GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "GetNetworkTestResultResponse_ConnectionTestJobResult_ResultType", Value: value}
+}
+
+// Values returns all values of GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType.
+func (GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType) Values() []GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType {
+	return []GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType{
+		GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType_NATIVE,
+		GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType_NFS,
+		GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType_S3,
+	}
+}
+
+// IsValid returns true if value is a value of GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType.
+func (value GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType) IsValid() bool {
+	switch value {
+	case GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType_NATIVE,
+		GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType_NFS,
+		GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType_S3:
+		return true
+	}
+	return false
+}
+
+// ParseGetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType returns the GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseGetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType(value string) (GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType, error) {
+	if parsed := GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "GetPolicyRulesRequest_PolicySubject_ClientPolicySubject_ClientType", Value: value}
+}
+
+// Values returns all values of GetQueryProgressResponse_Status.
+func (GetQueryProgressResponse_Status) Values() []GetQueryProgressResponse_Status {
+	return []GetQueryProgressResponse_Status{
+		GetQueryProgressResponse_Status_CANCELLED,
+		GetQueryProgressResponse_Status_DONE,
+		GetQueryProgressResponse_Status_RUNNING,
+	}
+}
+
+// IsValid returns true if value is a value of GetQueryProgressResponse_Status.
+func (value GetQueryProgressResponse_Status) IsValid() bool {
+	switch value {
+	case GetQueryProgressResponse_Status_CANCELLED,
+		GetQueryProgressResponse_Status_DONE,
+		GetQueryProgressResponse_Status_RUNNING:
+		return true
+	}
+	return false
+}
+
+// ParseGetQueryProgressResponse_Status returns the GetQueryProgressResponse_Status with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseGetQueryProgressResponse_Status(value string) (GetQueryProgressResponse_Status, error) {
+	if parsed := GetQueryProgressResponse_Status(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "GetQueryProgressResponse_Status", Value: value}
+}
+
+// Values returns all values of GetSupportDumpStatusResponse_Status.
+func (GetSupportDumpStatusResponse_Status) Values() []GetSupportDumpStatusResponse_Status {
+	return []GetSupportDumpStatusResponse_Status{
+		GetSupportDumpStatusResponse_Status_DONE,
+		GetSupportDumpStatusResponse_Status_GENERATING,
+		GetSupportDumpStatusResponse_Status_NOT_FOUND,
+	}
+}
+
+// IsValid returns true if value is a value of GetSupportDumpStatusResponse_Status.
+func (value GetSupportDumpStatusResponse_Status) IsValid() bool {
+	switch value {
+	case GetSupportDumpStatusResponse_Status_DONE,
+		GetSupportDumpStatusResponse_Status_GENERATING,
+		GetSupportDumpStatusResponse_Status_NOT_FOUND:
+		return true
+	}
+	return false
+}
+
+// ParseGetSupportDumpStatusResponse_Status returns the GetSupportDumpStatusResponse_Status with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseGetSupportDumpStatusResponse_Status(value string) (GetSupportDumpStatusResponse_Status, error) {
+	if parsed := GetSupportDumpStatusResponse_Status(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "GetSupportDumpStatusResponse_Status", Value: value}
+}
+
+// Values returns all values of GetTopCapacityConsumerRequest_Scope.
+func (GetTopCapacityConsumerRequest_Scope) Values() []GetTopCapacityConsumerRequest_Scope {
+	return []GetTopCapacityConsumerRequest_Scope{
+		GetTopCapacityConsumerRequest_Scope_SYSTEM,
+		GetTopCapacityConsumerRequest_Scope_TENANT,
+		GetTopCapacityConsumerRequest_Scope_VOLUME,
+	}
+}
+
+// IsValid returns true if value is a value of GetTopCapacityConsumerRequest_Scope.
+func (value GetTopCapacityConsumerRequest_Scope) IsValid() bool {
+	switch value {
+	case GetTopCapacityConsumerRequest_Scope_SYSTEM,
+		GetTopCapacityConsumerRequest_Scope_TENANT,
+		GetTopCapacityConsumerRequest_Scope_VOLUME:
+		return true
+	}
+	return false
+}
+
+// ParseGetTopCapacityConsumerRequest_Scope returns the GetTopCapacityConsumerRequest_Scope with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseGetTopCapacityConsumerRequest_Scope(value string) (GetTopCapacityConsumerRequest_Scope, error) {
+	if parsed := GetTopCapacityConsumerRequest_Scope(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "GetTopCapacityConsumerRequest_Scope", Value: value}
+}
+
+// Values returns all values of HealthManagerStatus_MaintenanceWindowMode.
+func (HealthManagerStatus_MaintenanceWindowMode) Values() []HealthManagerStatus_MaintenanceWindowMode {
+	return []HealthManagerStatus_MaintenanceWindowMode{
+		HealthManagerStatus_MaintenanceWindowMode_ACTIVE,
+		HealthManagerStatus_MaintenanceWindowMode_ALWAYS,
+		HealthManagerStatus_MaintenanceWindowMode_HEALTH_MANAGER_DISABLED,
+		HealthManagerStatus_MaintenanceWindowMode_SCHEDULED,
+	}
+}
+
+// IsValid returns true if value is a value of HealthManagerStatus_MaintenanceWindowMode.
+func (value HealthManagerStatus_MaintenanceWindowMode) IsValid() bool {
+	switch value {
+	case HealthManagerStatus_MaintenanceWindowMode_ACTIVE,
+		HealthManagerStatus_MaintenanceWindowMode_ALWAYS,
+		HealthManagerStatus_MaintenanceWindowMode_HEALTH_MANAGER_DISABLED,
+		HealthManagerStatus_MaintenanceWindowMode_SCHEDULED:
+		return true
+	}
+	return false
+}
+
+// ParseHealthManagerStatus_MaintenanceWindowMode returns the HealthManagerStatus_MaintenanceWindowMode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseHealthManagerStatus_MaintenanceWindowMode(value string) (HealthManagerStatus_MaintenanceWindowMode, error) {
+	if parsed := HealthManagerStatus_MaintenanceWindowMode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "HealthManagerStatus_MaintenanceWindowMode", Value: value}
+}
+
+// Values returns all values of HealthManagerStatus_SystemHealth.
+func (HealthManagerStatus_SystemHealth) Values() []HealthManagerStatus_SystemHealth {
+	return []HealthManagerStatus_SystemHealth{
+		HealthManagerStatus_SystemHealth_DEGRADED,
+		HealthManagerStatus_SystemHealth_HEALTHY,
+	}
+}
+
+// IsValid returns true if value is a value of HealthManagerStatus_SystemHealth.
+func (value HealthManagerStatus_SystemHealth) IsValid() bool {
+	switch value {
+	case HealthManagerStatus_SystemHealth_DEGRADED,
+		HealthManagerStatus_SystemHealth_HEALTHY:
+		return true
+	}
+	return false
+}
+
+// ParseHealthManagerStatus_SystemHealth returns the HealthManagerStatus_SystemHealth with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseHealthManagerStatus_SystemHealth(value string) (HealthManagerStatus_SystemHealth, error) {
+	if parsed := HealthManagerStatus_SystemHealth(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "HealthManagerStatus_SystemHealth", Value: value}
+}
+
+// Values returns all values of InternalOnDiskFormatPolicy_CrcMethod.
+func (InternalOnDiskFormatPolicy_CrcMethod) Values() []InternalOnDiskFormatPolicy_CrcMethod {
+	return []InternalOnDiskFormatPolicy_CrcMethod{
+		InternalOnDiskFormatPolicy_CrcMethod_CRC_32C,
+		InternalOnDiskFormatPolicy_CrcMethod_CRC_32_ISCSI,
+		InternalOnDiskFormatPolicy_CrcMethod_NO_CRC,
+	}
+}
+
+// IsValid returns true if value is a value of InternalOnDiskFormatPolicy_CrcMethod.
+func (value InternalOnDiskFormatPolicy_CrcMethod) IsValid() bool {
+	switch value {
+	case InternalOnDiskFormatPolicy_CrcMethod_CRC_32C,
+		InternalOnDiskFormatPolicy_CrcMethod_CRC_32_ISCSI,
+		InternalOnDiskFormatPolicy_CrcMethod_NO_CRC:
+		return true
+	}
+	return false
+}
+
+// ParseInternalOnDiskFormatPolicy_CrcMethod returns the InternalOnDiskFormatPolicy_CrcMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseInternalOnDiskFormatPolicy_CrcMethod(value string) (InternalOnDiskFormatPolicy_CrcMethod, error) {
+	if parsed := InternalOnDiskFormatPolicy_CrcMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "InternalOnDiskFormatPolicy_CrcMethod", Value: value}
+}
+
+// Values returns all values of InternalOnDiskFormatPolicy_PersistentFormat.
+func (InternalOnDiskFormatPolicy_PersistentFormat) Values() []InternalOnDiskFormatPolicy_PersistentFormat {
+	return []InternalOnDiskFormatPolicy_PersistentFormat{
+		InternalOnDiskFormatPolicy_PersistentFormat_V1_METADATA_FILE,
+		InternalOnDiskFormatPolicy_PersistentFormat_V2_METADATA_HEADER_4K,
+		InternalOnDiskFormatPolicy_PersistentFormat_V3_METADATA_HEADER_4K_BLOCK_LENGTH,
+	}
+}
+
+// IsValid returns true if value is a value of InternalOnDiskFormatPolicy_PersistentFormat.
+func (value InternalOnDiskFormatPolicy_PersistentFormat) IsValid() bool {
+	switch value {
+	case InternalOnDiskFormatPolicy_PersistentFormat_V1_METADATA_FILE,
+		InternalOnDiskFormatPolicy_PersistentFormat_V2_METADATA_HEADER_4K,
+		InternalOnDiskFormatPolicy_PersistentFormat_V3_METADATA_HEADER_4K_BLOCK_LENGTH:
+		return true
+	}
+	return false
+}
+
+// ParseInternalOnDiskFormatPolicy_PersistentFormat returns the InternalOnDiskFormatPolicy_PersistentFormat with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseInternalOnDiskFormatPolicy_PersistentFormat(value string) (InternalOnDiskFormatPolicy_PersistentFormat, error) {
+	if parsed := InternalOnDiskFormatPolicy_PersistentFormat(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "InternalOnDiskFormatPolicy_PersistentFormat", Value: value}
+}
+
+// Values returns all values of KeyStoreSlotParams_HashMethod.
+func (KeyStoreSlotParams_HashMethod) Values() []KeyStoreSlotParams_HashMethod {
+	return []KeyStoreSlotParams_HashMethod{
+		KeyStoreSlotParams_HashMethod_PBKDF2WithHmacSHA512,
+	}
+}
+
+// IsValid returns true if value is a value of KeyStoreSlotParams_HashMethod.
+func (value KeyStoreSlotParams_HashMethod) IsValid() bool {
+	switch value {
+	case KeyStoreSlotParams_HashMethod_PBKDF2WithHmacSHA512:
+		return true
+	}
+	return false
+}
+
+// ParseKeyStoreSlotParams_HashMethod returns the KeyStoreSlotParams_HashMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseKeyStoreSlotParams_HashMethod(value string) (KeyStoreSlotParams_HashMethod, error) {
+	if parsed := KeyStoreSlotParams_HashMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "KeyStoreSlotParams_HashMethod", Value: value}
+}
+
+// Values returns all values of KeyStoreSlotParams_KeyEncryptionMethod.
+func (KeyStoreSlotParams_KeyEncryptionMethod) Values() []KeyStoreSlotParams_KeyEncryptionMethod {
+	return []KeyStoreSlotParams_KeyEncryptionMethod{
+		KeyStoreSlotParams_KeyEncryptionMethod_AES_CBC_256_PKCS5PADDING,
+	}
+}
+
+// IsValid returns true if value is a value of KeyStoreSlotParams_KeyEncryptionMethod.
+func (value KeyStoreSlotParams_KeyEncryptionMethod) IsValid() bool {
+	switch value {
+	case KeyStoreSlotParams_KeyEncryptionMethod_AES_CBC_256_PKCS5PADDING:
+		return true
+	}
+	return false
+}
+
+// ParseKeyStoreSlotParams_KeyEncryptionMethod returns the KeyStoreSlotParams_KeyEncryptionMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseKeyStoreSlotParams_KeyEncryptionMethod(value string) (KeyStoreSlotParams_KeyEncryptionMethod, error) {
+	if parsed := KeyStoreSlotParams_KeyEncryptionMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "KeyStoreSlotParams_KeyEncryptionMethod", Value: value}
+}
+
+// Values returns all values of Label_EntityType.
+func (Label_EntityType) Values() []Label_EntityType {
+	return []Label_EntityType{
+		Label_EntityType_SERVICE,
+		Label_EntityType_TENANT,
+		Label_EntityType_VOLUME,
+	}
+}
+
+// IsValid returns true if value is a value of Label_EntityType.
+func (value Label_EntityType) IsValid() bool {
+	switch value {
+	case Label_EntityType_SERVICE,
+		Label_EntityType_TENANT,
+		Label_EntityType_VOLUME:
+		return true
+	}
+	return false
+}
+
+// ParseLabel_EntityType returns the Label_EntityType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseLabel_EntityType(value string) (Label_EntityType, error) {
+	if parsed := Label_EntityType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Label_EntityType", Value: value}
+}
+
+// Values returns all values of Label_Namespace.
+func (Label_Namespace) Values() []Label_Namespace {
+	return []Label_Namespace{
+		Label_Namespace_SYSTEM,
+	}
+}
+
+// IsValid returns true if value is a value of Label_Namespace.
+func (value Label_Namespace) IsValid() bool {
+	switch value {
+	case Label_Namespace_SYSTEM:
+		return true
+	}
+	return false
+}
+
+// ParseLabel_Namespace returns the Label_Namespace with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseLabel_Namespace(value string) (Label_Namespace, error) {
+	if parsed := Label_Namespace(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Label_Namespace", Value: value}
+}
+
+// Values returns all values of MakeDeviceSettings_DeviceType.
+func (MakeDeviceSettings_DeviceType) Values() []MakeDeviceSettings_DeviceType {
+	return []MakeDeviceSettings_DeviceType{
+		MakeDeviceSettings_DeviceType_DATA,
+		MakeDeviceSettings_DeviceType_METADATA,
+		MakeDeviceSettings_DeviceType_REGISTRY,
+	}
+}
+
+// IsValid returns true if value is a value of MakeDeviceSettings_DeviceType.
+func (value MakeDeviceSettings_DeviceType) IsValid() bool {
+	switch value {
+	case MakeDeviceSettings_DeviceType_DATA,
+		MakeDeviceSettings_DeviceType_METADATA,
+		MakeDeviceSettings_DeviceType_REGISTRY:
+		return true
+	}
+	return false
+}
+
+// ParseMakeDeviceSettings_DeviceType returns the MakeDeviceSettings_DeviceType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseMakeDeviceSettings_DeviceType(value string) (MakeDeviceSettings_DeviceType, error) {
+	if parsed := MakeDeviceSettings_DeviceType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "MakeDeviceSettings_DeviceType", Value: value}
+}
+
+// Values returns all values of MakeDeviceSettings_FsType.
+func (MakeDeviceSettings_FsType) Values() []MakeDeviceSettings_FsType {
+	return []MakeDeviceSettings_FsType{
+		MakeDeviceSettings_FsType_EXT4,
+		MakeDeviceSettings_FsType_XFS,
+	}
+}
+
+// IsValid returns true if value is a value of MakeDeviceSettings_FsType.
+func (value MakeDeviceSettings_FsType) IsValid() bool {
+	switch value {
+	case MakeDeviceSettings_FsType_EXT4,
+		MakeDeviceSettings_FsType_XFS:
+		return true
+	}
+	return false
+}
+
+// ParseMakeDeviceSettings_FsType returns the MakeDeviceSettings_FsType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseMakeDeviceSettings_FsType(value string) (MakeDeviceSettings_FsType, error) {
+	if parsed := MakeDeviceSettings_FsType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "MakeDeviceSettings_FsType", Value: value}
+}
+
+// Values returns all values of NotificationRule_NotificationSource.
+func (NotificationRule_NotificationSource) Values() []NotificationRule_NotificationSource {
+	return []NotificationRule_NotificationSource{
+		NotificationRule_NotificationSource_ALERT_MANAGER,
+		NotificationRule_NotificationSource_HEALTH_MANAGER,
+		NotificationRule_NotificationSource_QUOTA_MANAGER,
+	}
+}
+
+// IsValid returns true if value is a value of NotificationRule_NotificationSource.
+func (value NotificationRule_NotificationSource) IsValid() bool {
+	switch value {
+	case NotificationRule_NotificationSource_ALERT_MANAGER,
+		NotificationRule_NotificationSource_HEALTH_MANAGER,
+		NotificationRule_NotificationSource_QUOTA_MANAGER:
+		return true
+	}
+	return false
+}
+
+// ParseNotificationRule_NotificationSource returns the NotificationRule_NotificationSource with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseNotificationRule_NotificationSource(value string) (NotificationRule_NotificationSource, error) {
+	if parsed := NotificationRule_NotificationSource(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "NotificationRule_NotificationSource", Value: value}
+}
+
+// Values returns all values of NotificationRule_Severity.
+func (NotificationRule_Severity) Values() []NotificationRule_Severity {
+	return []NotificationRule_Severity{
+		NotificationRule_Severity_ERROR,
+		NotificationRule_Severity_INFO,
+		NotificationRule_Severity_WARN,
+	}
+}
+
+// IsValid returns true if value is a value of NotificationRule_Severity.
+func (value NotificationRule_Severity) IsValid() bool {
+	switch value {
+	case NotificationRule_Severity_ERROR,
+		NotificationRule_Severity_INFO,
+		NotificationRule_Severity_WARN:
+		return true
+	}
+	return false
+}
+
+// ParseNotificationRule_Severity returns the NotificationRule_Severity with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseNotificationRule_Severity(value string) (NotificationRule_Severity, error) {
+	if parsed := NotificationRule_Severity(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "NotificationRule_Severity", Value: value}
+}
+
+// Values returns all values of NotificationTarget_RoleType.
+func (NotificationTarget_RoleType) Values() []NotificationTarget_RoleType {
+	return []NotificationTarget_RoleType{
+		NotificationTarget_RoleType_SUPERUSER,
+		NotificationTarget_RoleType_TENANT_ADMIN,
+	}
+}
+
+// IsValid returns true if value is a value of NotificationTarget_RoleType.
+func (value NotificationTarget_RoleType) IsValid() bool {
+	switch value {
+	case NotificationTarget_RoleType_SUPERUSER,
+		NotificationTarget_RoleType_TENANT_ADMIN:
+		return true
+	}
+	return false
+}
+
+// ParseNotificationTarget_RoleType returns the NotificationTarget_RoleType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseNotificationTarget_RoleType(value string) (NotificationTarget_RoleType, error) {
+	if parsed := NotificationTarget_RoleType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "NotificationTarget_RoleType", Value: value}
+}
+
+// Values returns all values of NotificationTarget_TargetType.
+func (NotificationTarget_TargetType) Values() []NotificationTarget_TargetType {
+	return []NotificationTarget_TargetType{
+		NotificationTarget_TargetType_AUDIT_LOG,
+		NotificationTarget_TargetType_EMAIL,
+		NotificationTarget_TargetType_EMAIL_ROLE,
+		NotificationTarget_TargetType_EMAIL_USER,
+	}
+}
+
+// IsValid returns true if value is a value of NotificationTarget_TargetType.
+func (value NotificationTarget_TargetType) IsValid() bool {
+	switch value {
+	case NotificationTarget_TargetType_AUDIT_LOG,
+		NotificationTarget_TargetType_EMAIL,
+		NotificationTarget_TargetType_EMAIL_ROLE,
+		NotificationTarget_TargetType_EMAIL_USER:
+		return true
+	}
+	return false
+}
+
+// ParseNotificationTarget_TargetType returns the NotificationTarget_TargetType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseNotificationTarget_TargetType(value string) (NotificationTarget_TargetType, error) {
+	if parsed := NotificationTarget_TargetType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "NotificationTarget_TargetType", Value: value}
+}
+
+// Values returns all values of OSyncBehaviorPolicy_Mode.
+func (OSyncBehaviorPolicy_Mode) Values() []OSyncBehaviorPolicy_Mode {
+	return []OSyncBehaviorPolicy_Mode{
+		OSyncBehaviorPolicy_Mode_AS_REQUESTED,
+		OSyncBehaviorPolicy_Mode_DISABLE_ALWAYS,
+		OSyncBehaviorPolicy_Mode_ENABLE_ALWAYS,
+	}
+}
+
+// IsValid returns true if value is a value of OSyncBehaviorPolicy_Mode.
+func (value OSyncBehaviorPolicy_Mode) IsValid() bool {
+	switch value {
+	case OSyncBehaviorPolicy_Mode_AS_REQUESTED,
+		OSyncBehaviorPolicy_Mode_DISABLE_ALWAYS,
+		OSyncBehaviorPolicy_Mode_ENABLE_ALWAYS:
+		return true
+	}
+	return false
+}
+
+// ParseOSyncBehaviorPolicy_Mode returns the OSyncBehaviorPolicy_Mode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseOSyncBehaviorPolicy_Mode(value string) (OSyncBehaviorPolicy_Mode, error) {
+	if parsed := OSyncBehaviorPolicy_Mode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "OSyncBehaviorPolicy_Mode", Value: value}
+}
+
+// Values returns all values of PageCachePolicy_Mode.
+func (PageCachePolicy_Mode) Values() []PageCachePolicy_Mode {
+	return []PageCachePolicy_Mode{
+		PageCachePolicy_Mode_FLUSH_ALWAYS,
+		PageCachePolicy_Mode_KEEP_ALWAYS,
+		PageCachePolicy_Mode_USE_HEURISTIC,
+	}
+}
+
+// IsValid returns true if value is a value of PageCachePolicy_Mode.
+func (value PageCachePolicy_Mode) IsValid() bool {
+	switch value {
+	case PageCachePolicy_Mode_FLUSH_ALWAYS,
+		PageCachePolicy_Mode_KEEP_ALWAYS,
+		PageCachePolicy_Mode_USE_HEURISTIC:
+		return true
+	}
+	return false
+}
+
+// ParsePageCachePolicy_Mode returns the PageCachePolicy_Mode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParsePageCachePolicy_Mode(value string) (PageCachePolicy_Mode, error) {
+	if parsed := PageCachePolicy_Mode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "PageCachePolicy_Mode", Value: value}
+}
+
+// Values returns all values of PlacementSettings_PlacementMethod.
+func (PlacementSettings_PlacementMethod) Values() []PlacementSettings_PlacementMethod {
+	return []PlacementSettings_PlacementMethod{
+		PlacementSettings_PlacementMethod_AUTOMATIC_HDD_SSD,
+		PlacementSettings_PlacementMethod_TAG_BASED,
+	}
+}
+
+// IsValid returns true if value is a value of PlacementSettings_PlacementMethod.
+func (value PlacementSettings_PlacementMethod) IsValid() bool {
+	switch value {
+	case PlacementSettings_PlacementMethod_AUTOMATIC_HDD_SSD,
+		PlacementSettings_PlacementMethod_TAG_BASED:
+		return true
+	}
+	return false
+}
+
+// ParsePlacementSettings_PlacementMethod returns the PlacementSettings_PlacementMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParsePlacementSettings_PlacementMethod(value string) (PlacementSettings_PlacementMethod, error) {
+	if parsed := PlacementSettings_PlacementMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "PlacementSettings_PlacementMethod", Value: value}
+}
+
+// Values returns all values of PolicyScope_Operator.
+func (PolicyScope_Operator) Values() []PolicyScope_Operator {
+	return []PolicyScope_Operator{
+		PolicyScope_Operator_ALL_OF,
+		PolicyScope_Operator_ANY_OF,
+	}
+}
+
+// IsValid returns true if value is a value of PolicyScope_Operator.
+func (value PolicyScope_Operator) IsValid() bool {
+	switch value {
+	case PolicyScope_Operator_ALL_OF,
+		PolicyScope_Operator_ANY_OF:
+		return true
+	}
+	return false
+}
+
+// ParsePolicyScope_Operator returns the PolicyScope_Operator with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParsePolicyScope_Operator(value string) (PolicyScope_Operator, error) {
+	if parsed := PolicyScope_Operator(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "PolicyScope_Operator", Value: value}
+}
+
+// Values returns all values of RegenerateDatabaseRequest_DatabaseType.
+func (RegenerateDatabaseRequest_DatabaseType) Values() []RegenerateDatabaseRequest_DatabaseType {
+	return []RegenerateDatabaseRequest_DatabaseType{
+		RegenerateDatabaseRequest_DatabaseType_UPDATE_SCHEMA,
+		RegenerateDatabaseRequest_DatabaseType_VOLUME_ACCOUNTING,
+	}
+}
+
+// IsValid returns true if value is a value of RegenerateDatabaseRequest_DatabaseType.
+func (value RegenerateDatabaseRequest_DatabaseType) IsValid() bool {
+	switch value {
+	case RegenerateDatabaseRequest_DatabaseType_UPDATE_SCHEMA,
+		RegenerateDatabaseRequest_DatabaseType_VOLUME_ACCOUNTING:
+		return true
+	}
+	return false
+}
+
+// ParseRegenerateDatabaseRequest_DatabaseType returns the RegenerateDatabaseRequest_DatabaseType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseRegenerateDatabaseRequest_DatabaseType(value string) (RegenerateDatabaseRequest_DatabaseType, error) {
+	if parsed := RegenerateDatabaseRequest_DatabaseType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "RegenerateDatabaseRequest_DatabaseType", Value: value}
+}
+
+// Values returns all values of ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod.
+func (ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod) Values() []ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod {
+	return []ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod{
+		ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod_BLOCK_LEVEL,
+		ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod_OBJECT_LEVEL,
+	}
+}
+
+// IsValid returns true if value is a value of ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod.
+func (value ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod) IsValid() bool {
+	switch value {
+	case ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod_BLOCK_LEVEL,
+		ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod_OBJECT_LEVEL:
+		return true
+	}
+	return false
+}
+
+// ParseReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod returns the ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod(value string) (ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod, error) {
+	if parsed := ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ReplicationRedundancyDetailsPolicy_DistributionSchema_StripingMethod", Value: value}
+}
+
+// Values returns all values of Resource_LimitType.
+func (Resource_LimitType) Values() []Resource_LimitType {
+	return []Resource_LimitType{
+		Resource_LimitType_DEFAULT_QUOTA,
+		Resource_LimitType_DERIVED,
+		Resource_LimitType_LICENSE,
+		Resource_LimitType_QUOTA,
+	}
+}
+
+// IsValid returns true if value is a value of Resource_LimitType.
+func (value Resource_LimitType) IsValid() bool {
+	switch value {
+	case Resource_LimitType_DEFAULT_QUOTA,
+		Resource_LimitType_DERIVED,
+		Resource_LimitType_LICENSE,
+		Resource_LimitType_QUOTA:
+		return true
+	}
+	return false
+}
+
+// ParseResource_LimitType returns the Resource_LimitType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseResource_LimitType(value string) (Resource_LimitType, error) {
+	if parsed := Resource_LimitType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Resource_LimitType", Value: value}
+}
+
+// Values returns all values of Resource_Type.
+func (Resource_Type) Values() []Resource_Type {
+	return []Resource_Type{
+		Resource_Type_DIRECTORY_COUNT,
+		Resource_Type_FILE_COUNT,
+		Resource_Type_HDD_LOGICAL_DISK_SPACE,
+		Resource_Type_HDD_PHYSICAL_DISK_SPACE,
+		Resource_Type_LOGICAL_DISK_SPACE,
+		Resource_Type_PHYSICAL_DISK_SPACE,
+		Resource_Type_RESERVED_0,
+		Resource_Type_RESERVED_4,
+		Resource_Type_RESERVED_7,
+		Resource_Type_SSD_LOGICAL_DISK_SPACE,
+		Resource_Type_SSD_PHYSICAL_DISK_SPACE,
+		Resource_Type_VOLUME_COUNT,
+	}
+}
+
+// IsValid returns true if value is a value of Resource_Type.
+func (value Resource_Type) IsValid() bool {
+	switch value {
+	case Resource_Type_DIRECTORY_COUNT,
+		Resource_Type_FILE_COUNT,
+		Resource_Type_HDD_LOGICAL_DISK_SPACE,
+		Resource_Type_HDD_PHYSICAL_DISK_SPACE,
+		Resource_Type_LOGICAL_DISK_SPACE,
+		Resource_Type_PHYSICAL_DISK_SPACE,
+		Resource_Type_RESERVED_0,
+		Resource_Type_RESERVED_4,
+		Resource_Type_RESERVED_7,
+		Resource_Type_SSD_LOGICAL_DISK_SPACE,
+		Resource_Type_SSD_PHYSICAL_DISK_SPACE,
+		Resource_Type_VOLUME_COUNT:
+		return true
+	}
+	return false
+}
+
+// ParseResource_Type returns the Resource_Type with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseResource_Type(value string) (Resource_Type, error) {
+	if parsed := Resource_Type(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "Resource_Type", Value: value}
+}
+
+// Values returns all values of RpcRetryOperationsPolicy_Mode.
+func (RpcRetryOperationsPolicy_Mode) Values() []RpcRetryOperationsPolicy_Mode {
+	return []RpcRetryOperationsPolicy_Mode{
+		RpcRetryOperationsPolicy_Mode_RETRY_FOREVER,
+		RpcRetryOperationsPolicy_Mode_RETRY_FOREVER_UNLESS_FULL,
+		RpcRetryOperationsPolicy_Mode_RETRY_INTERACTIVE,
+		RpcRetryOperationsPolicy_Mode_RETRY_NEVER,
+	}
+}
+
+// IsValid returns true if value is a value of RpcRetryOperationsPolicy_Mode.
+func (value RpcRetryOperationsPolicy_Mode) IsValid() bool {
+	switch value {
+	case RpcRetryOperationsPolicy_Mode_RETRY_FOREVER,
+		RpcRetryOperationsPolicy_Mode_RETRY_FOREVER_UNLESS_FULL,
+		RpcRetryOperationsPolicy_Mode_RETRY_INTERACTIVE,
+		RpcRetryOperationsPolicy_Mode_RETRY_NEVER:
+		return true
+	}
+	return false
+}
+
+// ParseRpcRetryOperationsPolicy_Mode returns the RpcRetryOperationsPolicy_Mode with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseRpcRetryOperationsPolicy_Mode(value string) (RpcRetryOperationsPolicy_Mode, error) {
+	if parsed := RpcRetryOperationsPolicy_Mode(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "RpcRetryOperationsPolicy_Mode", Value: value}
+}
+
+// Values returns all values of RuleAction_ActionType.
+func (RuleAction_ActionType) Values() []RuleAction_ActionType {
+	return []RuleAction_ActionType{
+		RuleAction_ActionType_DO_NOTHING,
+		RuleAction_ActionType_OBSOLETE_CLEANUP_DEVICE,
+		RuleAction_ActionType_OBSOLETE_ENFORCE_FILE_PLACEMENT,
+		RuleAction_ActionType_OBSOLETE_ENFORCE_VOLUME_PLACEMENT,
+		RuleAction_ActionType_OBSOLETE_MANAGE_REGISTRY_REPLICAS,
+		RuleAction_ActionType_OBSOLETE_NOTIFY,
+		RuleAction_ActionType_OBSOLETE_REBALANCE_DEVICE,
+		RuleAction_ActionType_OBSOLETE_REGENERATE_DEVICE,
+		RuleAction_ActionType_OBSOLETE_RESET_VOLUME_ACCOUNTING,
+		RuleAction_ActionType_OBSOLETE_SCRUB_VOLUME,
+		RuleAction_ActionType_OBSOLETE_SET_DEVICE_DISCONNECTED,
+		RuleAction_ActionType_OBSOLETE_SET_DEVICE_OFFLINE,
+		RuleAction_ActionType_OBSOLETE_TRIGGER_VOLUME_CHECKPOINT,
+		RuleAction_ActionType_OBSOLETE_UNREGISTER_SERVICE,
+	}
+}
+
+// IsValid returns true if value is a value of RuleAction_ActionType.
+func (value RuleAction_ActionType) IsValid() bool {
+	switch value {
+	case RuleAction_ActionType_DO_NOTHING,
+		RuleAction_ActionType_OBSOLETE_CLEANUP_DEVICE,
+		RuleAction_ActionType_OBSOLETE_ENFORCE_FILE_PLACEMENT,
+		RuleAction_ActionType_OBSOLETE_ENFORCE_VOLUME_PLACEMENT,
+		RuleAction_ActionType_OBSOLETE_MANAGE_REGISTRY_REPLICAS,
+		RuleAction_ActionType_OBSOLETE_NOTIFY,
+		RuleAction_ActionType_OBSOLETE_REBALANCE_DEVICE,
+		RuleAction_ActionType_OBSOLETE_REGENERATE_DEVICE,
+		RuleAction_ActionType_OBSOLETE_RESET_VOLUME_ACCOUNTING,
+		RuleAction_ActionType_OBSOLETE_SCRUB_VOLUME,
+		RuleAction_ActionType_OBSOLETE_SET_DEVICE_DISCONNECTED,
+		RuleAction_ActionType_OBSOLETE_SET_DEVICE_OFFLINE,
+		RuleAction_ActionType_OBSOLETE_TRIGGER_VOLUME_CHECKPOINT,
+		RuleAction_ActionType_OBSOLETE_UNREGISTER_SERVICE:
+		return true
+	}
+	return false
+}
+
+// ParseRuleAction_ActionType returns the RuleAction_ActionType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseRuleAction_ActionType(value string) (RuleAction_ActionType, error) {
+	if parsed := RuleAction_ActionType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "RuleAction_ActionType", Value: value}
+}
+
+// Values returns all values of ServiceDescription_NetworkEndpoint_Protocol.
+func (ServiceDescription_NetworkEndpoint_Protocol) Values() []ServiceDescription_NetworkEndpoint_Protocol {
+	return []ServiceDescription_NetworkEndpoint_Protocol{
+		ServiceDescription_NetworkEndpoint_Protocol_HTTP,
+		ServiceDescription_NetworkEndpoint_Protocol_HTTPS,
+		ServiceDescription_NetworkEndpoint_Protocol_NFS,
+		ServiceDescription_NetworkEndpoint_Protocol_PLAIN,
+	}
+}
+
+// IsValid returns true if value is a value of ServiceDescription_NetworkEndpoint_Protocol.
+func (value ServiceDescription_NetworkEndpoint_Protocol) IsValid() bool {
+	switch value {
+	case ServiceDescription_NetworkEndpoint_Protocol_HTTP,
+		ServiceDescription_NetworkEndpoint_Protocol_HTTPS,
+		ServiceDescription_NetworkEndpoint_Protocol_NFS,
+		ServiceDescription_NetworkEndpoint_Protocol_PLAIN:
+		return true
+	}
+	return false
+}
+
+// ParseServiceDescription_NetworkEndpoint_Protocol returns the ServiceDescription_NetworkEndpoint_Protocol with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseServiceDescription_NetworkEndpoint_Protocol(value string) (ServiceDescription_NetworkEndpoint_Protocol, error) {
+	if parsed := ServiceDescription_NetworkEndpoint_Protocol(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "ServiceDescription_NetworkEndpoint_Protocol", Value: value}
+}
+
+// Values returns all values of SubjectList_Type.
+func (SubjectList_Type) Values() []SubjectList_Type {
+	return []SubjectList_Type{
+		SubjectList_Type_DEVICE,
+		SubjectList_Type_SERVICE,
+		SubjectList_Type_SNAPSHOT,
+		SubjectList_Type_TASK,
+		SubjectList_Type_UNFORMATTED_DEVICE,
+		SubjectList_Type_VOLUME,
+	}
+}
+
+// IsValid returns true if value is a value of SubjectList_Type.
+func (value SubjectList_Type) IsValid() bool {
+	switch value {
+	case SubjectList_Type_DEVICE,
+		SubjectList_Type_SERVICE,
+		SubjectList_Type_SNAPSHOT,
+		SubjectList_Type_TASK,
+		SubjectList_Type_UNFORMATTED_DEVICE,
+		SubjectList_Type_VOLUME:
+		return true
+	}
+	return false
+}
+
+// ParseSubjectList_Type returns the SubjectList_Type with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSubjectList_Type(value string) (SubjectList_Type, error) {
+	if parsed := SubjectList_Type(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SubjectList_Type", Value: value}
+}
+
+// Values returns all values of SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount.
+func (SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount) Values() []SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount {
+	return []SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount{
+		SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount_ALWAYS,
+		SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount_DISABLE,
+		SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount_PER_DEVICE,
+	}
+}
+
+// IsValid returns true if value is a value of SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount.
+func (value SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount) IsValid() bool {
+	switch value {
+	case SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount_ALWAYS,
+		SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount_DISABLE,
+		SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount_PER_DEVICE:
+		return true
+	}
+	return false
+}
+
+// ParseSystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount returns the SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount(value string) (SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount, error) {
+	if parsed := SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SystemConfiguration_DeviceManagerConfig_AutoFilesSystemCheckOnDeviceMount", Value: value}
+}
+
+// Values returns all values of SystemConfiguration_EjectableDevicesPolicy_LedType.
+func (SystemConfiguration_EjectableDevicesPolicy_LedType) Values() []SystemConfiguration_EjectableDevicesPolicy_LedType {
+	return []SystemConfiguration_EjectableDevicesPolicy_LedType{
+		SystemConfiguration_EjectableDevicesPolicy_LedType_FAILURE,
+		SystemConfiguration_EjectableDevicesPolicy_LedType_LOCATE,
+		SystemConfiguration_EjectableDevicesPolicy_LedType_NONE,
+	}
+}
+
+// IsValid returns true if value is a value of SystemConfiguration_EjectableDevicesPolicy_LedType.
+func (value SystemConfiguration_EjectableDevicesPolicy_LedType) IsValid() bool {
+	switch value {
+	case SystemConfiguration_EjectableDevicesPolicy_LedType_FAILURE,
+		SystemConfiguration_EjectableDevicesPolicy_LedType_LOCATE,
+		SystemConfiguration_EjectableDevicesPolicy_LedType_NONE:
+		return true
+	}
+	return false
+}
+
+// ParseSystemConfiguration_EjectableDevicesPolicy_LedType returns the SystemConfiguration_EjectableDevicesPolicy_LedType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSystemConfiguration_EjectableDevicesPolicy_LedType(value string) (SystemConfiguration_EjectableDevicesPolicy_LedType, error) {
+	if parsed := SystemConfiguration_EjectableDevicesPolicy_LedType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SystemConfiguration_EjectableDevicesPolicy_LedType", Value: value}
+}
+
+// Values returns all values of SystemConfiguration_HandleDefectiveDevicesPolicy_Action.
+func (SystemConfiguration_HandleDefectiveDevicesPolicy_Action) Values() []SystemConfiguration_HandleDefectiveDevicesPolicy_Action {
+	return []SystemConfiguration_HandleDefectiveDevicesPolicy_Action{
+		SystemConfiguration_HandleDefectiveDevicesPolicy_Action_NO_ACTION,
+		SystemConfiguration_HandleDefectiveDevicesPolicy_Action_REGENERATE_DEVICE,
+		SystemConfiguration_HandleDefectiveDevicesPolicy_Action_TAKE_OFFLINE,
+	}
+}
+
+// IsValid returns true if value is a value of SystemConfiguration_HandleDefectiveDevicesPolicy_Action.
+func (value SystemConfiguration_HandleDefectiveDevicesPolicy_Action) IsValid() bool {
+	switch value {
+	case SystemConfiguration_HandleDefectiveDevicesPolicy_Action_NO_ACTION,
+		SystemConfiguration_HandleDefectiveDevicesPolicy_Action_REGENERATE_DEVICE,
+		SystemConfiguration_HandleDefectiveDevicesPolicy_Action_TAKE_OFFLINE:
+		return true
+	}
+	return false
+}
+
+// ParseSystemConfiguration_HandleDefectiveDevicesPolicy_Action returns the SystemConfiguration_HandleDefectiveDevicesPolicy_Action with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSystemConfiguration_HandleDefectiveDevicesPolicy_Action(value string) (SystemConfiguration_HandleDefectiveDevicesPolicy_Action, error) {
+	if parsed := SystemConfiguration_HandleDefectiveDevicesPolicy_Action(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SystemConfiguration_HandleDefectiveDevicesPolicy_Action", Value: value}
+}
+
+// Values returns all values of SystemConfiguration_LdapServerConfig_ConnectionSecurity.
+func (SystemConfiguration_LdapServerConfig_ConnectionSecurity) Values() []SystemConfiguration_LdapServerConfig_ConnectionSecurity {
+	return []SystemConfiguration_LdapServerConfig_ConnectionSecurity{
+		SystemConfiguration_LdapServerConfig_ConnectionSecurity_NONE,
+		SystemConfiguration_LdapServerConfig_ConnectionSecurity_SSL,
+		SystemConfiguration_LdapServerConfig_ConnectionSecurity_START_TLS,
+	}
+}
+
+// IsValid returns true if value is a value of SystemConfiguration_LdapServerConfig_ConnectionSecurity.
+func (value SystemConfiguration_LdapServerConfig_ConnectionSecurity) IsValid() bool {
+	switch value {
+	case SystemConfiguration_LdapServerConfig_ConnectionSecurity_NONE,
+		SystemConfiguration_LdapServerConfig_ConnectionSecurity_SSL,
+		SystemConfiguration_LdapServerConfig_ConnectionSecurity_START_TLS:
+		return true
+	}
+	return false
+}
+
+// ParseSystemConfiguration_LdapServerConfig_ConnectionSecurity returns the SystemConfiguration_LdapServerConfig_ConnectionSecurity with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSystemConfiguration_LdapServerConfig_ConnectionSecurity(value string) (SystemConfiguration_LdapServerConfig_ConnectionSecurity, error) {
+	if parsed := SystemConfiguration_LdapServerConfig_ConnectionSecurity(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SystemConfiguration_LdapServerConfig_ConnectionSecurity", Value: value}
+}
+
+// Values returns all values of SystemConfiguration_LdapServerConfig_DataSource.
+func (SystemConfiguration_LdapServerConfig_DataSource) Values() []SystemConfiguration_LdapServerConfig_DataSource {
+	return []SystemConfiguration_LdapServerConfig_DataSource{
+		SystemConfiguration_LdapServerConfig_DataSource_LDAP_ATTRIBUTE,
+		SystemConfiguration_LdapServerConfig_DataSource_LDAP_GROUP_MEMBERSHIP,
+		SystemConfiguration_LdapServerConfig_DataSource_LOCAL_DATABASE,
+	}
+}
+
+// IsValid returns true if value is a value of SystemConfiguration_LdapServerConfig_DataSource.
+func (value SystemConfiguration_LdapServerConfig_DataSource) IsValid() bool {
+	switch value {
+	case SystemConfiguration_LdapServerConfig_DataSource_LDAP_ATTRIBUTE,
+		SystemConfiguration_LdapServerConfig_DataSource_LDAP_GROUP_MEMBERSHIP,
+		SystemConfiguration_LdapServerConfig_DataSource_LOCAL_DATABASE:
+		return true
+	}
+	return false
+}
+
+// ParseSystemConfiguration_LdapServerConfig_DataSource returns the SystemConfiguration_LdapServerConfig_DataSource with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSystemConfiguration_LdapServerConfig_DataSource(value string) (SystemConfiguration_LdapServerConfig_DataSource, error) {
+	if parsed := SystemConfiguration_LdapServerConfig_DataSource(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SystemConfiguration_LdapServerConfig_DataSource", Value: value}
+}
+
+// Values returns all values of SystemConfiguration_OpenIdConnectConfig_DataSource.
+func (SystemConfiguration_OpenIdConnectConfig_DataSource) Values() []SystemConfiguration_OpenIdConnectConfig_DataSource {
+	return []SystemConfiguration_OpenIdConnectConfig_DataSource{
+		SystemConfiguration_OpenIdConnectConfig_DataSource_LOCAL_DATABASE,
+		SystemConfiguration_OpenIdConnectConfig_DataSource_OIDC_CLAIMS,
+		SystemConfiguration_OpenIdConnectConfig_DataSource_OIDC_GROUP_MEMBERSHIP,
+	}
+}
+
+// IsValid returns true if value is a value of SystemConfiguration_OpenIdConnectConfig_DataSource.
+func (value SystemConfiguration_OpenIdConnectConfig_DataSource) IsValid() bool {
+	switch value {
+	case SystemConfiguration_OpenIdConnectConfig_DataSource_LOCAL_DATABASE,
+		SystemConfiguration_OpenIdConnectConfig_DataSource_OIDC_CLAIMS,
+		SystemConfiguration_OpenIdConnectConfig_DataSource_OIDC_GROUP_MEMBERSHIP:
+		return true
+	}
+	return false
+}
+
+// ParseSystemConfiguration_OpenIdConnectConfig_DataSource returns the SystemConfiguration_OpenIdConnectConfig_DataSource with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSystemConfiguration_OpenIdConnectConfig_DataSource(value string) (SystemConfiguration_OpenIdConnectConfig_DataSource, error) {
+	if parsed := SystemConfiguration_OpenIdConnectConfig_DataSource(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SystemConfiguration_OpenIdConnectConfig_DataSource", Value: value}
+}
+
+// Values returns all values of SystemConfiguration_S3ProxyConfig_AuthenticationType.
+func (SystemConfiguration_S3ProxyConfig_AuthenticationType) Values() []SystemConfiguration_S3ProxyConfig_AuthenticationType {
+	return []SystemConfiguration_S3ProxyConfig_AuthenticationType{
+		SystemConfiguration_S3ProxyConfig_AuthenticationType_KEYSTONE,
+		SystemConfiguration_S3ProxyConfig_AuthenticationType_LDAP,
+		SystemConfiguration_S3ProxyConfig_AuthenticationType_PASSWORD_FILE,
+		SystemConfiguration_S3ProxyConfig_AuthenticationType_REGISTRY_USER_DATABASE,
+	}
+}
+
+// IsValid returns true if value is a value of SystemConfiguration_S3ProxyConfig_AuthenticationType.
+func (value SystemConfiguration_S3ProxyConfig_AuthenticationType) IsValid() bool {
+	switch value {
+	case SystemConfiguration_S3ProxyConfig_AuthenticationType_KEYSTONE,
+		SystemConfiguration_S3ProxyConfig_AuthenticationType_LDAP,
+		SystemConfiguration_S3ProxyConfig_AuthenticationType_PASSWORD_FILE,
+		SystemConfiguration_S3ProxyConfig_AuthenticationType_REGISTRY_USER_DATABASE:
+		return true
+	}
+	return false
+}
+
+// ParseSystemConfiguration_S3ProxyConfig_AuthenticationType returns the SystemConfiguration_S3ProxyConfig_AuthenticationType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseSystemConfiguration_S3ProxyConfig_AuthenticationType(value string) (SystemConfiguration_S3ProxyConfig_AuthenticationType, error) {
+	if parsed := SystemConfiguration_S3ProxyConfig_AuthenticationType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "SystemConfiguration_S3ProxyConfig_AuthenticationType", Value: value}
+}
+
+// Values returns all values of TagBasedPlacementPolicy_AutomaticConstraints.
+func (TagBasedPlacementPolicy_AutomaticConstraints) Values() []TagBasedPlacementPolicy_AutomaticConstraints {
+	return []TagBasedPlacementPolicy_AutomaticConstraints{
+		TagBasedPlacementPolicy_AutomaticConstraints_AUTOMATIC_HDD_SSD,
+		TagBasedPlacementPolicy_AutomaticConstraints_NONE,
+	}
+}
+
+// IsValid returns true if value is a value of TagBasedPlacementPolicy_AutomaticConstraints.
+func (value TagBasedPlacementPolicy_AutomaticConstraints) IsValid() bool {
+	switch value {
+	case TagBasedPlacementPolicy_AutomaticConstraints_AUTOMATIC_HDD_SSD,
+		TagBasedPlacementPolicy_AutomaticConstraints_NONE:
+		return true
+	}
+	return false
+}
+
+// ParseTagBasedPlacementPolicy_AutomaticConstraints returns the TagBasedPlacementPolicy_AutomaticConstraints with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseTagBasedPlacementPolicy_AutomaticConstraints(value string) (TagBasedPlacementPolicy_AutomaticConstraints, error) {
+	if parsed := TagBasedPlacementPolicy_AutomaticConstraints(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "TagBasedPlacementPolicy_AutomaticConstraints", Value: value}
+}
+
+// Values returns all values of TaskInfo_OwnerType.
+func (TaskInfo_OwnerType) Values() []TaskInfo_OwnerType {
+	return []TaskInfo_OwnerType{
+		TaskInfo_OwnerType_HEALTH_MANAGER,
+		TaskInfo_OwnerType_USER,
+	}
+}
+
+// IsValid returns true if value is a value of TaskInfo_OwnerType.
+func (value TaskInfo_OwnerType) IsValid() bool {
+	switch value {
+	case TaskInfo_OwnerType_HEALTH_MANAGER,
+		TaskInfo_OwnerType_USER:
+		return true
+	}
+	return false
+}
+
+// ParseTaskInfo_OwnerType returns the TaskInfo_OwnerType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseTaskInfo_OwnerType(value string) (TaskInfo_OwnerType, error) {
+	if parsed := TaskInfo_OwnerType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "TaskInfo_OwnerType", Value: value}
+}
+
+// Values returns all values of TaskInfo_SchedulingReason.
+func (TaskInfo_SchedulingReason) Values() []TaskInfo_SchedulingReason {
+	return []TaskInfo_SchedulingReason{
+		TaskInfo_SchedulingReason_ALL_SUBTASKS_TERMINATED,
+		TaskInfo_SchedulingReason_HAS_HIGHER_PRIORITY_TASK,
+		TaskInfo_SchedulingReason_NOT_IN_MAINTENANCE_WINDOW,
+		TaskInfo_SchedulingReason_NUMBER_OF_INACCESSIBLE_DEVICES_LIMIT,
+		TaskInfo_SchedulingReason_NUMBER_OF_TASKS_LIMIT,
+		TaskInfo_SchedulingReason_PRECONDITION_VIOLATION,
+		TaskInfo_SchedulingReason_USER_ACTION,
+	}
+}
+
+// IsValid returns true if value is a value of TaskInfo_SchedulingReason.
+func (value TaskInfo_SchedulingReason) IsValid() bool {
+	switch value {
+	case TaskInfo_SchedulingReason_ALL_SUBTASKS_TERMINATED,
+		TaskInfo_SchedulingReason_HAS_HIGHER_PRIORITY_TASK,
+		TaskInfo_SchedulingReason_NOT_IN_MAINTENANCE_WINDOW,
+		TaskInfo_SchedulingReason_NUMBER_OF_INACCESSIBLE_DEVICES_LIMIT,
+		TaskInfo_SchedulingReason_NUMBER_OF_TASKS_LIMIT,
+		TaskInfo_SchedulingReason_PRECONDITION_VIOLATION,
+		TaskInfo_SchedulingReason_USER_ACTION:
+		return true
+	}
+	return false
+}
+
+// ParseTaskInfo_SchedulingReason returns the TaskInfo_SchedulingReason with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseTaskInfo_SchedulingReason(value string) (TaskInfo_SchedulingReason, error) {
+	if parsed := TaskInfo_SchedulingReason(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "TaskInfo_SchedulingReason", Value: value}
+}
+
+// Values returns all values of TaskInfo_Performance_Type.
+func (TaskInfo_Performance_Type) Values() []TaskInfo_Performance_Type {
+	return []TaskInfo_Performance_Type{
+		TaskInfo_Performance_Type_BYTE,
+		TaskInfo_Performance_Type_FILE,
+		TaskInfo_Performance_Type_REGISTRY,
+		TaskInfo_Performance_Type_SCANNING,
+		TaskInfo_Performance_Type_VOLUME,
+	}
+}
+
+// IsValid returns true if value is a value of TaskInfo_Performance_Type.
+func (value TaskInfo_Performance_Type) IsValid() bool {
+	switch value {
+	case TaskInfo_Performance_Type_BYTE,
+		TaskInfo_Performance_Type_FILE,
+		TaskInfo_Performance_Type_REGISTRY,
+		TaskInfo_Performance_Type_SCANNING,
+		TaskInfo_Performance_Type_VOLUME:
+		return true
+	}
+	return false
+}
+
+// ParseTaskInfo_Performance_Type returns the TaskInfo_Performance_Type with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseTaskInfo_Performance_Type(value string) (TaskInfo_Performance_Type, error) {
+	if parsed := TaskInfo_Performance_Type(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "TaskInfo_Performance_Type", Value: value}
+}
+
+// Values returns all values of UserAndGroupMappingSecurityPolicy_MappingPolicy.
+func (UserAndGroupMappingSecurityPolicy_MappingPolicy) Values() []UserAndGroupMappingSecurityPolicy_MappingPolicy {
+	return []UserAndGroupMappingSecurityPolicy_MappingPolicy{
+		UserAndGroupMappingSecurityPolicy_MappingPolicy_DENY_UNKNOWN,
+		UserAndGroupMappingSecurityPolicy_MappingPolicy_USE_NUMERIC_ID_FOR_UNKNOWN,
+	}
+}
+
+// IsValid returns true if value is a value of UserAndGroupMappingSecurityPolicy_MappingPolicy.
+func (value UserAndGroupMappingSecurityPolicy_MappingPolicy) IsValid() bool {
+	switch value {
+	case UserAndGroupMappingSecurityPolicy_MappingPolicy_DENY_UNKNOWN,
+		UserAndGroupMappingSecurityPolicy_MappingPolicy_USE_NUMERIC_ID_FOR_UNKNOWN:
+		return true
+	}
+	return false
+}
+
+// ParseUserAndGroupMappingSecurityPolicy_MappingPolicy returns the UserAndGroupMappingSecurityPolicy_MappingPolicy with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseUserAndGroupMappingSecurityPolicy_MappingPolicy(value string) (UserAndGroupMappingSecurityPolicy_MappingPolicy, error) {
+	if parsed := UserAndGroupMappingSecurityPolicy_MappingPolicy(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "UserAndGroupMappingSecurityPolicy_MappingPolicy", Value: value}
+}
+
+// Values returns all values of UserSource_DirectoryType.
+func (UserSource_DirectoryType) Values() []UserSource_DirectoryType {
+	return []UserSource_DirectoryType{
+		UserSource_DirectoryType_INTERNAL_DATABASE,
+		UserSource_DirectoryType_KEYSTONE,
+		UserSource_DirectoryType_LDAP,
+		UserSource_DirectoryType_OIDC,
+	}
+}
+
+// IsValid returns true if value is a value of UserSource_DirectoryType.
+func (value UserSource_DirectoryType) IsValid() bool {
+	switch value {
+	case UserSource_DirectoryType_INTERNAL_DATABASE,
+		UserSource_DirectoryType_KEYSTONE,
+		UserSource_DirectoryType_LDAP,
+		UserSource_DirectoryType_OIDC:
+		return true
+	}
+	return false
+}
+
+// ParseUserSource_DirectoryType returns the UserSource_DirectoryType with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseUserSource_DirectoryType(value string) (UserSource_DirectoryType, error) {
+	if parsed := UserSource_DirectoryType(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "UserSource_DirectoryType", Value: value}
+}
+
+// Values returns all values of VerifyLicenseResponse_VerificationResult.
+func (VerifyLicenseResponse_VerificationResult) Values() []VerifyLicenseResponse_VerificationResult {
+	return []VerifyLicenseResponse_VerificationResult{
+		VerifyLicenseResponse_VerificationResult_EXPIRED,
+		VerifyLicenseResponse_VerificationResult_INVALID,
+		VerifyLicenseResponse_VerificationResult_OK,
+		VerifyLicenseResponse_VerificationResult_SUPERSEDED,
+	}
+}
+
+// IsValid returns true if value is a value of VerifyLicenseResponse_VerificationResult.
+func (value VerifyLicenseResponse_VerificationResult) IsValid() bool {
+	switch value {
+	case VerifyLicenseResponse_VerificationResult_EXPIRED,
+		VerifyLicenseResponse_VerificationResult_INVALID,
+		VerifyLicenseResponse_VerificationResult_OK,
+		VerifyLicenseResponse_VerificationResult_SUPERSEDED:
+		return true
+	}
+	return false
+}
+
+// ParseVerifyLicenseResponse_VerificationResult returns the VerifyLicenseResponse_VerificationResult with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseVerifyLicenseResponse_VerificationResult(value string) (VerifyLicenseResponse_VerificationResult, error) {
+	if parsed := VerifyLicenseResponse_VerificationResult(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "VerifyLicenseResponse_VerificationResult", Value: value}
+}
+
+// Values returns all values of VolumeEncryptionContext_FileEncryptionMethod.
+func (VolumeEncryptionContext_FileEncryptionMethod) Values() []VolumeEncryptionContext_FileEncryptionMethod {
+	return []VolumeEncryptionContext_FileEncryptionMethod{
+		VolumeEncryptionContext_FileEncryptionMethod_AES_XTS_128,
+		VolumeEncryptionContext_FileEncryptionMethod_AES_XTS_256,
+	}
+}
+
+// IsValid returns true if value is a value of VolumeEncryptionContext_FileEncryptionMethod.
+func (value VolumeEncryptionContext_FileEncryptionMethod) IsValid() bool {
+	switch value {
+	case VolumeEncryptionContext_FileEncryptionMethod_AES_XTS_128,
+		VolumeEncryptionContext_FileEncryptionMethod_AES_XTS_256:
+		return true
+	}
+	return false
+}
+
+// ParseVolumeEncryptionContext_FileEncryptionMethod returns the VolumeEncryptionContext_FileEncryptionMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseVolumeEncryptionContext_FileEncryptionMethod(value string) (VolumeEncryptionContext_FileEncryptionMethod, error) {
+	if parsed := VolumeEncryptionContext_FileEncryptionMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "VolumeEncryptionContext_FileEncryptionMethod", Value: value}
+}
+
+// Values returns all values of VolumeEncryptionContext_KeyDerivationMethod.
+func (VolumeEncryptionContext_KeyDerivationMethod) Values() []VolumeEncryptionContext_KeyDerivationMethod {
+	return []VolumeEncryptionContext_KeyDerivationMethod{
+		VolumeEncryptionContext_KeyDerivationMethod_HMAC_SHA256,
+		VolumeEncryptionContext_KeyDerivationMethod_HMAC_SHA512,
+	}
+}
+
+// IsValid returns true if value is a value of VolumeEncryptionContext_KeyDerivationMethod.
+func (value VolumeEncryptionContext_KeyDerivationMethod) IsValid() bool {
+	switch value {
+	case VolumeEncryptionContext_KeyDerivationMethod_HMAC_SHA256,
+		VolumeEncryptionContext_KeyDerivationMethod_HMAC_SHA512:
+		return true
+	}
+	return false
+}
+
+// ParseVolumeEncryptionContext_KeyDerivationMethod returns the VolumeEncryptionContext_KeyDerivationMethod with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseVolumeEncryptionContext_KeyDerivationMethod(value string) (VolumeEncryptionContext_KeyDerivationMethod, error) {
+	if parsed := VolumeEncryptionContext_KeyDerivationMethod(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "VolumeEncryptionContext_KeyDerivationMethod", Value: value}
+}
+
+// Values returns all values of VolumeEncryptionContext_VolumeKeyOwner.
+func (VolumeEncryptionContext_VolumeKeyOwner) Values() []VolumeEncryptionContext_VolumeKeyOwner {
+	return []VolumeEncryptionContext_VolumeKeyOwner{
+		VolumeEncryptionContext_VolumeKeyOwner_SYSTEM,
+		VolumeEncryptionContext_VolumeKeyOwner_USER,
+	}
+}
+
+// IsValid returns true if value is a value of VolumeEncryptionContext_VolumeKeyOwner.
+func (value VolumeEncryptionContext_VolumeKeyOwner) IsValid() bool {
+	switch value {
+	case VolumeEncryptionContext_VolumeKeyOwner_SYSTEM,
+		VolumeEncryptionContext_VolumeKeyOwner_USER:
+		return true
+	}
+	return false
+}
+
+// ParseVolumeEncryptionContext_VolumeKeyOwner returns the VolumeEncryptionContext_VolumeKeyOwner with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseVolumeEncryptionContext_VolumeKeyOwner(value string) (VolumeEncryptionContext_VolumeKeyOwner, error) {
+	if parsed := VolumeEncryptionContext_VolumeKeyOwner(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "VolumeEncryptionContext_VolumeKeyOwner", Value: value}
+}
+
+// Values returns all values of VolumeEncryptionPolicy_Profile.
+func (VolumeEncryptionPolicy_Profile) Values() []VolumeEncryptionPolicy_Profile {
+	return []VolumeEncryptionPolicy_Profile{
+		VolumeEncryptionPolicy_Profile_NONE,
+		VolumeEncryptionPolicy_Profile_SYSTEM_AES_128,
+		VolumeEncryptionPolicy_Profile_SYSTEM_AES_256,
+	}
+}
+
+// IsValid returns true if value is a value of VolumeEncryptionPolicy_Profile.
+func (value VolumeEncryptionPolicy_Profile) IsValid() bool {
+	switch value {
+	case VolumeEncryptionPolicy_Profile_NONE,
+		VolumeEncryptionPolicy_Profile_SYSTEM_AES_128,
+		VolumeEncryptionPolicy_Profile_SYSTEM_AES_256:
+		return true
+	}
+	return false
+}
+
+// ParseVolumeEncryptionPolicy_Profile returns the VolumeEncryptionPolicy_Profile with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseVolumeEncryptionPolicy_Profile(value string) (VolumeEncryptionPolicy_Profile, error) {
+	if parsed := VolumeEncryptionPolicy_Profile(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "VolumeEncryptionPolicy_Profile", Value: value}
+}
+
+// Values returns all values of WindowsSpecificSecurityPolicy_GroupSelectionPolicy.
+func (WindowsSpecificSecurityPolicy_GroupSelectionPolicy) Values() []WindowsSpecificSecurityPolicy_GroupSelectionPolicy {
+	return []WindowsSpecificSecurityPolicy_GroupSelectionPolicy{
+		WindowsSpecificSecurityPolicy_GroupSelectionPolicy_DEFAULT_GROUP,
+		WindowsSpecificSecurityPolicy_GroupSelectionPolicy_PARENT_DIRECTORY,
+		WindowsSpecificSecurityPolicy_GroupSelectionPolicy_USERNAME,
+	}
+}
+
+// IsValid returns true if value is a value of WindowsSpecificSecurityPolicy_GroupSelectionPolicy.
+func (value WindowsSpecificSecurityPolicy_GroupSelectionPolicy) IsValid() bool {
+	switch value {
+	case WindowsSpecificSecurityPolicy_GroupSelectionPolicy_DEFAULT_GROUP,
+		WindowsSpecificSecurityPolicy_GroupSelectionPolicy_PARENT_DIRECTORY,
+		WindowsSpecificSecurityPolicy_GroupSelectionPolicy_USERNAME:
+		return true
+	}
+	return false
+}
+
+// ParseWindowsSpecificSecurityPolicy_GroupSelectionPolicy returns the WindowsSpecificSecurityPolicy_GroupSelectionPolicy with the value, an error matching ErrUnknownEnumValue
+// if the value is unknown.
+func ParseWindowsSpecificSecurityPolicy_GroupSelectionPolicy(value string) (WindowsSpecificSecurityPolicy_GroupSelectionPolicy, error) {
+	if parsed := WindowsSpecificSecurityPolicy_GroupSelectionPolicy(value); parsed.IsValid() {
+		return parsed, nil
+	}
+	return "", &EnumValueError{Type: "WindowsSpecificSecurityPolicy_GroupSelectionPolicy", Value: value}
+}
@@ -0,0 +1,132 @@
+package quobyte
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// JSON-RPC error codes returned by the Quobyte API service
+const (
+	ErrorCodeParseError         int64 = -32700
+	ErrorCodeInvalidRequest     int64 = -32600
+	ErrorCodeMethodNotFound     int64 = -32601
+	ErrorCodeInvalidParams      int64 = -32602
+	ErrorCodeJSONEncodingFailed int64 = -32603
+)
+
+// Sentinel errors that can be matched with errors.Is against errors returned by the client.
+var (
+	ErrParseError         = errors.New("quobyte: parse error")
+	ErrInvalidRequest     = errors.New("quobyte: invalid request")
+	ErrMethodNotFound     = errors.New("quobyte: method not found")
+	ErrInvalidParams      = errors.New("quobyte: invalid params")
+	ErrJSONEncodingFailed = errors.New("quobyte: json encoding failed")
+	ErrAuthentication     = errors.New("quobyte: authentication failed")
+	ErrPermissionDenied   = errors.New("quobyte: permission denied")
+	ErrNotFound           = errors.New("quobyte: not found")
+	// ErrProtocol is returned for responses that violate JSON-RPC 2.0, e.g. with a wrong id.
+	ErrProtocol = errors.New("quobyte: JSON-RPC protocol violation")
+	// ErrUnknownField is returned in strict mode for responses with fields unknown to types.go.
+	ErrUnknownField = errors.New("quobyte: unknown field in response")
+	// ErrUnknownEnumValue is returned for enum values unknown to types.go, see EnumValueError.
+	ErrUnknownEnumValue = errors.New("quobyte: unknown enum value")
+	// ErrUnsupportedByServer is returned for methods that the server version does not have, see
+	// UnsupportedError.
+	ErrUnsupportedByServer = errors.New("quobyte: method not supported by server")
+	// ErrDumpMismatch is returned if a downloaded dump does not have the expected size or
+	// checksum, see DumpOptions.
+	ErrDumpMismatch = errors.New("quobyte: dump does not match expected size or checksum")
+)
+
+var codeErrors = map[int64]error{
+	ErrorCodeParseError:         ErrParseError,
+	ErrorCodeInvalidRequest:     ErrInvalidRequest,
+	ErrorCodeMethodNotFound:     ErrMethodNotFound,
+	ErrorCodeInvalidParams:      ErrInvalidParams,
+	ErrorCodeJSONEncodingFailed: ErrJSONEncodingFailed,
+}
+
+// The API service reports missing entities and denied access only through the error message.
+var (
+	notFoundMessages         = []string{"not found", "does not exist", "no such", "unknown volume", "unknown tenant"}
+	permissionDeniedMessages = []string{"permission denied", "access denied", "not authorized", "not allowed"}
+)
+
+// RPCError is returned for every failed call that reached the Quobyte API service, either as
+// a JSON-RPC error object or as a non 2xx HTTP status.
+type RPCError struct {
+	// Method is the JSON-RPC method that failed.
+	Method string
+	// Code is the JSON-RPC error code, 0 if the call failed on HTTP level.
+	Code int64
+	// Message is the error message reported by the server.
+	Message string
+	// Data is the optional data member of the JSON-RPC error object.
+	Data json.RawMessage
+	// HTTPStatus is the HTTP status code of the response.
+	HTTPStatus int
+}
+
+func (err *RPCError) Error() string {
+	if err.Code == 0 && err.HTTPStatus != 0 && (err.HTTPStatus < 200 || err.HTTPStatus > 299) {
+		return fmt.Sprintf(httpErrorMessageFormat, err.HTTPStatus, err.Message)
+	}
+	message := err.Message
+	if message == "" {
+		message = (&rpcError{Code: err.Code}).decodeErrorCode()
+	}
+	if message == "" {
+		message = fmt.Sprintf("error code %d", err.Code)
+	}
+	if len(err.Data) > 0 && string(err.Data) != "null" {
+		message += " (data: " + string(err.Data) + ")"
+	}
+	return fmt.Sprintf(errorMessageFormat, err.Method, message)
+}
+
+// DecodeData decodes the data member of the JSON-RPC error object into v.
+func (err *RPCError) DecodeData(v interface{}) error {
+	if len(err.Data) == 0 {
+		return fmt.Errorf("%s has no data", err.Method)
+	}
+	return json.Unmarshal(err.Data, v)
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package.
+func (err *RPCError) Is(target error) bool {
+	switch target {
+	case ErrAuthentication:
+		return err.HTTPStatus == http.StatusUnauthorized
+	case ErrPermissionDenied:
+		return err.HTTPStatus == http.StatusForbidden ||
+			(err.isApplicationError() && messageContains(err.Message, permissionDeniedMessages))
+	case ErrNotFound:
+		return err.HTTPStatus == http.StatusNotFound ||
+			(err.isApplicationError() && messageContains(err.Message, notFoundMessages))
+	}
+	sentinel, ok := codeErrors[err.Code]
+	return ok && sentinel == target
+}
+
+// isApplicationError returns false for errors of the JSON-RPC protocol itself, e.g. "Method not
+// found", whose messages must not be read as messages of the API service.
+func (err *RPCError) isApplicationError() bool {
+	switch err.Code {
+	case ErrorCodeParseError, ErrorCodeInvalidRequest, ErrorCodeMethodNotFound:
+		return false
+	}
+	return true
+}
+
+func messageContains(message string, fragments []string) bool {
+	message = strings.ToLower(message)
+	for _, fragment := range fragments {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
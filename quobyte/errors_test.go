@@ -0,0 +1,120 @@
+package quobyte
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDecodeResponseReturnsRPCError(t *testing.T) {
+	byt := json.RawMessage(`{"code":-32601,"message":"","data":{"method":"fooBar"}}`)
+	res, _ := json.Marshal(&response{ID: "0", Version: "2.0", Error: &byt})
+
+	err := decodeResponse("fooBar", bytes.NewReader(res), &CreateVolumeResponse{})
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		t.Fatalf("Expected *RPCError, got %T: %v", err, err)
+	}
+	if rpcErr.Method != "fooBar" || rpcErr.Code != ErrorCodeMethodNotFound {
+		t.Fatalf("Unexpected error fields: %+v", rpcErr)
+	}
+	if string(rpcErr.Data) != `{"method":"fooBar"}` {
+		t.Fatalf("Unexpected error data: %s", rpcErr.Data)
+	}
+	if !errors.Is(err, ErrMethodNotFound) {
+		t.Fatalf("Expected error to match ErrMethodNotFound: %v", err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Fatalf("Unexpected match of ErrNotFound: %v", err)
+	}
+}
+
+type rpcErrorIsTest struct {
+	err      *RPCError
+	target   error
+	expected bool
+}
+
+func TestRPCErrorIs(t *testing.T) {
+	tests := []*rpcErrorIsTest{
+		{err: &RPCError{Code: ErrorCodeInvalidRequest}, target: ErrInvalidRequest, expected: true},
+		{err: &RPCError{Code: ErrorCodeParseError}, target: ErrParseError, expected: true},
+		{err: &RPCError{Code: ErrorCodeInvalidParams}, target: ErrInvalidParams, expected: true},
+		{err: &RPCError{Code: ErrorCodeJSONEncodingFailed}, target: ErrJSONEncodingFailed, expected: true},
+		{err: &RPCError{Code: ErrorCodeInvalidRequest}, target: ErrMethodNotFound, expected: false},
+		{err: &RPCError{HTTPStatus: 401}, target: ErrAuthentication, expected: true},
+		{err: &RPCError{HTTPStatus: 403}, target: ErrPermissionDenied, expected: true},
+		{err: &RPCError{HTTPStatus: 500}, target: ErrAuthentication, expected: false},
+		{err: &RPCError{Message: "Volume foo does not exist"}, target: ErrNotFound, expected: true},
+		{err: &RPCError{Message: "Permission denied for user bar"}, target: ErrPermissionDenied, expected: true},
+		{err: &RPCError{Message: "Permission denied for user bar"}, target: ErrNotFound, expected: false},
+		{err: &RPCError{Code: ErrorCodeMethodNotFound, Message: "Method getFoo not found"}, target: ErrNotFound, expected: false},
+		{err: &RPCError{Code: ErrorCodeMethodNotFound, Message: "Method getFoo not found"}, target: ErrMethodNotFound, expected: true},
+	}
+
+	for _, test := range tests {
+		if got := errors.Is(test.err, test.target); got != test.expected {
+			t.Errorf("errors.Is(%+v, %v): got %v, want %v", test.err, test.target, got, test.expected)
+		}
+	}
+}
+
+func TestRPCErrorMessage(t *testing.T) {
+	err := &RPCError{Method: "getTenant", Code: -1}
+	if got, want := err.Error(), "method: getTenant error message: error code -1"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	err = &RPCError{Method: "getTenant", HTTPStatus: 502, Message: "Bad Gateway"}
+	if got, want := err.Error(), "JsonRPC failed with error (error code: 502) Bad Gateway"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestSendRequestAuthenticationError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer srv.Close()
+
+	client := NewQuobyteClient(srv.URL, "user", "wrong")
+	_, err := client.GetTenant(&GetTenantRequest{})
+	if !errors.Is(err, ErrAuthentication) {
+		t.Fatalf("Expected error to match ErrAuthentication, got %v", err)
+	}
+}
+
+func TestResolveVolumeNameToUUIDNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write(rpcResponse(req, `{"error":{"code":-32000,"message":"Volume vol in tenant test does not exist"}}`))
+	}))
+	defer srv.Close()
+
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	_, err := client.ResolveVolumeNameToUUID("vol", "test")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected error to match ErrNotFound, got %v", err)
+	}
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Method != "resolveVolumeName" {
+		t.Fatalf("Expected wrapped *RPCError, got %v", err)
+	}
+}
+
+func TestResolveVolumeNameToUUIDMethodNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write(rpcResponse(req, `{"error":{"code":-32601,"message":"Method resolveVolumeName not found"}}`))
+	}))
+	defer srv.Close()
+
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	_, err := client.ResolveVolumeNameToUUID("vol", "test")
+	if !errors.Is(err, ErrMethodNotFound) {
+		t.Fatalf("Expected error to match ErrMethodNotFound, got %v", err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("Missing method must not match ErrNotFound: %v", err)
+	}
+}
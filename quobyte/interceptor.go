@@ -0,0 +1,59 @@
+package quobyte
+
+import (
+	"context"
+	"net/http"
+)
+
+// Invoker sends a JSON-RPC call to the API service and decodes the result into response.
+type Invoker func(ctx context.Context, method string, request interface{}, response interface{}) error
+
+// Interceptor wraps every call of a client. It receives the JSON-RPC method, the typed request
+// (e.g. *CreateVolumeRequest) and the typed response to decode into (e.g. *CreateVolumeResponse,
+// nil for calls without result). It must call invoker to send the call and may modify ctx and
+// request before, and inspect response and the error after it. Returning without calling invoker
+// short-circuits the call.
+// Batches are intercepted once as a call of BatchMethod with the *Batch as request.
+type Interceptor func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error
+
+// AddInterceptor appends interceptors to the chain of the client. The first interceptor added is
+// the outermost one.
+func (client *QuobyteClient) AddInterceptor(interceptors ...Interceptor) {
+	client.interceptors = append(client.interceptors, interceptors...)
+}
+
+// WithInterceptors adds interceptors to the chain of the client, see AddInterceptor.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(opts *clientOptions) error {
+		opts.interceptors = append(opts.interceptors, interceptors...)
+		return nil
+	}
+}
+
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, method string, request interface{}, response interface{}) error {
+			return interceptor(ctx, method, request, response, next)
+		}
+	}
+	return invoker
+}
+
+type headerKey struct{}
+
+// WithHeader returns a context that adds the header to the HTTP requests of calls made with it.
+// Interceptors can use it to add e.g. tracing headers.
+func WithHeader(ctx context.Context, key, value string) context.Context {
+	header := http.Header{}
+	if parent, ok := ctx.Value(headerKey{}).(http.Header); ok {
+		header = parent.Clone()
+	}
+	header.Add(key, value)
+	return context.WithValue(ctx, headerKey{}, header)
+}
+
+func headerFrom(ctx context.Context) http.Header {
+	header, _ := ctx.Value(headerKey{}).(http.Header)
+	return header
+}
@@ -0,0 +1,105 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestInterceptorChain(t *testing.T) {
+	var header string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		header = req.Header.Get("X-Trace-Id")
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		w.Write(rpcResponse(req, `{"result":{"volume_uuid":"1234"}}`))
+	}))
+	defer srv.Close()
+
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+			calls = append(calls, name+" before "+method)
+			err := invoker(ctx, method, request, response)
+			calls = append(calls, name+" after "+response.(*CreateVolumeResponse).VolumeUuid)
+			return err
+		}
+	}
+	tracing := func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+		return invoker(WithHeader(ctx, "X-Trace-Id", "abc"), method, request, response)
+	}
+	defaultTenant := func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+		if req, ok := request.(*CreateVolumeRequest); ok && req.TenantId == "" {
+			req.TenantId = "default"
+		}
+		return invoker(ctx, method, request, response)
+	}
+
+	client, err := NewClient(srv.URL, WithInterceptors(record("outer"), tracing))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AddInterceptor(defaultTenant, record("inner"))
+
+	request := &CreateVolumeRequest{Name: "vol"}
+	if _, err := client.CreateVolume(request); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"outer before createVolume", "inner before createVolume", "inner after 1234", "outer after 1234"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Unexpected calls: got %v, want %v", calls, expected)
+	}
+	if header != "abc" {
+		t.Errorf("Expected header from interceptor, got %q", header)
+	}
+	if request.TenantId != "default" {
+		t.Errorf("Request was not modified by interceptor")
+	}
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	client, err := NewClient("http://quobyte-api.invalid:7860")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AddInterceptor(func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+		if method == "resolveVolumeName" {
+			response.(*ResolveVolumeNameResponse).VolumeUuid = "cached"
+			return nil
+		}
+		return errors.New("blocked")
+	})
+
+	uuid, err := client.ResolveVolumeNameToUUID("vol", "tenant")
+	if err != nil || uuid != "cached" {
+		t.Errorf("Unexpected result: %s, %v", uuid, err)
+	}
+	if _, err := client.EraseVolume(&EraseVolumeRequest{VolumeUuid: "c858ffe2-4fa1-4c78-adbf-54c211734883"}); err == nil || err.Error() != "blocked" {
+		t.Errorf("Expected error from interceptor, got %v", err)
+	}
+}
+
+func TestInterceptorSeesError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write(rpcResponse(req, `{"error":{"code":-32601,"message":""}}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seen error
+	client.AddInterceptor(func(ctx context.Context, method string, request interface{}, response interface{}, invoker Invoker) error {
+		seen = invoker(ctx, method, request, response)
+		return seen
+	})
+	if _, err := client.GetTenant(&GetTenantRequest{}); !errors.Is(err, ErrMethodNotFound) {
+		t.Fatalf("Expected ErrMethodNotFound, got %v", err)
+	}
+	if !errors.Is(seen, ErrMethodNotFound) {
+		t.Errorf("Interceptor did not see the error, got %v", seen)
+	}
+}
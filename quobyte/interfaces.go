@@ -0,0 +1,337 @@
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
+
+package quobyte
+
+import "context"
+
+// VolumeAPI manages volumes and queries their files.
+type VolumeAPI interface {
+	AnalyzeVolumes(request *AnalyzeVolumesRequest) (result *AnalyzeVolumesResponse, err error)
+	AnalyzeVolumesContext(ctx context.Context, request *AnalyzeVolumesRequest) (result *AnalyzeVolumesResponse, err error)
+	CancelQuery(request *CancelQueryRequest) (result *CancelQueryResponse, err error)
+	CancelQueryContext(ctx context.Context, request *CancelQueryRequest) (result *CancelQueryResponse, err error)
+	CancelVolumeErasure(request *CancelVolumeErasureRequest) (result *CancelVolumeErasureResponse, err error)
+	CancelVolumeErasureContext(ctx context.Context, request *CancelVolumeErasureRequest) (result *CancelVolumeErasureResponse, err error)
+	CreateMirroredVolume(request *CreateMirroredVolumeRequest) (result *CreateMirroredVolumeResponse, err error)
+	CreateMirroredVolumeContext(ctx context.Context, request *CreateMirroredVolumeRequest) (result *CreateMirroredVolumeResponse, err error)
+	CreateVolume(request *CreateVolumeRequest) (result *CreateVolumeResponse, err error)
+	CreateVolumeContext(ctx context.Context, request *CreateVolumeRequest) (result *CreateVolumeResponse, err error)
+	DeleteVolume(request *DeleteVolumeRequest) (result *DeleteVolumeResponse, err error)
+	DeleteVolumeContext(ctx context.Context, request *DeleteVolumeRequest) (result *DeleteVolumeResponse, err error)
+	DisconnectMirroredVolume(request *DisconnectMirroredVolumeRequest) (result *DisconnectMirroredVolumeResponse, err error)
+	DisconnectMirroredVolumeContext(ctx context.Context, request *DisconnectMirroredVolumeRequest) (result *DisconnectMirroredVolumeResponse, err error)
+	EraseVolume(request *EraseVolumeRequest) (result *EraseVolumeResponse, err error)
+	EraseVolumeContext(ctx context.Context, request *EraseVolumeRequest) (result *EraseVolumeResponse, err error)
+	ExportVolume(request *ExportVolumeRequest) (result *ExportVolumeResponse, err error)
+	ExportVolumeContext(ctx context.Context, request *ExportVolumeRequest) (result *ExportVolumeResponse, err error)
+	GetAnalyzeReports(request *GetAnalyzeReportsRequest) (result *GetAnalyzeReportsResponse, err error)
+	GetAnalyzeReportsContext(ctx context.Context, request *GetAnalyzeReportsRequest) (result *GetAnalyzeReportsResponse, err error)
+	GetEffectiveVolumeConfiguration(request *GetEffectiveVolumeConfigurationRequest) (result *GetEffectiveVolumeConfigurationResponse, err error)
+	GetEffectiveVolumeConfigurationContext(ctx context.Context, request *GetEffectiveVolumeConfigurationRequest) (result *GetEffectiveVolumeConfigurationResponse, err error)
+	GetFileMetadataDump(request *GetFileMetadataDumpRequest) (result *GetFileMetadataDumpResponse, err error)
+	GetFileMetadataDumpContext(ctx context.Context, request *GetFileMetadataDumpRequest) (result *GetFileMetadataDumpResponse, err error)
+	GetQueryProgress(request *GetQueryProgressRequest) (result *GetQueryProgressResponse, err error)
+	GetQueryProgressContext(ctx context.Context, request *GetQueryProgressRequest) (result *GetQueryProgressResponse, err error)
+	GetVolumeList(request *GetVolumeListRequest) (result *GetVolumeListResponse, err error)
+	GetVolumeListContext(ctx context.Context, request *GetVolumeListRequest) (result *GetVolumeListResponse, err error)
+	PublishBucketVolume(request *PublishBucketVolumeRequest) (result *PublishBucketVolumeResponse, err error)
+	PublishBucketVolumeContext(ctx context.Context, request *PublishBucketVolumeRequest) (result *PublishBucketVolumeResponse, err error)
+	QueryFiles(request *QueryFilesRequest) (result *QueryFilesResponse, err error)
+	QueryFilesContext(ctx context.Context, request *QueryFilesRequest) (result *QueryFilesResponse, err error)
+	ResolveGlobalFileId(request *ResolveGlobalFileIdRequest) (result *ResolveGlobalFileIdResponse, err error)
+	ResolveGlobalFileIdContext(ctx context.Context, request *ResolveGlobalFileIdRequest) (result *ResolveGlobalFileIdResponse, err error)
+	ResolveVolumeName(request *ResolveVolumeNameRequest) (result *ResolveVolumeNameResponse, err error)
+	ResolveVolumeNameContext(ctx context.Context, request *ResolveVolumeNameRequest) (result *ResolveVolumeNameResponse, err error)
+	TriggerVolumeCheckpoint(request *TriggerVolumeCheckpointRequest) (result *TriggerVolumeCheckpointResponse, err error)
+	TriggerVolumeCheckpointContext(ctx context.Context, request *TriggerVolumeCheckpointRequest) (result *TriggerVolumeCheckpointResponse, err error)
+	UnpublishBucketVolume(request *UnpublishBucketVolumeRequest) (result *UnpublishBucketVolumeResponse, err error)
+	UnpublishBucketVolumeContext(ctx context.Context, request *UnpublishBucketVolumeRequest) (result *UnpublishBucketVolumeResponse, err error)
+	UpdateVolume(request *UpdateVolumeRequest) (result *UpdateVolumeResponse, err error)
+	UpdateVolumeContext(ctx context.Context, request *UpdateVolumeRequest) (result *UpdateVolumeResponse, err error)
+}
+
+// SnapshotAPI manages volume snapshots.
+type SnapshotAPI interface {
+	CreateSnapshot(request *CreateSnapshotRequest) (result *CreateSnapshotResponse, err error)
+	CreateSnapshotContext(ctx context.Context, request *CreateSnapshotRequest) (result *CreateSnapshotResponse, err error)
+	DeleteSnapshot(request *DeleteSnapshotRequest) (result *DeleteSnapshotResponse, err error)
+	DeleteSnapshotContext(ctx context.Context, request *DeleteSnapshotRequest) (result *DeleteSnapshotResponse, err error)
+	EraseSnapshot(request *EraseSnapshotRequest) (result *EraseSnapshotResponse, err error)
+	EraseSnapshotContext(ctx context.Context, request *EraseSnapshotRequest) (result *EraseSnapshotResponse, err error)
+	ListSnapshots(request *ListSnapshotsRequest) (result *ListSnapshotsResponse, err error)
+	ListSnapshotsContext(ctx context.Context, request *ListSnapshotsRequest) (result *ListSnapshotsResponse, err error)
+}
+
+// TenantAPI manages tenants.
+type TenantAPI interface {
+	DeleteTenant(request *DeleteTenantRequest) (result *DeleteTenantResponse, err error)
+	DeleteTenantContext(ctx context.Context, request *DeleteTenantRequest) (result *DeleteTenantResponse, err error)
+	GetTenant(request *GetTenantRequest) (result *GetTenantResponse, err error)
+	GetTenantContext(ctx context.Context, request *GetTenantRequest) (result *GetTenantResponse, err error)
+	ResolveTenantName(request *ResolveTenantNameRequest) (result *ResolveTenantNameResponse, err error)
+	ResolveTenantNameContext(ctx context.Context, request *ResolveTenantNameRequest) (result *ResolveTenantNameResponse, err error)
+	SetTenant(request *SetTenantRequest) (result *SetTenantResponse, err error)
+	SetTenantContext(ctx context.Context, request *SetTenantRequest) (result *SetTenantResponse, err error)
+}
+
+// QuotaAPI manages quotas and reports resource usage.
+type QuotaAPI interface {
+	GetAccounting(request *GetAccountingRequest) (result *GetAccountingResponse, err error)
+	GetAccountingContext(ctx context.Context, request *GetAccountingRequest) (result *GetAccountingResponse, err error)
+	GetQuota(request *GetQuotaRequest) (result *GetQuotaResponse, err error)
+	GetQuotaContext(ctx context.Context, request *GetQuotaRequest) (result *GetQuotaResponse, err error)
+	GetTopCapacityConsumer(request *GetTopCapacityConsumerRequest) (result *GetTopCapacityConsumerResponse, err error)
+	GetTopCapacityConsumerContext(ctx context.Context, request *GetTopCapacityConsumerRequest) (result *GetTopCapacityConsumerResponse, err error)
+	SetQuota(request *SetQuotaRequest) (result *SetQuotaResponse, err error)
+	SetQuotaContext(ctx context.Context, request *SetQuotaRequest) (result *SetQuotaResponse, err error)
+}
+
+// LabelAPI manages labels of volumes and tenants.
+type LabelAPI interface {
+	DeleteLabels(request *DeleteLabelsRequest) (result *DeleteLabelsResponse, err error)
+	DeleteLabelsContext(ctx context.Context, request *DeleteLabelsRequest) (result *DeleteLabelsResponse, err error)
+	GetLabels(request *GetLabelsRequest) (result *GetLabelsResponse, err error)
+	GetLabelsContext(ctx context.Context, request *GetLabelsRequest) (result *GetLabelsResponse, err error)
+	SetLabels(request *SetLabelsRequest) (result *SetLabelsResponse, err error)
+	SetLabelsContext(ctx context.Context, request *SetLabelsRequest) (result *SetLabelsResponse, err error)
+}
+
+// TaskAPI manages cluster tasks.
+type TaskAPI interface {
+	CancelTask(request *CancelTaskRequest) (result *CancelTaskResponse, err error)
+	CancelTaskContext(ctx context.Context, request *CancelTaskRequest) (result *CancelTaskResponse, err error)
+	CreateTask(request *CreateTaskRequest) (result *CreateTaskResponse, err error)
+	CreateTaskContext(ctx context.Context, request *CreateTaskRequest) (result *CreateTaskResponse, err error)
+	GetTaskList(request *GetTaskListRequest) (result *GetTaskListResponse, err error)
+	GetTaskListContext(ctx context.Context, request *GetTaskListRequest) (result *GetTaskListResponse, err error)
+	ResumeTask(request *ResumeTaskRequest) (result *ResumeTaskResponse, err error)
+	ResumeTaskContext(ctx context.Context, request *ResumeTaskRequest) (result *ResumeTaskResponse, err error)
+	RetryTask(request *RetryTaskRequest) (result *RetryTaskResponse, err error)
+	RetryTaskContext(ctx context.Context, request *RetryTaskRequest) (result *RetryTaskResponse, err error)
+}
+
+// DeviceAPI manages devices.
+type DeviceAPI interface {
+	GetDeviceGroups(request *GetDeviceGroupsRequest) (result *GetDeviceGroupsResponse, err error)
+	GetDeviceGroupsContext(ctx context.Context, request *GetDeviceGroupsRequest) (result *GetDeviceGroupsResponse, err error)
+	GetDeviceIds(request *GetDeviceIdsRequest) (result *GetDeviceIdsResponse, err error)
+	GetDeviceIdsContext(ctx context.Context, request *GetDeviceIdsRequest) (result *GetDeviceIdsResponse, err error)
+	GetDeviceList(request *GetDeviceListRequest) (result *GetDeviceListResponse, err error)
+	GetDeviceListContext(ctx context.Context, request *GetDeviceListRequest) (result *GetDeviceListResponse, err error)
+	GetDeviceNetworkEndpoints(request *GetDeviceNetworkEndpointsRequest) (result *GetDeviceNetworkEndpointsResponse, err error)
+	GetDeviceNetworkEndpointsContext(ctx context.Context, request *GetDeviceNetworkEndpointsRequest) (result *GetDeviceNetworkEndpointsResponse, err error)
+	GetDeviceTags(request *GetDeviceTagsRequest) (result *GetDeviceTagsResponse, err error)
+	GetDeviceTagsContext(ctx context.Context, request *GetDeviceTagsRequest) (result *GetDeviceTagsResponse, err error)
+	GetUnformattedDevices(request *GetUnformattedDevicesRequest) (result *GetUnformattedDevicesResponse, err error)
+	GetUnformattedDevicesContext(ctx context.Context, request *GetUnformattedDevicesRequest) (result *GetUnformattedDevicesResponse, err error)
+	MakeDevice(request *MakeDeviceRequest) (result *MakeDeviceResponse, err error)
+	MakeDeviceContext(ctx context.Context, request *MakeDeviceRequest) (result *MakeDeviceResponse, err error)
+	RegenerateDatabase(request *RegenerateDatabaseRequest) (result *RegenerateDatabaseResponse, err error)
+	RegenerateDatabaseContext(ctx context.Context, request *RegenerateDatabaseRequest) (result *RegenerateDatabaseResponse, err error)
+	UpdateDevice(request *UpdateDeviceRequest) (result *UpdateDeviceResponse, err error)
+	UpdateDeviceContext(ctx context.Context, request *UpdateDeviceRequest) (result *UpdateDeviceResponse, err error)
+}
+
+// PolicyAPI manages policy rules and presets.
+type PolicyAPI interface {
+	ChangePolicyRulePriority(request *ChangePolicyRulePriorityRequest) (result *ChangePolicyRulePriorityResponse, err error)
+	ChangePolicyRulePriorityContext(ctx context.Context, request *ChangePolicyRulePriorityRequest) (result *ChangePolicyRulePriorityResponse, err error)
+	CreatePolicyRule(request *CreatePolicyRuleRequest) (result *CreatePolicyRuleResponse, err error)
+	CreatePolicyRuleContext(ctx context.Context, request *CreatePolicyRuleRequest) (result *CreatePolicyRuleResponse, err error)
+	CreatePolicyRuleSet(request *CreatePolicyRuleSetRequest) (result *CreatePolicyRuleSetResponse, err error)
+	CreatePolicyRuleSetContext(ctx context.Context, request *CreatePolicyRuleSetRequest) (result *CreatePolicyRuleSetResponse, err error)
+	DeletePolicyRules(request *DeletePolicyRulesRequest) (result *DeletePolicyRulesResponse, err error)
+	DeletePolicyRulesContext(ctx context.Context, request *DeletePolicyRulesRequest) (result *DeletePolicyRulesResponse, err error)
+	DumpEffectivePolicyRules(request *DumpEffectivePolicyRulesRequest) (result *DumpEffectivePolicyRulesResponse, err error)
+	DumpEffectivePolicyRulesContext(ctx context.Context, request *DumpEffectivePolicyRulesRequest) (result *DumpEffectivePolicyRulesResponse, err error)
+	DumpPolicyPresets(request *DumpPolicyPresetsRequest) (result *DumpPolicyPresetsResponse, err error)
+	DumpPolicyPresetsContext(ctx context.Context, request *DumpPolicyPresetsRequest) (result *DumpPolicyPresetsResponse, err error)
+	ExportPolicyRules(request *ExportPolicyRulesRequest) (result *ExportPolicyRulesResponse, err error)
+	ExportPolicyRulesContext(ctx context.Context, request *ExportPolicyRulesRequest) (result *ExportPolicyRulesResponse, err error)
+	FilterPolicyRules(request *FilterPolicyRulesRequest) (result *FilterPolicyRulesResponse, err error)
+	FilterPolicyRulesContext(ctx context.Context, request *FilterPolicyRulesRequest) (result *FilterPolicyRulesResponse, err error)
+	GetPolicyPresets(request *GetPolicyPresetsRequest) (result *GetPolicyPresetsResponse, err error)
+	GetPolicyPresetsContext(ctx context.Context, request *GetPolicyPresetsRequest) (result *GetPolicyPresetsResponse, err error)
+	GetPolicyRuleSets(request *GetPolicyRuleSetsRequest) (result *GetPolicyRuleSetsResponse, err error)
+	GetPolicyRuleSetsContext(ctx context.Context, request *GetPolicyRuleSetsRequest) (result *GetPolicyRuleSetsResponse, err error)
+	GetPolicyRules(request *GetPolicyRulesRequest) (result *GetPolicyRulesResponse, err error)
+	GetPolicyRulesContext(ctx context.Context, request *GetPolicyRulesRequest) (result *GetPolicyRulesResponse, err error)
+	ImportPolicyRules(request *ImportPolicyRulesRequest) (result *ImportPolicyRulesResponse, err error)
+	ImportPolicyRulesContext(ctx context.Context, request *ImportPolicyRulesRequest) (result *ImportPolicyRulesResponse, err error)
+	ResolvePolicyRuleName(request *ResolvePolicyRuleNameRequest) (result *ResolvePolicyRuleNameResponse, err error)
+	ResolvePolicyRuleNameContext(ctx context.Context, request *ResolvePolicyRuleNameRequest) (result *ResolvePolicyRuleNameResponse, err error)
+	UpdatePolicyRules(request *UpdatePolicyRulesRequest) (result *UpdatePolicyRulesResponse, err error)
+	UpdatePolicyRulesContext(ctx context.Context, request *UpdatePolicyRulesRequest) (result *UpdatePolicyRulesResponse, err error)
+}
+
+// ConfigurationAPI manages configurations.
+type ConfigurationAPI interface {
+	DeleteConfiguration(request *DeleteConfigurationRequest) (result *DeleteConfigurationResponse, err error)
+	DeleteConfigurationContext(ctx context.Context, request *DeleteConfigurationRequest) (result *DeleteConfigurationResponse, err error)
+	ExportConfiguration(request *ExportConfigurationRequest) (result *ExportConfigurationResponse, err error)
+	ExportConfigurationContext(ctx context.Context, request *ExportConfigurationRequest) (result *ExportConfigurationResponse, err error)
+	GetConfiguration(request *GetConfigurationRequest) (result *GetConfigurationResponse, err error)
+	GetConfigurationContext(ctx context.Context, request *GetConfigurationRequest) (result *GetConfigurationResponse, err error)
+	ImportConfiguration(request *ImportConfigurationRequest) (result *ImportConfigurationResponse, err error)
+	ImportConfigurationContext(ctx context.Context, request *ImportConfigurationRequest) (result *ImportConfigurationResponse, err error)
+	SetConfiguration(request *SetConfigurationRequest) (result *SetConfigurationResponse, err error)
+	SetConfigurationContext(ctx context.Context, request *SetConfigurationRequest) (result *SetConfigurationResponse, err error)
+}
+
+// SecurityAPI manages certificates, keystores and volume encryption keys.
+type SecurityAPI interface {
+	AddCa(request *AddCaRequest) (result *AddCaResponse, err error)
+	AddCaContext(ctx context.Context, request *AddCaRequest) (result *AddCaResponse, err error)
+	AddCertificate(request *AddCertificateRequest) (result *AddCertificateResponse, err error)
+	AddCertificateContext(ctx context.Context, request *AddCertificateRequest) (result *AddCertificateResponse, err error)
+	AddCsr(request *AddCsrRequest) (result *AddCsrResponse, err error)
+	AddCsrContext(ctx context.Context, request *AddCsrRequest) (result *AddCsrResponse, err error)
+	CreateMasterKeystoreSlot(request *CreateMasterKeystoreSlotRequest) (result *CreateMasterKeystoreSlotResponse, err error)
+	CreateMasterKeystoreSlotContext(ctx context.Context, request *CreateMasterKeystoreSlotRequest) (result *CreateMasterKeystoreSlotResponse, err error)
+	CreateNewUserKeystoreSlot(request *CreateNewUserKeystoreSlotRequest) (result *CreateNewUserKeystoreSlotResponse, err error)
+	CreateNewUserKeystoreSlotContext(ctx context.Context, request *CreateNewUserKeystoreSlotRequest) (result *CreateNewUserKeystoreSlotResponse, err error)
+	DecideCsr(request *DecideCsrRequest) (result *DecideCsrResponse, err error)
+	DecideCsrContext(ctx context.Context, request *DecideCsrRequest) (result *DecideCsrResponse, err error)
+	DeleteCa(request *DeleteCaRequest) (result *DeleteCaResponse, err error)
+	DeleteCaContext(ctx context.Context, request *DeleteCaRequest) (result *DeleteCaResponse, err error)
+	DeleteCertificate(request *DeleteCertificateRequest) (result *DeleteCertificateResponse, err error)
+	DeleteCertificateContext(ctx context.Context, request *DeleteCertificateRequest) (result *DeleteCertificateResponse, err error)
+	DeleteCsr(request *DeleteCsrRequest) (result *DeleteCsrResponse, err error)
+	DeleteCsrContext(ctx context.Context, request *DeleteCsrRequest) (result *DeleteCsrResponse, err error)
+	ExportCertificate(request *ExportCertificateRequest) (result *ExportCertificateResponse, err error)
+	ExportCertificateContext(ctx context.Context, request *ExportCertificateRequest) (result *ExportCertificateResponse, err error)
+	GetAddKeySlotData(request *GetAddKeySlotDataRequest) (result *GetAddKeySlotDataResponse, err error)
+	GetAddKeySlotDataContext(ctx context.Context, request *GetAddKeySlotDataRequest) (result *GetAddKeySlotDataResponse, err error)
+	GetCertificateSubject(request *GetCertificateSubjectRequest) (result *GetCertificateSubjectResponse, err error)
+	GetCertificateSubjectContext(ctx context.Context, request *GetCertificateSubjectRequest) (result *GetCertificateSubjectResponse, err error)
+	GetDefaultKeyStoreSlotParams(request *GetDefaultKeyStoreSlotParamsRequest) (result *GetDefaultKeyStoreSlotParamsResponse, err error)
+	GetDefaultKeyStoreSlotParamsContext(ctx context.Context, request *GetDefaultKeyStoreSlotParamsRequest) (result *GetDefaultKeyStoreSlotParamsResponse, err error)
+	GetEncryptStatus(request *GetEncryptStatusRequest) (result *GetEncryptStatusResponse, err error)
+	GetEncryptStatusContext(ctx context.Context, request *GetEncryptStatusRequest) (result *GetEncryptStatusResponse, err error)
+	GetEncryptedVolumeKey(request *GetEncryptedVolumeKeyRequest) (result *GetEncryptedVolumeKeyResponse, err error)
+	GetEncryptedVolumeKeyContext(ctx context.Context, request *GetEncryptedVolumeKeyRequest) (result *GetEncryptedVolumeKeyResponse, err error)
+	GetKeyStoreSlotWithoutHash(request *GetKeyStoreSlotWithoutHashRequest) (result *GetKeyStoreSlotWithoutHashResponse, err error)
+	GetKeyStoreSlotWithoutHashContext(ctx context.Context, request *GetKeyStoreSlotWithoutHashRequest) (result *GetKeyStoreSlotWithoutHashResponse, err error)
+	GetMasterKeystoreSlots(request *GetMasterKeystoreSlotsRequest) (result *GetMasterKeystoreSlotsResponse, err error)
+	GetMasterKeystoreSlotsContext(ctx context.Context, request *GetMasterKeystoreSlotsRequest) (result *GetMasterKeystoreSlotsResponse, err error)
+	ListCa(request *ListCaRequest) (result *ListCaResponse, err error)
+	ListCaContext(ctx context.Context, request *ListCaRequest) (result *ListCaResponse, err error)
+	ListCertificates(request *ListCertificatesRequest) (result *ListCertificatesResponse, err error)
+	ListCertificatesContext(ctx context.Context, request *ListCertificatesRequest) (result *ListCertificatesResponse, err error)
+	ListCsr(request *ListCsrRequest) (result *ListCsrResponse, err error)
+	ListCsrContext(ctx context.Context, request *ListCsrRequest) (result *ListCsrResponse, err error)
+	RemoveKeystoreSlot(request *RemoveKeystoreSlotRequest) (result *RemoveKeystoreSlotResponse, err error)
+	RemoveKeystoreSlotContext(ctx context.Context, request *RemoveKeystoreSlotRequest) (result *RemoveKeystoreSlotResponse, err error)
+	RemoveMasterKeystoreSlot(request *RemoveMasterKeystoreSlotRequest) (result *RemoveMasterKeystoreSlotResponse, err error)
+	RemoveMasterKeystoreSlotContext(ctx context.Context, request *RemoveMasterKeystoreSlotRequest) (result *RemoveMasterKeystoreSlotResponse, err error)
+	RevokeCertificate(request *RevokeCertificateRequest) (result *RevokeCertificateResponse, err error)
+	RevokeCertificateContext(ctx context.Context, request *RevokeCertificateRequest) (result *RevokeCertificateResponse, err error)
+	SetCertificateOwner(request *SetCertificateOwnerRequest) (result *SetCertificateOwnerResponse, err error)
+	SetCertificateOwnerContext(ctx context.Context, request *SetCertificateOwnerRequest) (result *SetCertificateOwnerResponse, err error)
+	SetCertificateSubject(request *SetCertificateSubjectRequest) (result *SetCertificateSubjectResponse, err error)
+	SetCertificateSubjectContext(ctx context.Context, request *SetCertificateSubjectRequest) (result *SetCertificateSubjectResponse, err error)
+	SetEncryptedVolumeKey(request *SetEncryptedVolumeKeyRequest) (result *SetEncryptedVolumeKeyResponse, err error)
+	SetEncryptedVolumeKeyContext(ctx context.Context, request *SetEncryptedVolumeKeyRequest) (result *SetEncryptedVolumeKeyResponse, err error)
+	UnlockMasterKeystoreSlot(request *UnlockMasterKeystoreSlotRequest) (result *UnlockMasterKeystoreSlotResponse, err error)
+	UnlockMasterKeystoreSlotContext(ctx context.Context, request *UnlockMasterKeystoreSlotRequest) (result *UnlockMasterKeystoreSlotResponse, err error)
+}
+
+// UserAPI manages users and their access keys.
+type UserAPI interface {
+	CreateAccessKeyCredentials(request *CreateAccessKeyCredentialsRequest) (result *CreateAccessKeyCredentialsResponse, err error)
+	CreateAccessKeyCredentialsContext(ctx context.Context, request *CreateAccessKeyCredentialsRequest) (result *CreateAccessKeyCredentialsResponse, err error)
+	CreateUser(request *CreateUserRequest) (result *CreateUserResponse, err error)
+	CreateUserContext(ctx context.Context, request *CreateUserRequest) (result *CreateUserResponse, err error)
+	DeleteAccessKeyCredentials(request *DeleteAccessKeyCredentialsRequest) (result *DeleteAccessKeyCredentialsResponse, err error)
+	DeleteAccessKeyCredentialsContext(ctx context.Context, request *DeleteAccessKeyCredentialsRequest) (result *DeleteAccessKeyCredentialsResponse, err error)
+	DeleteUser(request *DeleteUserRequest) (result *DeleteUserResponse, err error)
+	DeleteUserContext(ctx context.Context, request *DeleteUserRequest) (result *DeleteUserResponse, err error)
+	GetUsers(request *GetUsersRequest) (result *GetUsersResponse, err error)
+	GetUsersContext(ctx context.Context, request *GetUsersRequest) (result *GetUsersResponse, err error)
+	ImportAccessKeys(request *ImportAccessKeysRequest) (result *ImportAccessKeysResponse, err error)
+	ImportAccessKeysContext(ctx context.Context, request *ImportAccessKeysRequest) (result *ImportAccessKeysResponse, err error)
+	UpdateUser(request *UpdateUserRequest) (result *UpdateUserResponse, err error)
+	UpdateUserContext(ctx context.Context, request *UpdateUserRequest) (result *UpdateUserResponse, err error)
+	WhoAmI(request *WhoAmIRequest) (result *WhoAmIResponse, err error)
+	WhoAmIContext(ctx context.Context, request *WhoAmIRequest) (result *WhoAmIResponse, err error)
+}
+
+// MonitoringAPI manages alerts, notifications and the audit log.
+type MonitoringAPI interface {
+	AcknowledgeAlert(request *AcknowledgeAlertRequest) (result *AcknowledgeAlertResponse, err error)
+	AcknowledgeAlertContext(ctx context.Context, request *AcknowledgeAlertRequest) (result *AcknowledgeAlertResponse, err error)
+	ConfigureRule(request *ConfigureRuleRequest) (result *ConfigureRuleResponse, err error)
+	ConfigureRuleContext(ctx context.Context, request *ConfigureRuleRequest) (result *ConfigureRuleResponse, err error)
+	CreateNotificationRule(request *CreateNotificationRuleRequest) (result *CreateNotificationRuleResponse, err error)
+	CreateNotificationRuleContext(ctx context.Context, request *CreateNotificationRuleRequest) (result *CreateNotificationRuleResponse, err error)
+	DeleteNotificationRule(request *DeleteNotificationRuleRequest) (result *DeleteNotificationRuleResponse, err error)
+	DeleteNotificationRuleContext(ctx context.Context, request *DeleteNotificationRuleRequest) (result *DeleteNotificationRuleResponse, err error)
+	GetAuditLog(request *GetAuditLogRequest) (result *GetAuditLogResponse, err error)
+	GetAuditLogContext(ctx context.Context, request *GetAuditLogRequest) (result *GetAuditLogResponse, err error)
+	GetFiringRules(request *GetFiringRulesRequest) (result *GetFiringRulesResponse, err error)
+	GetFiringRulesContext(ctx context.Context, request *GetFiringRulesRequest) (result *GetFiringRulesResponse, err error)
+	GetLatestEvent(request *GetLatestEventRequest) (result *GetLatestEventResponse, err error)
+	GetLatestEventContext(ctx context.Context, request *GetLatestEventRequest) (result *GetLatestEventResponse, err error)
+	GetNotificationRules(request *GetNotificationRulesRequest) (result *GetNotificationRulesResponse, err error)
+	GetNotificationRulesContext(ctx context.Context, request *GetNotificationRulesRequest) (result *GetNotificationRulesResponse, err error)
+	GetRules(request *GetRulesRequest) (result *GetRulesResponse, err error)
+	GetRulesContext(ctx context.Context, request *GetRulesRequest) (result *GetRulesResponse, err error)
+	SetNotificationRule(request *SetNotificationRuleRequest) (result *SetNotificationRuleResponse, err error)
+	SetNotificationRuleContext(ctx context.Context, request *SetNotificationRuleRequest) (result *SetNotificationRuleResponse, err error)
+	SilenceAlert(request *SilenceAlertRequest) (result *SilenceAlertResponse, err error)
+	SilenceAlertContext(ctx context.Context, request *SilenceAlertRequest) (result *SilenceAlertResponse, err error)
+}
+
+// LicenseAPI manages the license.
+type LicenseAPI interface {
+	AcceptTermsAndConditions(request *AcceptTermsAndConditionsRequest) (result *AcceptTermsAndConditionsResponse, err error)
+	AcceptTermsAndConditionsContext(ctx context.Context, request *AcceptTermsAndConditionsRequest) (result *AcceptTermsAndConditionsResponse, err error)
+	GetLicense(request *GetLicenseRequest) (result *GetLicenseResponse, err error)
+	GetLicenseContext(ctx context.Context, request *GetLicenseRequest) (result *GetLicenseResponse, err error)
+	SetLicenseKey(request *SetLicenseKeyRequest) (result *SetLicenseKeyResponse, err error)
+	SetLicenseKeyContext(ctx context.Context, request *SetLicenseKeyRequest) (result *SetLicenseKeyResponse, err error)
+	VerifyLicense(request *VerifyLicenseRequest) (result *VerifyLicenseResponse, err error)
+	VerifyLicenseContext(ctx context.Context, request *VerifyLicenseRequest) (result *VerifyLicenseResponse, err error)
+}
+
+// ClusterAPI manages services and registry replicas and reports the state of the cluster.
+type ClusterAPI interface {
+	AddRegistryReplica(request *AddRegistryReplicaRequest) (result *AddRegistryReplicaResponse, err error)
+	AddRegistryReplicaContext(ctx context.Context, request *AddRegistryReplicaRequest) (result *AddRegistryReplicaResponse, err error)
+	CancelNetworkTest(request *CancelNetworkTestRequest) (result *CancelNetworkTestResponse, err error)
+	CancelNetworkTestContext(ctx context.Context, request *CancelNetworkTestRequest) (result *CancelNetworkTestResponse, err error)
+	DeregisterService(request *DeregisterServiceRequest) (result *DeregisterServiceResponse, err error)
+	DeregisterServiceContext(ctx context.Context, request *DeregisterServiceRequest) (result *DeregisterServiceResponse, err error)
+	GetClientList(request *GetClientListRequest) (result *GetClientListResponse, err error)
+	GetClientListContext(ctx context.Context, request *GetClientListRequest) (result *GetClientListResponse, err error)
+	GetHealthManagerStatus(request *GetHealthManagerStatusRequest) (result *GetHealthManagerStatusResponse, err error)
+	GetHealthManagerStatusContext(ctx context.Context, request *GetHealthManagerStatusRequest) (result *GetHealthManagerStatusResponse, err error)
+	GetInformation(request *GetInformationRequest) (result *GetInformationResponse, err error)
+	GetInformationContext(ctx context.Context, request *GetInformationRequest) (result *GetInformationResponse, err error)
+	GetNetworkTestResult(request *GetNetworkTestResultRequest) (result *GetNetworkTestResultResponse, err error)
+	GetNetworkTestResultContext(ctx context.Context, request *GetNetworkTestResultRequest) (result *GetNetworkTestResultResponse, err error)
+	GetServices(request *GetServicesRequest) (result *GetServicesResponse, err error)
+	GetServicesContext(ctx context.Context, request *GetServicesRequest) (result *GetServicesResponse, err error)
+	GetSystemStatistics(request *GetSystemStatisticsRequest) (result *GetSystemStatisticsResponse, err error)
+	GetSystemStatisticsContext(ctx context.Context, request *GetSystemStatisticsRequest) (result *GetSystemStatisticsResponse, err error)
+	ListRegistryReplicas(request *ListRegistryReplicasRequest) (result *ListRegistryReplicasResponse, err error)
+	ListRegistryReplicasContext(ctx context.Context, request *ListRegistryReplicasRequest) (result *ListRegistryReplicasResponse, err error)
+	RemoveRegistryReplica(request *RemoveRegistryReplicaRequest) (result *RemoveRegistryReplicaResponse, err error)
+	RemoveRegistryReplicaContext(ctx context.Context, request *RemoveRegistryReplicaRequest) (result *RemoveRegistryReplicaResponse, err error)
+	StartNetworkTest(request *StartNetworkTestRequest) (result *StartNetworkTestResponse, err error)
+	StartNetworkTestContext(ctx context.Context, request *StartNetworkTestRequest) (result *StartNetworkTestResponse, err error)
+}
+
+// SupportAPI creates support and service dumps.
+type SupportAPI interface {
+	CancelSupportDump(request *CancelSupportDumpRequest) (result *CancelSupportDumpResponse, err error)
+	CancelSupportDumpContext(ctx context.Context, request *CancelSupportDumpRequest) (result *CancelSupportDumpResponse, err error)
+	GenerateAsyncSupportDump(request *GenerateAsyncSupportDumpRequest) (result *GenerateAsyncSupportDumpResponse, err error)
+	GenerateAsyncSupportDumpContext(ctx context.Context, request *GenerateAsyncSupportDumpRequest) (result *GenerateAsyncSupportDumpResponse, err error)
+	GetServiceDump(request *GetServiceDumpRequest) (result *GetServiceDumpResponse, err error)
+	GetServiceDumpContext(ctx context.Context, request *GetServiceDumpRequest) (result *GetServiceDumpResponse, err error)
+	GetSupportDump(request *GetSupportDumpRequest) (result *GetSupportDumpResponse, err error)
+	GetSupportDumpContext(ctx context.Context, request *GetSupportDumpRequest) (result *GetSupportDumpResponse, err error)
+	GetSupportDumpStatus(request *GetSupportDumpStatusRequest) (result *GetSupportDumpStatusResponse, err error)
+	GetSupportDumpStatusContext(ctx context.Context, request *GetSupportDumpStatusRequest) (result *GetSupportDumpStatusResponse, err error)
+}
@@ -0,0 +1,296 @@
+package quobyte
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MethodClass groups JSON-RPC methods for rate limits and concurrency caps.
+type MethodClass string
+
+const (
+	// ReadMethods are the idempotent methods, e.g. getVolumeList.
+	ReadMethods MethodClass = "read"
+	// WriteMethods are the methods that modify state, e.g. setLabels or setQuota.
+	WriteMethods MethodClass = "write"
+	// HeavyMethods are the methods that put a high load on the API service.
+	HeavyMethods MethodClass = "heavy"
+)
+
+var heavyMethods = map[string]bool{
+	"queryFiles":               true,
+	"generateAsyncSupportDump": true,
+	"getFileMetadataDump":      true,
+}
+
+// Limit restricts the calls of a client. Zero values mean no restriction.
+type Limit struct {
+	// Rate is the number of calls per second, refilled into a token bucket.
+	Rate float64
+	// Burst is the size of the token bucket, at least 1.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent calls.
+	MaxInFlight int
+}
+
+type limits struct {
+	mu      sync.Mutex
+	global  *limiter
+	classes map[MethodClass]*limiter
+	methods map[string]MethodClass
+}
+
+// SetLimit restricts all calls of the client.
+func (client *QuobyteClient) SetLimit(limit Limit) {
+	client.limits.mu.Lock()
+	defer client.limits.mu.Unlock()
+	client.limits.global = newLimiter(limit)
+}
+
+// SetClassLimit restricts the calls of the methods in class. Calls have to pass the limit of
+// their class and the limit set by SetLimit.
+func (client *QuobyteClient) SetClassLimit(class MethodClass, limit Limit) {
+	client.limits.mu.Lock()
+	defer client.limits.mu.Unlock()
+	if client.limits.classes == nil {
+		client.limits.classes = map[MethodClass]*limiter{}
+	}
+	client.limits.classes[class] = newLimiter(limit)
+}
+
+// SetMethodClass overrides the class of a JSON-RPC method.
+func (client *QuobyteClient) SetMethodClass(method string, class MethodClass) {
+	client.limits.mu.Lock()
+	defer client.limits.mu.Unlock()
+	if client.limits.methods == nil {
+		client.limits.methods = map[string]MethodClass{}
+	}
+	client.limits.methods[method] = class
+}
+
+// WithLimit restricts all calls of the client, see SetLimit.
+func WithLimit(limit Limit) Option {
+	return func(opts *clientOptions) error {
+		opts.limits = append(opts.limits, func(client *QuobyteClient) { client.SetLimit(limit) })
+		return nil
+	}
+}
+
+// WithClassLimit restricts the calls of the methods in class, see SetClassLimit.
+func WithClassLimit(class MethodClass, limit Limit) Option {
+	return func(opts *clientOptions) error {
+		opts.limits = append(opts.limits, func(client *QuobyteClient) { client.SetClassLimit(class, limit) })
+		return nil
+	}
+}
+
+// methodClass returns the class of a method: heavy if it is known to be expensive, read if it is
+// idempotent and write otherwise.
+func (client *QuobyteClient) methodClass(method string, idempotent bool) MethodClass {
+	client.limits.mu.Lock()
+	class, ok := client.limits.methods[method]
+	client.limits.mu.Unlock()
+	switch {
+	case ok:
+		return class
+	case heavyMethods[method]:
+		return HeavyMethods
+	case idempotent:
+		return ReadMethods
+	}
+	return WriteMethods
+}
+
+// callsKey marks contexts of requests with many calls, e.g. batches, with the number of calls
+// per class.
+type callsKey struct{}
+
+func withCalls(ctx context.Context, calls map[MethodClass]int) context.Context {
+	return context.WithValue(ctx, callsKey{}, calls)
+}
+
+// withLimits waits until the limits of the client and the class of the method allow the call
+// and then calls send. Requests with many calls, see withCalls, take one token of the limits of
+// their classes per call.
+func (client *QuobyteClient) withLimits(ctx context.Context, method string, idempotent bool, send func() error) error {
+	calls, ok := ctx.Value(callsKey{}).(map[MethodClass]int)
+	if !ok {
+		calls = map[MethodClass]int{client.methodClass(method, idempotent): 1}
+	}
+	classes := make([]MethodClass, 0, len(calls))
+	total := 0
+	for class, n := range calls {
+		classes = append(classes, class)
+		total += n
+	}
+	// a fixed order of the classes keeps concurrent batches from waiting for each other
+	sort.Slice(classes, func(i, j int) bool { return classes[i] < classes[j] })
+	client.limits.mu.Lock()
+	limiters := make([]*limiter, 0, len(classes)+1)
+	weights := make([]int, 0, len(classes)+1)
+	for _, class := range classes {
+		limiters = append(limiters, client.limits.classes[class])
+		weights = append(weights, calls[class])
+	}
+	limiters = append(limiters, client.limits.global)
+	weights = append(weights, total)
+	client.limits.mu.Unlock()
+	// the class limits are acquired first to not block the global limit while waiting for them
+	for i, limiter := range limiters {
+		if limiter == nil {
+			continue
+		}
+		if err := limiter.acquireN(ctx, weights[i]); err != nil {
+			return err
+		}
+		defer limiter.releaseN(weights[i])
+	}
+	return send()
+}
+
+// limiter is a token bucket with a cap of concurrent calls. Waiting calls are served in FIFO
+// order.
+type limiter struct {
+	limit Limit
+
+	mu       sync.Mutex
+	tokens   float64
+	last     time.Time
+	inFlight int
+	queue    []*waiter
+}
+
+type waiter struct {
+	wakeup chan struct{}
+}
+
+func newLimiter(limit Limit) *limiter {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+	return &limiter{limit: limit, tokens: float64(limit.Burst), last: time.Now()}
+}
+
+// refill adds the tokens accumulated since the last call. Must be called with mu held.
+func (limiter *limiter) refill(now time.Time) {
+	if limiter.limit.Rate <= 0 {
+		return
+	}
+	limiter.tokens = math.Min(float64(limiter.limit.Burst), limiter.tokens+now.Sub(limiter.last).Seconds()*limiter.limit.Rate)
+	limiter.last = now
+}
+
+// slots returns the number of in-flight slots taken by a request with n calls. Requests with
+// more calls than MaxInFlight take all slots.
+func (limiter *limiter) slots(n int) int {
+	if limiter.limit.MaxInFlight > 0 && n > limiter.limit.MaxInFlight {
+		return limiter.limit.MaxInFlight
+	}
+	return n
+}
+
+// delay returns how long the request with n calls at the head of the queue has to wait, -1 if it
+// has to wait for a release. Requests with more calls than Burst wait for a full bucket and leave
+// it in debt, so that the following calls wait for the refill. Must be called with mu held.
+func (limiter *limiter) delay(now time.Time, n int) time.Duration {
+	if limiter.limit.MaxInFlight > 0 && limiter.inFlight+limiter.slots(n) > limiter.limit.MaxInFlight {
+		return -1
+	}
+	if limiter.limit.Rate <= 0 {
+		return 0
+	}
+	limiter.refill(now)
+	needed := math.Min(float64(n), float64(limiter.limit.Burst))
+	if limiter.tokens >= needed {
+		return 0
+	}
+	return time.Duration((needed - limiter.tokens) / limiter.limit.Rate * float64(time.Second))
+}
+
+// wakeHead wakes up the call at the head of the queue. Must be called with mu held.
+func (limiter *limiter) wakeHead() {
+	if len(limiter.queue) == 0 {
+		return
+	}
+	select {
+	case limiter.queue[0].wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (limiter *limiter) acquire(ctx context.Context) error {
+	return limiter.acquireN(ctx, 1)
+}
+
+// acquireN waits until a request with n calls may be sent.
+func (limiter *limiter) acquireN(ctx context.Context, n int) error {
+	self := &waiter{wakeup: make(chan struct{}, 1)}
+	limiter.mu.Lock()
+	limiter.queue = append(limiter.queue, self)
+	for {
+		var delay time.Duration = -1
+		if limiter.queue[0] == self {
+			delay = limiter.delay(time.Now(), n)
+			if delay == 0 {
+				if limiter.limit.Rate > 0 {
+					limiter.tokens -= float64(n)
+				}
+				limiter.inFlight += limiter.slots(n)
+				limiter.queue = limiter.queue[1:]
+				limiter.wakeHead()
+				limiter.mu.Unlock()
+				return nil
+			}
+		}
+		limiter.mu.Unlock()
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if delay > 0 {
+			timer = time.NewTimer(delay)
+			timeout = timer.C
+		}
+		select {
+		case <-self.wakeup:
+		case <-timeout:
+		case <-ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		limiter.mu.Lock()
+		if ctx.Err() != nil {
+			limiter.remove(self)
+			limiter.mu.Unlock()
+			return ctx.Err()
+		}
+	}
+}
+
+// remove removes a canceled call from the queue. Must be called with mu held.
+func (limiter *limiter) remove(self *waiter) {
+	for i, waiter := range limiter.queue {
+		if waiter == self {
+			limiter.queue = append(limiter.queue[:i:i], limiter.queue[i+1:]...)
+			if i == 0 {
+				limiter.wakeHead()
+			}
+			return
+		}
+	}
+}
+
+func (limiter *limiter) release() {
+	limiter.releaseN(1)
+}
+
+// releaseN releases the slots of a request with n calls.
+func (limiter *limiter) releaseN(n int) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.inFlight -= limiter.slots(n)
+	limiter.wakeHead()
+}
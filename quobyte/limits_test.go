@@ -0,0 +1,195 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newConcurrencyServer returns an API server that answers after delay and records the maximum
+// number of concurrent requests.
+func newConcurrencyServer(t *testing.T, delay time.Duration, maxConcurrent *int64) *httptest.Server {
+	var concurrent int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		current := atomic.AddInt64(&concurrent, 1)
+		defer atomic.AddInt64(&concurrent, -1)
+		for {
+			seen := atomic.LoadInt64(maxConcurrent)
+			if current <= seen || atomic.CompareAndSwapInt64(maxConcurrent, seen, current) {
+				break
+			}
+		}
+		time.Sleep(delay)
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		w.Write(rpcResponse(req, `{"result":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestClassLimitCapsInFlightCalls(t *testing.T) {
+	var maxConcurrent int64
+	srv := newConcurrencyServer(t, 20*time.Millisecond, &maxConcurrent)
+	client, err := NewClient(srv.URL, WithClassLimit(WriteMethods, Limit{MaxInFlight: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// log in before the concurrent calls
+	if _, err := client.GetVolumeList(&GetVolumeListRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.SetLabels(&SetLabelsRequest{}); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := atomic.LoadInt64(&maxConcurrent); got != 2 {
+		t.Errorf("Expected 2 concurrent calls, got %d", got)
+	}
+}
+
+func TestLimitRate(t *testing.T) {
+	var maxConcurrent int64
+	srv := newConcurrencyServer(t, 0, &maxConcurrent)
+	client, err := NewClient(srv.URL, WithLimit(Limit{Rate: 100, Burst: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	for i := 0; i < 12; i++ {
+		if _, err := client.GetVolumeList(&GetVolumeListRequest{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// the first 2 calls use the burst, the other 10 wait for a token each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Calls were not rate limited, took %v", elapsed)
+	}
+}
+
+func TestMethodClass(t *testing.T) {
+	client, err := NewClient("http://quobyte-api.invalid:7860")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetMethodClass("getAuditLog", HeavyMethods)
+	for method, expected := range map[string]MethodClass{
+		"getVolumeList":       ReadMethods,
+		"setQuota":            WriteMethods,
+		"queryFiles":          HeavyMethods,
+		"getFileMetadataDump": HeavyMethods,
+		"getAuditLog":         HeavyMethods,
+	} {
+		if got := client.methodClass(method, client.methodIdempotency(method) == Idempotent); got != expected {
+			t.Errorf("%s: got %s, want %s", method, got, expected)
+		}
+	}
+}
+
+func TestLimiterIsFair(t *testing.T) {
+	limiter := newLimiter(Limit{MaxInFlight: 1})
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			limiter.acquire(context.Background())
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+			limiter.release()
+		}(i)
+		// wait until the call is queued
+		for {
+			limiter.mu.Lock()
+			queued := len(limiter.queue)
+			limiter.mu.Unlock()
+			if queued == i+1 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	limiter.release()
+	wg.Wait()
+	for i, got := range order {
+		if got != i {
+			t.Fatalf("Calls were not served in order: %v", order)
+		}
+	}
+}
+
+func TestLimiterCancellation(t *testing.T) {
+	limiter := newLimiter(Limit{MaxInFlight: 1})
+	limiter.acquire(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+	limiter.mu.Lock()
+	queued := len(limiter.queue)
+	limiter.mu.Unlock()
+	if queued != 0 {
+		t.Errorf("Canceled call is still queued")
+	}
+	limiter.release()
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestBatchTakesLimitsOfItsCalls(t *testing.T) {
+	var posts int64
+	client, err := NewClient(newBatchServer(t, true, &posts).URL,
+		WithLimit(Limit{Rate: 0.001, Burst: 10}),
+		WithClassLimit(WriteMethods, Limit{Rate: 0.001, Burst: 10, MaxInFlight: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := client.NewBatch()
+	for i := 0; i < 3; i++ {
+		batch.Add(MethodSetLabels, &SetLabelsRequest{}, nil)
+	}
+	batch.Add(MethodGetVolumeList, &GetVolumeListRequest{}, &GetVolumeListResponse{})
+	if err := batch.Send(context.Background()); err != nil || atomic.LoadInt64(&posts) != 1 {
+		t.Fatalf("Unexpected error %v after %d requests", err, atomic.LoadInt64(&posts))
+	}
+	for name, limiter := range map[string]*limiter{"global": client.limits.global, "write": client.limits.classes[WriteMethods]} {
+		limiter.mu.Lock()
+		tokens, inFlight := limiter.tokens, limiter.inFlight
+		limiter.mu.Unlock()
+		expected := map[string]float64{"global": 6, "write": 7}[name]
+		if tokens < expected || tokens > expected+0.1 || inFlight != 0 {
+			t.Errorf("%s: expected %v tokens and no call in flight, got %v and %d", name, expected, tokens, inFlight)
+		}
+	}
+
+	// a batch with more calls than the burst waits for a full bucket and leaves it in debt
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	batch = client.NewBatch()
+	for i := 0; i < 20; i++ {
+		batch.Add(MethodSetLabels, &SetLabelsRequest{}, nil)
+	}
+	if err := batch.Send(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the batch to wait for tokens, got %v", err)
+	}
+}
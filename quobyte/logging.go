@@ -0,0 +1,112 @@
+package quobyte
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+)
+
+// redactedValue replaces the values of secret fields in logged messages.
+const redactedValue = "REDACTED"
+
+// SetLogger makes the client log the JSON-RPC requests and responses at debug level. Values of
+// fields carrying passwords, secrets or key material are redacted. nil disables the logging.
+func (client *QuobyteClient) SetLogger(logger *slog.Logger) {
+	client.logger = logger
+}
+
+// WithLogger makes the client log the JSON-RPC requests and responses, see SetLogger.
+func WithLogger(logger *slog.Logger) Option {
+	return func(opts *clientOptions) error {
+		opts.logger = logger
+		return nil
+	}
+}
+
+func (client QuobyteClient) debugEnabled(ctx context.Context) bool {
+	return client.logger != nil && client.logger.Enabled(ctx, slog.LevelDebug)
+}
+
+func (client QuobyteClient) logRequest(ctx context.Context, ep *endpoint, method string, message []byte, login bool) {
+	client.logger.LogAttrs(ctx, slog.LevelDebug, "Quobyte API request",
+		slog.String("method", method),
+		slog.String("endpoint", ep.url.String()),
+		slog.Bool("login", login),
+		slog.String("request", redact(message)))
+}
+
+func (client QuobyteClient) logResponse(ctx context.Context, ep *endpoint, method string, status int, body []byte, duration time.Duration) {
+	client.logger.LogAttrs(ctx, slog.LevelDebug, "Quobyte API response",
+		slog.String("method", method),
+		slog.String("endpoint", ep.url.String()),
+		slog.Int("status", status),
+		slog.Duration("duration", duration),
+		slog.String("response", redact(body)))
+}
+
+// logStreamedResponse logs a response whose body is decoded while it is received, e.g. a dump.
+// The body is not buffered and not logged.
+func (client QuobyteClient) logStreamedResponse(ctx context.Context, ep *endpoint, method string, status int, duration time.Duration) {
+	client.logger.LogAttrs(ctx, slog.LevelDebug, "Quobyte API response",
+		slog.String("method", method),
+		slog.String("endpoint", ep.url.String()),
+		slog.Int("status", status),
+		slog.Duration("duration", duration),
+		slog.String("response", "[streamed, not logged]"))
+}
+
+// streamedKey marks contexts of calls whose response body is streamed.
+type streamedKey struct{}
+
+func withStreamedResponse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamedKey{}, true)
+}
+
+func isStreamedResponse(ctx context.Context) bool {
+	streamed, _ := ctx.Value(streamedKey{}).(bool)
+	return streamed
+}
+
+func (client QuobyteClient) logFailure(ctx context.Context, ep *endpoint, method string, err error, duration time.Duration) {
+	client.logger.LogAttrs(ctx, slog.LevelDebug, "Quobyte API request failed",
+		slog.String("method", method),
+		slog.String("endpoint", ep.url.String()),
+		slog.Duration("duration", duration),
+		slog.String("error", err.Error()))
+}
+
+// redact returns the JSON message with the values of all secretFields replaced. Messages that
+// are no JSON, e.g. error pages of proxies, are returned unchanged.
+func redact(message []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return string(message)
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return string(message)
+	}
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if secretFields[key] && field != nil && field != "" {
+				value[key] = redactedValue
+			} else {
+				value[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactValue(element)
+		}
+	}
+	return value
+}
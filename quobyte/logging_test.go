@@ -0,0 +1,90 @@
+package quobyte
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLoggingRedactsSecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		w.Write(rpcResponse(req, `{"result":{"access_key_credentials":{"access_key_id":"AKID","secret_access_key":"s3cr3t-key"}}}`))
+	}))
+	defer srv.Close()
+
+	var output bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := NewClient(srv.URL, WithCredentials("admin", "basic-password"), WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateUser(&CreateUserRequest{UserName: "alice", Password: "user-password"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	response, err := client.CreateAccessKeyCredentials(&CreateAccessKeyCredentialsRequest{UserName: "alice"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if response.AccessKeyCredentials.SecretAccessKey != "s3cr3t-key" {
+		t.Errorf("Response was modified by logging: %+v", response)
+	}
+	client.SetEncryptedVolumeKey(&SetEncryptedVolumeKeyRequest{
+		VolumeUuid:                         "c858ffe2-4fa1-4c78-adbf-54c211734883",
+		EncodedNewKeystoreSlotPasswordHash: "slot-hash",
+		NewEncryptedVolumeKey:              EncodedEncryptedKey{EncodedEncryptedKey: "key-material", EncodedKeyDerivationSalt: "key-salt"},
+	})
+	client.VerifyLicense(&VerifyLicenseRequest{Key: "license-key"})
+
+	logged := output.String()
+	for _, secret := range []string{"basic-password", "user-password", "s3cr3t-key", "slot-hash", "key-material", "key-salt", "license-key"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("Secret %s was logged:\n%s", secret, logged)
+		}
+	}
+	for _, expected := range []string{"method=createUser", `\"user_name\":\"alice\"`, `\"access_key_id\":\"AKID\"`, `\"volume_uuid\":\"c858ffe2-4fa1-4c78-adbf-54c211734883\"`, "status=200"} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("Expected %s in log:\n%s", expected, logged)
+		}
+	}
+}
+
+func TestLoggingDisabled(t *testing.T) {
+	var output bytes.Buffer
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write(rpcResponse(req, `{"result":{}}`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL, WithLogger(slog.New(slog.NewTextHandler(&output, nil))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if output.Len() != 0 {
+		t.Errorf("Expected no output below debug level, got %s", output.String())
+	}
+}
+
+type redactTest struct {
+	message  string
+	expected string
+}
+
+func TestRedact(t *testing.T) {
+	tests := []redactTest{
+		{`{"params":{"password":"x","users":[{"salt":"y","id":"a"}]}}`, `{"params":{"password":"REDACTED","users":[{"id":"a","salt":"REDACTED"}]}}`},
+		{`{"password":""}`, `{"password":""}`},
+		{`{"size":12345678901234567890}`, `{"size":12345678901234567890}`},
+		{`Bad Gateway`, `Bad Gateway`},
+	}
+	for _, test := range tests {
+		if got := redact([]byte(test.message)); got != test.expected {
+			t.Errorf("redact(%s): got %s, want %s", test.message, got, test.expected)
+		}
+	}
+}
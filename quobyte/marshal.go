@@ -0,0 +1,819 @@
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
+
+package quobyte
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *AcceptTermsAndConditionsRequest) MarshalJSON() ([]byte, error) {
+	type plain AcceptTermsAndConditionsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *AcknowledgeAlertRequest) MarshalJSON() ([]byte, error) {
+	type plain AcknowledgeAlertRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *AddCaRequest) MarshalJSON() ([]byte, error) {
+	type plain AddCaRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *AddCertificateRequest) MarshalJSON() ([]byte, error) {
+	type plain AddCertificateRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *AddCsrRequest) MarshalJSON() ([]byte, error) {
+	type plain AddCsrRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *AddRegistryReplicaRequest) MarshalJSON() ([]byte, error) {
+	type plain AddRegistryReplicaRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *AnalyzeVolumesRequest) MarshalJSON() ([]byte, error) {
+	type plain AnalyzeVolumesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CancelNetworkTestRequest) MarshalJSON() ([]byte, error) {
+	type plain CancelNetworkTestRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CancelQueryRequest) MarshalJSON() ([]byte, error) {
+	type plain CancelQueryRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CancelSupportDumpRequest) MarshalJSON() ([]byte, error) {
+	type plain CancelSupportDumpRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CancelTaskRequest) MarshalJSON() ([]byte, error) {
+	type plain CancelTaskRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CancelVolumeErasureRequest) MarshalJSON() ([]byte, error) {
+	type plain CancelVolumeErasureRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ChangePolicyRulePriorityRequest) MarshalJSON() ([]byte, error) {
+	type plain ChangePolicyRulePriorityRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ConfigureRuleRequest) MarshalJSON() ([]byte, error) {
+	type plain ConfigureRuleRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateAccessKeyCredentialsRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateAccessKeyCredentialsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateMasterKeystoreSlotRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateMasterKeystoreSlotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateMirroredVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateMirroredVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateNewUserKeystoreSlotRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateNewUserKeystoreSlotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateNotificationRuleRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateNotificationRuleRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreatePolicyRuleRequest) MarshalJSON() ([]byte, error) {
+	type plain CreatePolicyRuleRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreatePolicyRuleSetRequest) MarshalJSON() ([]byte, error) {
+	type plain CreatePolicyRuleSetRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateSnapshotRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateSnapshotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateTaskRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateTaskRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateUserRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateUserRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *CreateVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain CreateVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DecideCsrRequest) MarshalJSON() ([]byte, error) {
+	type plain DecideCsrRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteAccessKeyCredentialsRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteAccessKeyCredentialsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteCaRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteCaRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteCertificateRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteCertificateRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteConfigurationRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteConfigurationRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteCsrRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteCsrRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteLabelsRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteLabelsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteNotificationRuleRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteNotificationRuleRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeletePolicyRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain DeletePolicyRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteSnapshotRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteSnapshotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteTenantRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteTenantRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteUserRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteUserRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeleteVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain DeleteVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DeregisterServiceRequest) MarshalJSON() ([]byte, error) {
+	type plain DeregisterServiceRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DisconnectMirroredVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain DisconnectMirroredVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DumpEffectivePolicyRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain DumpEffectivePolicyRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *DumpPolicyPresetsRequest) MarshalJSON() ([]byte, error) {
+	type plain DumpPolicyPresetsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *EraseSnapshotRequest) MarshalJSON() ([]byte, error) {
+	type plain EraseSnapshotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *EraseVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain EraseVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ExportCertificateRequest) MarshalJSON() ([]byte, error) {
+	type plain ExportCertificateRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ExportConfigurationRequest) MarshalJSON() ([]byte, error) {
+	type plain ExportConfigurationRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ExportPolicyRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain ExportPolicyRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ExportVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain ExportVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *FilterPolicyRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain FilterPolicyRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GenerateAsyncSupportDumpRequest) MarshalJSON() ([]byte, error) {
+	type plain GenerateAsyncSupportDumpRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetAccountingRequest) MarshalJSON() ([]byte, error) {
+	type plain GetAccountingRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetAddKeySlotDataRequest) MarshalJSON() ([]byte, error) {
+	type plain GetAddKeySlotDataRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetAnalyzeReportsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetAnalyzeReportsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetAuditLogRequest) MarshalJSON() ([]byte, error) {
+	type plain GetAuditLogRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetCertificateSubjectRequest) MarshalJSON() ([]byte, error) {
+	type plain GetCertificateSubjectRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetClientListRequest) MarshalJSON() ([]byte, error) {
+	type plain GetClientListRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetConfigurationRequest) MarshalJSON() ([]byte, error) {
+	type plain GetConfigurationRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetDefaultKeyStoreSlotParamsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetDefaultKeyStoreSlotParamsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetDeviceGroupsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetDeviceGroupsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetDeviceIdsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetDeviceIdsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetDeviceListRequest) MarshalJSON() ([]byte, error) {
+	type plain GetDeviceListRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetDeviceNetworkEndpointsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetDeviceNetworkEndpointsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetDeviceTagsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetDeviceTagsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetEffectiveVolumeConfigurationRequest) MarshalJSON() ([]byte, error) {
+	type plain GetEffectiveVolumeConfigurationRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetEncryptStatusRequest) MarshalJSON() ([]byte, error) {
+	type plain GetEncryptStatusRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetEncryptedVolumeKeyRequest) MarshalJSON() ([]byte, error) {
+	type plain GetEncryptedVolumeKeyRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetFileMetadataDumpRequest) MarshalJSON() ([]byte, error) {
+	type plain GetFileMetadataDumpRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetFiringRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain GetFiringRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetHealthManagerStatusRequest) MarshalJSON() ([]byte, error) {
+	type plain GetHealthManagerStatusRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetInformationRequest) MarshalJSON() ([]byte, error) {
+	type plain GetInformationRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetKeyStoreSlotWithoutHashRequest) MarshalJSON() ([]byte, error) {
+	type plain GetKeyStoreSlotWithoutHashRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetLabelsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetLabelsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetLatestEventRequest) MarshalJSON() ([]byte, error) {
+	type plain GetLatestEventRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetLicenseRequest) MarshalJSON() ([]byte, error) {
+	type plain GetLicenseRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetMasterKeystoreSlotsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetMasterKeystoreSlotsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetNetworkTestResultRequest) MarshalJSON() ([]byte, error) {
+	type plain GetNetworkTestResultRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetNotificationRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain GetNotificationRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetPolicyPresetsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetPolicyPresetsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetPolicyRuleSetsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetPolicyRuleSetsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetPolicyRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain GetPolicyRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetQueryProgressRequest) MarshalJSON() ([]byte, error) {
+	type plain GetQueryProgressRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetQuotaRequest) MarshalJSON() ([]byte, error) {
+	type plain GetQuotaRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain GetRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetServiceDumpRequest) MarshalJSON() ([]byte, error) {
+	type plain GetServiceDumpRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetServicesRequest) MarshalJSON() ([]byte, error) {
+	type plain GetServicesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetSupportDumpRequest) MarshalJSON() ([]byte, error) {
+	type plain GetSupportDumpRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetSupportDumpStatusRequest) MarshalJSON() ([]byte, error) {
+	type plain GetSupportDumpStatusRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetSystemStatisticsRequest) MarshalJSON() ([]byte, error) {
+	type plain GetSystemStatisticsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetTaskListRequest) MarshalJSON() ([]byte, error) {
+	type plain GetTaskListRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetTenantRequest) MarshalJSON() ([]byte, error) {
+	type plain GetTenantRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetTopCapacityConsumerRequest) MarshalJSON() ([]byte, error) {
+	type plain GetTopCapacityConsumerRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetUnformattedDevicesRequest) MarshalJSON() ([]byte, error) {
+	type plain GetUnformattedDevicesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetUsersRequest) MarshalJSON() ([]byte, error) {
+	type plain GetUsersRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *GetVolumeListRequest) MarshalJSON() ([]byte, error) {
+	type plain GetVolumeListRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ImportAccessKeysRequest) MarshalJSON() ([]byte, error) {
+	type plain ImportAccessKeysRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ImportConfigurationRequest) MarshalJSON() ([]byte, error) {
+	type plain ImportConfigurationRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ImportPolicyRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain ImportPolicyRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ListCaRequest) MarshalJSON() ([]byte, error) {
+	type plain ListCaRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ListCertificatesRequest) MarshalJSON() ([]byte, error) {
+	type plain ListCertificatesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ListCsrRequest) MarshalJSON() ([]byte, error) {
+	type plain ListCsrRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ListRegistryReplicasRequest) MarshalJSON() ([]byte, error) {
+	type plain ListRegistryReplicasRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ListSnapshotsRequest) MarshalJSON() ([]byte, error) {
+	type plain ListSnapshotsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *MakeDeviceRequest) MarshalJSON() ([]byte, error) {
+	type plain MakeDeviceRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *PublishBucketVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain PublishBucketVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *QueryFilesRequest) MarshalJSON() ([]byte, error) {
+	type plain QueryFilesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *RegenerateDatabaseRequest) MarshalJSON() ([]byte, error) {
+	type plain RegenerateDatabaseRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *RemoveKeystoreSlotRequest) MarshalJSON() ([]byte, error) {
+	type plain RemoveKeystoreSlotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *RemoveMasterKeystoreSlotRequest) MarshalJSON() ([]byte, error) {
+	type plain RemoveMasterKeystoreSlotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *RemoveRegistryReplicaRequest) MarshalJSON() ([]byte, error) {
+	type plain RemoveRegistryReplicaRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ResolveGlobalFileIdRequest) MarshalJSON() ([]byte, error) {
+	type plain ResolveGlobalFileIdRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ResolvePolicyRuleNameRequest) MarshalJSON() ([]byte, error) {
+	type plain ResolvePolicyRuleNameRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ResolveTenantNameRequest) MarshalJSON() ([]byte, error) {
+	type plain ResolveTenantNameRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ResolveVolumeNameRequest) MarshalJSON() ([]byte, error) {
+	type plain ResolveVolumeNameRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *ResumeTaskRequest) MarshalJSON() ([]byte, error) {
+	type plain ResumeTaskRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *RetryTaskRequest) MarshalJSON() ([]byte, error) {
+	type plain RetryTaskRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *RevokeCertificateRequest) MarshalJSON() ([]byte, error) {
+	type plain RevokeCertificateRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetCertificateOwnerRequest) MarshalJSON() ([]byte, error) {
+	type plain SetCertificateOwnerRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetCertificateSubjectRequest) MarshalJSON() ([]byte, error) {
+	type plain SetCertificateSubjectRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetConfigurationRequest) MarshalJSON() ([]byte, error) {
+	type plain SetConfigurationRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetEncryptedVolumeKeyRequest) MarshalJSON() ([]byte, error) {
+	type plain SetEncryptedVolumeKeyRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetLabelsRequest) MarshalJSON() ([]byte, error) {
+	type plain SetLabelsRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetLicenseKeyRequest) MarshalJSON() ([]byte, error) {
+	type plain SetLicenseKeyRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetNotificationRuleRequest) MarshalJSON() ([]byte, error) {
+	type plain SetNotificationRuleRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetQuotaRequest) MarshalJSON() ([]byte, error) {
+	type plain SetQuotaRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SetTenantRequest) MarshalJSON() ([]byte, error) {
+	type plain SetTenantRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *SilenceAlertRequest) MarshalJSON() ([]byte, error) {
+	type plain SilenceAlertRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *StartNetworkTestRequest) MarshalJSON() ([]byte, error) {
+	type plain StartNetworkTestRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *TriggerVolumeCheckpointRequest) MarshalJSON() ([]byte, error) {
+	type plain TriggerVolumeCheckpointRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *UnlockMasterKeystoreSlotRequest) MarshalJSON() ([]byte, error) {
+	type plain UnlockMasterKeystoreSlotRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *UnpublishBucketVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain UnpublishBucketVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *UpdateDeviceRequest) MarshalJSON() ([]byte, error) {
+	type plain UpdateDeviceRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *UpdatePolicyRulesRequest) MarshalJSON() ([]byte, error) {
+	type plain UpdatePolicyRulesRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *UpdateUserRequest) MarshalJSON() ([]byte, error) {
+	type plain UpdateUserRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *UpdateVolumeRequest) MarshalJSON() ([]byte, error) {
+	type plain UpdateVolumeRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *VerifyLicenseRequest) MarshalJSON() ([]byte, error) {
+	type plain VerifyLicenseRequest
+	return request.presence.marshal((*plain)(request))
+}
+
+// MarshalJSON encodes the request with the fields marked by SetExplicit.
+func (request *WhoAmIRequest) MarshalJSON() ([]byte, error) {
+	type plain WhoAmIRequest
+	return request.presence.marshal((*plain)(request))
+}
@@ -0,0 +1,146 @@
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
+
+package quobyte
+
+// JSON-RPC method names of the Quobyte API.
+const (
+	MethodAcceptTermsAndConditions        = "acceptTermsAndConditions"
+	MethodAcknowledgeAlert                = "acknowledgeAlert"
+	MethodAddCa                           = "addCa"
+	MethodAddCertificate                  = "addCertificate"
+	MethodAddCsr                          = "addCsr"
+	MethodAddRegistryReplica              = "addRegistryReplica"
+	MethodAnalyzeVolumes                  = "analyzeVolumes"
+	MethodCancelNetworkTest               = "cancelNetworkTest"
+	MethodCancelQuery                     = "cancelQuery"
+	MethodCancelSupportDump               = "cancelSupportDump"
+	MethodCancelTask                      = "cancelTask"
+	MethodCancelVolumeErasure             = "cancelVolumeErasure"
+	MethodChangePolicyRulePriority        = "changePolicyRulePriority"
+	MethodConfigureRule                   = "configureRule"
+	MethodCreateAccessKeyCredentials      = "createAccessKeyCredentials"
+	MethodCreateMasterKeystoreSlot        = "createMasterKeystoreSlot"
+	MethodCreateMirroredVolume            = "createMirroredVolume"
+	MethodCreateNewUserKeystoreSlot       = "createNewUserKeystoreSlot"
+	MethodCreateNotificationRule          = "createNotificationRule"
+	MethodCreatePolicyRule                = "createPolicyRule"
+	MethodCreatePolicyRuleSet             = "createPolicyRuleSet"
+	MethodCreateSnapshot                  = "createSnapshot"
+	MethodCreateTask                      = "createTask"
+	MethodCreateUser                      = "createUser"
+	MethodCreateVolume                    = "createVolume"
+	MethodDecideCsr                       = "decideCsr"
+	MethodDeleteAccessKeyCredentials      = "deleteAccessKeyCredentials"
+	MethodDeleteCa                        = "deleteCa"
+	MethodDeleteCertificate               = "deleteCertificate"
+	MethodDeleteConfiguration             = "deleteConfiguration"
+	MethodDeleteCsr                       = "deleteCsr"
+	MethodDeleteLabels                    = "deleteLabels"
+	MethodDeleteNotificationRule          = "deleteNotificationRule"
+	MethodDeletePolicyRules               = "deletePolicyRules"
+	MethodDeleteSnapshot                  = "deleteSnapshot"
+	MethodDeleteTenant                    = "deleteTenant"
+	MethodDeleteUser                      = "deleteUser"
+	MethodDeleteVolume                    = "deleteVolume"
+	MethodDeregisterService               = "deregisterService"
+	MethodDisconnectMirroredVolume        = "disconnectMirroredVolume"
+	MethodDumpEffectivePolicyRules        = "dumpEffectivePolicyRules"
+	MethodDumpPolicyPresets               = "dumpPolicyPresets"
+	MethodEraseSnapshot                   = "eraseSnapshot"
+	MethodEraseVolume                     = "eraseVolume"
+	MethodExportCertificate               = "exportCertificate"
+	MethodExportConfiguration             = "exportConfiguration"
+	MethodExportPolicyRules               = "exportPolicyRules"
+	MethodExportVolume                    = "exportVolume"
+	MethodFilterPolicyRules               = "filterPolicyRules"
+	MethodGenerateAsyncSupportDump        = "generateAsyncSupportDump"
+	MethodGetAccounting                   = "getAccounting"
+	MethodGetAddKeySlotData               = "getAddKeySlotData"
+	MethodGetAnalyzeReports               = "getAnalyzeReports"
+	MethodGetAuditLog                     = "getAuditLog"
+	MethodGetCertificateSubject           = "getCertificateSubject"
+	MethodGetClientList                   = "getClientList"
+	MethodGetConfiguration                = "getConfiguration"
+	MethodGetDefaultKeyStoreSlotParams    = "getDefaultKeyStoreSlotParams"
+	MethodGetDeviceGroups                 = "getDeviceGroups"
+	MethodGetDeviceIds                    = "getDeviceIds"
+	MethodGetDeviceList                   = "getDeviceList"
+	MethodGetDeviceNetworkEndpoints       = "getDeviceNetworkEndpoints"
+	MethodGetDeviceTags                   = "getDeviceTags"
+	MethodGetEffectiveVolumeConfiguration = "getEffectiveVolumeConfiguration"
+	MethodGetEncryptStatus                = "getEncryptStatus"
+	MethodGetEncryptedVolumeKey           = "getEncryptedVolumeKey"
+	MethodGetFileMetadataDump             = "getFileMetadataDump"
+	MethodGetFiringRules                  = "getFiringRules"
+	MethodGetHealthManagerStatus          = "getHealthManagerStatus"
+	MethodGetInformation                  = "getInformation"
+	MethodGetKeyStoreSlotWithoutHash      = "getKeyStoreSlotWithoutHash"
+	MethodGetLabels                       = "getLabels"
+	MethodGetLatestEvent                  = "getLatestEvent"
+	MethodGetLicense                      = "getLicense"
+	MethodGetMasterKeystoreSlots          = "getMasterKeystoreSlots"
+	MethodGetNetworkTestResult            = "getNetworkTestResult"
+	MethodGetNotificationRules            = "getNotificationRules"
+	MethodGetPolicyPresets                = "getPolicyPresets"
+	MethodGetPolicyRuleSets               = "getPolicyRuleSets"
+	MethodGetPolicyRules                  = "getPolicyRules"
+	MethodGetQueryProgress                = "getQueryProgress"
+	MethodGetQuota                        = "getQuota"
+	MethodGetRules                        = "getRules"
+	MethodGetServiceDump                  = "getServiceDump"
+	MethodGetServices                     = "getServices"
+	MethodGetSupportDump                  = "getSupportDump"
+	MethodGetSupportDumpStatus            = "getSupportDumpStatus"
+	MethodGetSystemStatistics             = "getSystemStatistics"
+	MethodGetTaskList                     = "getTaskList"
+	MethodGetTenant                       = "getTenant"
+	MethodGetTopCapacityConsumer          = "getTopCapacityConsumer"
+	MethodGetUnformattedDevices           = "getUnformattedDevices"
+	MethodGetUsers                        = "getUsers"
+	MethodGetVolumeList                   = "getVolumeList"
+	MethodImportAccessKeys                = "importAccessKeys"
+	MethodImportConfiguration             = "importConfiguration"
+	MethodImportPolicyRules               = "importPolicyRules"
+	MethodListCa                          = "listCa"
+	MethodListCertificates                = "listCertificates"
+	MethodListCsr                         = "listCsr"
+	MethodListRegistryReplicas            = "listRegistryReplicas"
+	MethodListSnapshots                   = "listSnapshots"
+	MethodMakeDevice                      = "makeDevice"
+	MethodPublishBucketVolume             = "publishBucketVolume"
+	MethodQueryFiles                      = "queryFiles"
+	MethodRegenerateDatabase              = "regenerateDatabase"
+	MethodRemoveKeystoreSlot              = "removeKeystoreSlot"
+	MethodRemoveMasterKeystoreSlot        = "removeMasterKeystoreSlot"
+	MethodRemoveRegistryReplica           = "removeRegistryReplica"
+	MethodResolveGlobalFileId             = "resolveGlobalFileId"
+	MethodResolvePolicyRuleName           = "resolvePolicyRuleName"
+	MethodResolveTenantName               = "resolveTenantName"
+	MethodResolveVolumeName               = "resolveVolumeName"
+	MethodResumeTask                      = "resumeTask"
+	MethodRetryTask                       = "retryTask"
+	MethodRevokeCertificate               = "revokeCertificate"
+	MethodSetCertificateOwner             = "setCertificateOwner"
+	MethodSetCertificateSubject           = "setCertificateSubject"
+	MethodSetConfiguration                = "setConfiguration"
+	MethodSetEncryptedVolumeKey           = "setEncryptedVolumeKey"
+	MethodSetLabels                       = "setLabels"
+	MethodSetLicenseKey                   = "setLicenseKey"
+	MethodSetNotificationRule             = "setNotificationRule"
+	MethodSetQuota                        = "setQuota"
+	MethodSetTenant                       = "setTenant"
+	MethodSilenceAlert                    = "silenceAlert"
+	MethodStartNetworkTest                = "startNetworkTest"
+	MethodTriggerVolumeCheckpoint         = "triggerVolumeCheckpoint"
+	MethodUnlockMasterKeystoreSlot        = "unlockMasterKeystoreSlot"
+	MethodUnpublishBucketVolume           = "unpublishBucketVolume"
+	MethodUpdateDevice                    = "updateDevice"
+	MethodUpdatePolicyRules               = "updatePolicyRules"
+	MethodUpdateUser                      = "updateUser"
+	MethodUpdateVolume                    = "updateVolume"
+	MethodVerifyLicense                   = "verifyLicense"
+	MethodWhoAmI                          = "whoAmI"
+)
+
+// minimumVersions are the first Quobyte releases that have the methods, as far as they are known.
+var minimumVersions = map[string]string{}
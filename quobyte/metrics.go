@@ -0,0 +1,209 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBuckets are the upper bounds in seconds of the call latency histogram.
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Metrics records statistics about the calls of one or more clients and exposes them in the
+// Prometheus text format. It does not depend on the Prometheus client library, serve it with
+// http.Handle("/metrics", metrics).
+type Metrics struct {
+	buckets []float64
+
+	mu                sync.Mutex
+	calls             map[string]uint64
+	errors            map[metricErrorKey]uint64
+	latencies         map[string]*histogram
+	reauthentications uint64
+	inFlight          int64
+}
+
+type metricErrorKey struct {
+	method string
+	code   string
+}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewMetrics creates metrics with the given latency histogram buckets in seconds. No buckets
+// means DefaultLatencyBuckets.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Metrics{
+		buckets:   buckets,
+		calls:     map[string]uint64{},
+		errors:    map[metricErrorKey]uint64{},
+		latencies: map[string]*histogram{},
+	}
+}
+
+// SetMetrics makes the client record its calls in metrics. nil disables the recording.
+func (client *QuobyteClient) SetMetrics(metrics *Metrics) {
+	client.metrics = metrics
+}
+
+// WithMetrics makes the client record its calls in metrics, see SetMetrics.
+func WithMetrics(metrics *Metrics) Option {
+	return func(opts *clientOptions) error {
+		opts.metrics = metrics
+		return nil
+	}
+}
+
+func (metrics *Metrics) observeCall(method string, duration time.Duration, err error) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	metrics.calls[method]++
+	if err != nil {
+		metrics.errors[metricErrorKey{method: method, code: errorCode(err)}]++
+	}
+	latency, ok := metrics.latencies[method]
+	if !ok {
+		latency = &histogram{counts: make([]uint64, len(metrics.buckets))}
+		metrics.latencies[method] = latency
+	}
+	seconds := duration.Seconds()
+	for i, bound := range metrics.buckets {
+		if seconds <= bound {
+			latency.counts[i]++
+		}
+	}
+	latency.sum += seconds
+	latency.count++
+}
+
+func (metrics *Metrics) observeReauthentication() {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	metrics.reauthentications++
+}
+
+func (metrics *Metrics) addInFlight(delta int64) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	metrics.inFlight += delta
+}
+
+// errorCode returns the value of the code label for err: the JSON-RPC error code, the HTTP status
+// or the kind of transport failure.
+func errorCode(err error) string {
+	var rpcErr *RPCError
+	switch {
+	case errors.As(err, &rpcErr) && rpcErr.Code != 0:
+		return strconv.FormatInt(rpcErr.Code, 10)
+	case errors.As(err, &rpcErr):
+		return "http_" + strconv.Itoa(rpcErr.HTTPStatus)
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	}
+	return "transport"
+}
+
+// withMetrics records the call made by send.
+func (client *QuobyteClient) withMetrics(method string, send func() error) error {
+	if client.metrics == nil {
+		return send()
+	}
+	start := time.Now()
+	err := send()
+	client.metrics.observeCall(method, time.Since(start), err)
+	return err
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (metrics *Metrics) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	metrics.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format.
+func (metrics *Metrics) WriteTo(w io.Writer) (int64, error) {
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	var b strings.Builder
+
+	b.WriteString("# HELP quobyte_api_calls_total Number of API calls by JSON-RPC method.\n")
+	b.WriteString("# TYPE quobyte_api_calls_total counter\n")
+	for _, method := range sortedKeys(metrics.calls) {
+		fmt.Fprintf(&b, "quobyte_api_calls_total{method=\"%s\"} %d\n", escapeLabel(method), metrics.calls[method])
+	}
+
+	b.WriteString("# HELP quobyte_api_call_errors_total Number of failed API calls by JSON-RPC method and error code.\n")
+	b.WriteString("# TYPE quobyte_api_call_errors_total counter\n")
+	errorKeys := make([]metricErrorKey, 0, len(metrics.errors))
+	for key := range metrics.errors {
+		errorKeys = append(errorKeys, key)
+	}
+	sort.Slice(errorKeys, func(i, j int) bool {
+		if errorKeys[i].method != errorKeys[j].method {
+			return errorKeys[i].method < errorKeys[j].method
+		}
+		return errorKeys[i].code < errorKeys[j].code
+	})
+	for _, key := range errorKeys {
+		fmt.Fprintf(&b, "quobyte_api_call_errors_total{method=\"%s\",code=\"%s\"} %d\n",
+			escapeLabel(key.method), escapeLabel(key.code), metrics.errors[key])
+	}
+
+	b.WriteString("# HELP quobyte_api_call_duration_seconds Latency of API calls including retries.\n")
+	b.WriteString("# TYPE quobyte_api_call_duration_seconds histogram\n")
+	for _, method := range sortedKeys(metrics.latencies) {
+		latency := metrics.latencies[method]
+		label := escapeLabel(method)
+		for i, bound := range metrics.buckets {
+			fmt.Fprintf(&b, "quobyte_api_call_duration_seconds_bucket{method=\"%s\",le=\"%s\"} %d\n",
+				label, strconv.FormatFloat(bound, 'g', -1, 64), latency.counts[i])
+		}
+		fmt.Fprintf(&b, "quobyte_api_call_duration_seconds_bucket{method=\"%s\",le=\"+Inf\"} %d\n", label, latency.count)
+		fmt.Fprintf(&b, "quobyte_api_call_duration_seconds_sum{method=\"%s\"} %s\n", label, strconv.FormatFloat(latency.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "quobyte_api_call_duration_seconds_count{method=\"%s\"} %d\n", label, latency.count)
+	}
+
+	b.WriteString("# HELP quobyte_api_reauthentications_total Number of sessions rejected by the API service.\n")
+	b.WriteString("# TYPE quobyte_api_reauthentications_total counter\n")
+	fmt.Fprintf(&b, "quobyte_api_reauthentications_total %d\n", metrics.reauthentications)
+
+	b.WriteString("# HELP quobyte_api_requests_in_flight Number of HTTP requests currently sent to the API service.\n")
+	b.WriteString("# TYPE quobyte_api_requests_in_flight gauge\n")
+	fmt.Fprintf(&b, "quobyte_api_requests_in_flight %d\n", metrics.inFlight)
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
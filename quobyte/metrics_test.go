@@ -0,0 +1,69 @@
+package quobyte
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch atomic.AddInt64(&requests, 1) {
+		case 1:
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+			w.Write(rpcResponse(req, `{"result":{}}`))
+		case 2:
+			// session rejected, client logs in again
+			w.WriteHeader(401)
+		case 3:
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "2"})
+			w.Write(rpcResponse(req, `{"error":{"code":-32601,"message":""}}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	metrics := NewMetrics(0.1, 1)
+	client, err := NewClient(srv.URL, WithMetrics(metrics))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryConfig(NoRetries())
+	client.GetTenant(&GetTenantRequest{})
+	client.GetTenant(&GetTenantRequest{})
+	client.CreateVolume(&CreateVolumeRequest{Name: "vol"})
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	if got := recorder.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/plain; version=0.0.4") {
+		t.Errorf("Unexpected content type: %s", got)
+	}
+	output := recorder.Body.String()
+	for _, expected := range []string{
+		"# TYPE quobyte_api_calls_total counter\n",
+		"quobyte_api_calls_total{method=\"createVolume\"} 1\n",
+		"quobyte_api_calls_total{method=\"getTenant\"} 2\n",
+		"quobyte_api_call_errors_total{method=\"createVolume\",code=\"http_502\"} 1\n",
+		"quobyte_api_call_errors_total{method=\"getTenant\",code=\"-32601\"} 1\n",
+		"# TYPE quobyte_api_call_duration_seconds histogram\n",
+		"quobyte_api_call_duration_seconds_bucket{method=\"getTenant\",le=\"1\"} 2\n",
+		"quobyte_api_call_duration_seconds_bucket{method=\"getTenant\",le=\"+Inf\"} 2\n",
+		"quobyte_api_call_duration_seconds_count{method=\"getTenant\"} 2\n",
+		"quobyte_api_reauthentications_total 1\n",
+		"quobyte_api_requests_in_flight 0\n",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in output:\n%s", expected, output)
+		}
+	}
+}
+
+func TestEscapeLabel(t *testing.T) {
+	if got, want := escapeLabel("a\"b\\c\nd"), `a\"b\\c\nd`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,241 @@
+package quobyte
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Option configures a client created with NewClient.
+type Option func(*clientOptions) error
+
+type clientOptions struct {
+	authenticator      Authenticator
+	endpoints          []string
+	httpClient         *http.Client
+	rootCAs            *x509.CertPool
+	certificates       []tls.Certificate
+	insecureSkipVerify bool
+	timeout            time.Duration
+	proxy              func(*http.Request) (*url.URL, error)
+	userAgent          string
+	interceptors       []Interceptor
+	metrics            *Metrics
+	logger             *slog.Logger
+	strict             bool
+	validateEnums      bool
+	validateRequests   bool
+	limits             []func(*QuobyteClient)
+	breaker            *BreakerConfig
+}
+
+// WithCredentials sets the user name and password used to log in to the API service.
+func WithCredentials(username, password string) Option {
+	return WithAuthenticator(BasicAuth(username, password))
+}
+
+// WithAuthenticator sets how the client logs in to the API service, see Authenticator.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(opts *clientOptions) error {
+		opts.authenticator = authenticator
+		return nil
+	}
+}
+
+// WithEndpoints adds further API endpoints the client fails over to.
+func WithEndpoints(urls ...string) Option {
+	return func(opts *clientOptions) error {
+		opts.endpoints = append(opts.endpoints, urls...)
+		return nil
+	}
+}
+
+// WithCACertificates trusts the PEM encoded CA certificates instead of the system roots.
+func WithCACertificates(pem []byte) Option {
+	return func(opts *clientOptions) error {
+		if opts.rootCAs == nil {
+			opts.rootCAs = x509.NewCertPool()
+		}
+		if !opts.rootCAs.AppendCertsFromPEM(pem) {
+			return errors.New("could not parse CA certificates")
+		}
+		return nil
+	}
+}
+
+// WithCAFile trusts the CA certificates of the PEM encoded bundle instead of the system roots.
+func WithCAFile(path string) Option {
+	return func(opts *clientOptions) error {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read CA bundle due to %s", err.Error())
+		}
+		return WithCACertificates(pem)(opts)
+	}
+}
+
+// WithClientCertificate authenticates the client with the given certificate (mTLS).
+func WithClientCertificate(certificate tls.Certificate) Option {
+	return func(opts *clientOptions) error {
+		opts.certificates = append(opts.certificates, certificate)
+		return nil
+	}
+}
+
+// WithClientCertificateFile authenticates the client with the PEM encoded certificate and key (mTLS).
+func WithClientCertificateFile(certFile, keyFile string) Option {
+	return func(opts *clientOptions) error {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("could not load client certificate due to %s", err.Error())
+		}
+		return WithClientCertificate(certificate)(opts)
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server certificate.
+// Only use it for lab setups.
+func WithInsecureSkipVerify() Option {
+	return func(opts *clientOptions) error {
+		opts.insecureSkipVerify = true
+		return nil
+	}
+}
+
+// WithTimeout limits the time a single request may take, including reading the response.
+func WithTimeout(timeout time.Duration) Option {
+	return func(opts *clientOptions) error {
+		opts.timeout = timeout
+		return nil
+	}
+}
+
+// WithProxy sends all requests through the given HTTP proxy.
+func WithProxy(proxyURL string) Option {
+	return func(opts *clientOptions) error {
+		url, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("could not parse proxy url due to %s", err.Error())
+		}
+		opts.proxy = http.ProxyURL(url)
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of all requests.
+func WithUserAgent(userAgent string) Option {
+	return func(opts *clientOptions) error {
+		opts.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithHTTPClient uses a copy of the given http.Client for all requests. TLS and proxy options are
+// applied to its transport, which must be nil or an *http.Transport in that case.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(opts *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+		opts.httpClient = httpClient
+		return nil
+	}
+}
+
+func (opts *clientOptions) buildHTTPClient() (*http.Client, error) {
+	httpClient := &http.Client{}
+	if opts.httpClient != nil {
+		clone := *opts.httpClient
+		httpClient = &clone
+	}
+	if opts.timeout > 0 {
+		httpClient.Timeout = opts.timeout
+	}
+	if opts.rootCAs == nil && len(opts.certificates) == 0 && !opts.insecureSkipVerify && opts.proxy == nil {
+		return httpClient, nil
+	}
+
+	var transport *http.Transport
+	switch t := httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, fmt.Errorf("TLS and proxy options require an *http.Transport, got %T", t)
+	}
+	if opts.rootCAs != nil || len(opts.certificates) > 0 || opts.insecureSkipVerify {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		if opts.rootCAs != nil {
+			transport.TLSClientConfig.RootCAs = opts.rootCAs
+		}
+		if len(opts.certificates) > 0 {
+			transport.TLSClientConfig.Certificates = opts.certificates
+		}
+		if opts.insecureSkipVerify {
+			transport.TLSClientConfig.InsecureSkipVerify = true
+		}
+	}
+	if opts.proxy != nil {
+		transport.Proxy = opts.proxy
+	}
+	httpClient.Transport = transport
+	return httpClient, nil
+}
+
+func validateURL(urlStr string) error {
+	url, err := url.Parse(urlStr)
+	if err != nil {
+		return fmt.Errorf("could not parse url due to %s", err.Error())
+	}
+	if (url.Scheme != "http" && url.Scheme != "https") || url.Host == "" {
+		return fmt.Errorf("invalid API url %q, expected http(s)://host:port", urlStr)
+	}
+	return nil
+}
+
+// NewClient creates a new Quobyte API client for the API service at urlStr.
+func NewClient(urlStr string, options ...Option) (*QuobyteClient, error) {
+	var opts clientOptions
+	for _, option := range options {
+		if err := option(&opts); err != nil {
+			return nil, err
+		}
+	}
+	urls := append([]string{urlStr}, opts.endpoints...)
+	for _, url := range urls {
+		if err := validateURL(url); err != nil {
+			return nil, err
+		}
+	}
+	httpClient, err := opts.buildHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	client, err := newQuobyteClient(urls, opts.authenticator, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	client.userAgent = opts.userAgent
+	client.interceptors = opts.interceptors
+	client.metrics = opts.metrics
+	client.logger = opts.logger
+	client.strict = opts.strict
+	client.validateEnums = opts.validateEnums
+	client.validateRequests = opts.validateRequests
+	for _, setLimit := range opts.limits {
+		setLimit(client)
+	}
+	if opts.breaker != nil {
+		client.SetCircuitBreaker(*opts.breaker)
+	}
+	return client, nil
+}
@@ -0,0 +1,210 @@
+package quobyte
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func okHandler(w http.ResponseWriter, req *http.Request) {
+	http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+	w.Write(rpcResponse(req, `{"result":{}}`))
+}
+
+// newTLSServer starts a TLS server that does not log failed handshakes.
+func newTLSServer(t *testing.T, config *tls.Config) *httptest.Server {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(okHandler))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.TLS = config
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func certificatePEM(srv *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+}
+
+func TestNewClientInvalidURL(t *testing.T) {
+	for _, url := range []string{"", "localhost", "ftp://host", "http://"} {
+		if _, err := NewClient(url); err == nil {
+			t.Errorf("Expected error for url %q", url)
+		}
+	}
+	if _, err := NewClient("http://localhost:7860", WithEndpoints("::")); err == nil {
+		t.Errorf("Expected error for invalid endpoint")
+	}
+}
+
+func TestNewClientWithCACertificates(t *testing.T) {
+	srv := newTLSServer(t, nil)
+
+	client, err := NewClient(srv.URL, WithCredentials("user", "pw"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err == nil {
+		t.Fatal("Expected certificate verification to fail")
+	}
+
+	client, err = NewClient(srv.URL, WithCredentials("user", "pw"), WithCACertificates(certificatePEM(srv)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := NewClient(srv.URL, WithCACertificates([]byte("garbage"))); err == nil {
+		t.Fatal("Expected error for invalid CA certificates")
+	}
+}
+
+func TestNewClientWithInsecureSkipVerify(t *testing.T) {
+	srv := newTLSServer(t, nil)
+
+	client, err := NewClient(srv.URL, WithInsecureSkipVerify())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func newClientCertificate(t *testing.T) (tls.Certificate, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, certificate
+}
+
+func TestNewClientWithClientCertificate(t *testing.T) {
+	certificate, parsed := newClientCertificate(t)
+	pool := x509.NewCertPool()
+	pool.AddCert(parsed)
+
+	srv := newTLSServer(t, &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool})
+
+	client, err := NewClient(srv.URL, WithCACertificates(certificatePEM(srv)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err == nil {
+		t.Fatal("Expected handshake without client certificate to fail")
+	}
+
+	client, err = NewClient(srv.URL, WithCACertificates(certificatePEM(srv)), WithClientCertificate(certificate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestNewClientWithTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-req.Context().Done():
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(srv.URL, WithTimeout(20*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetRetryConfig(NoRetries())
+	var netErr interface{ Timeout() bool }
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("Expected timeout, got %v", err)
+	}
+}
+
+func TestNewClientWithUserAgentAndProxy(t *testing.T) {
+	var userAgent, requestURI string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		userAgent = req.UserAgent()
+		requestURI = req.RequestURI
+		okHandler(w, req)
+	}))
+	defer proxy.Close()
+
+	client, err := NewClient("http://quobyte-api.invalid:7860", WithProxy(proxy.URL), WithUserAgent("my-controller/1.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if userAgent != "my-controller/1.0" {
+		t.Errorf("Unexpected User-Agent: %s", userAgent)
+	}
+	if requestURI != "http://quobyte-api.invalid:7860/" {
+		t.Errorf("Request was not sent through proxy: %s", requestURI)
+	}
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (transport *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(okHandler))
+	defer srv.Close()
+
+	transport := &countingTransport{}
+	httpClient := &http.Client{Transport: transport}
+	client, err := NewClient(srv.URL, WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WhoAmI(&WhoAmIRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if transport.requests != 1 {
+		t.Errorf("Expected request through custom client, got %d", transport.requests)
+	}
+	if httpClient.Jar != nil {
+		t.Errorf("Given http client must not be modified")
+	}
+
+	if _, err := NewClient(srv.URL, WithHTTPClient(httpClient), WithInsecureSkipVerify()); err == nil {
+		t.Errorf("Expected error for TLS option with custom transport")
+	}
+}
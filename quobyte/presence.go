@@ -0,0 +1,118 @@
+package quobyte
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// presence records the fields of a request that are explicitly set. All fields are omitted from
+// the JSON if they have the zero value, so false, 0 and empty messages need to be marked to reach
+// the API service. The fields are kept behind a pointer, so that requests stay comparable with ==.
+// SetExplicit replaces the list instead of appending to it, so that copies of a request are not
+// changed by marking fields of another copy.
+type presence struct {
+	explicit *[]string
+}
+
+// SetExplicit marks fields as set, they are sent even if they have the zero value, e.g.
+// SetExplicit("draining") sends "draining": false. Fields are named by their JSON name, fields of
+// nested messages by their path such as "rebalance_settings.restrict_time". The other fields are
+// encoded as without SetExplicit.
+func (p *presence) SetExplicit(paths ...string) {
+	explicit := append(append([]string(nil), p.Explicit()...), paths...)
+	p.explicit = &explicit
+}
+
+// Explicit returns the fields marked by SetExplicit.
+func (p *presence) Explicit() []string {
+	if p.explicit == nil {
+		return nil
+	}
+	return *p.explicit
+}
+
+// marshal encodes the request, a pointer to the request struct without MarshalJSON, with the
+// explicitly set fields.
+func (p *presence) marshal(request interface{}) ([]byte, error) {
+	data, err := json.Marshal(request)
+	if err != nil || len(p.Explicit()) == 0 {
+		return data, err
+	}
+	var object map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	for _, path := range p.Explicit() {
+		if err := setExplicit(object, reflect.ValueOf(request).Elem(), strings.Split(path, ".")); err != nil {
+			return nil, fmt.Errorf("explicit field %s: %w", path, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// setExplicit adds the field at path of the struct value to object unless it is present.
+func setExplicit(object map[string]interface{}, value reflect.Value, path []string) error {
+	field, ok := fieldByJSONName(value, path[0])
+	if !ok {
+		return fmt.Errorf("%s has no field %s", value.Type().Name(), path[0])
+	}
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field = reflect.Zero(field.Type().Elem())
+		} else {
+			field = field.Elem()
+		}
+	}
+	if len(path) > 1 {
+		if field.Kind() != reflect.Struct {
+			return fmt.Errorf("%s is not a message", path[0])
+		}
+		nested, ok := object[path[0]].(map[string]interface{})
+		if !ok {
+			nested = map[string]interface{}{}
+			object[path[0]] = nested
+		}
+		return setExplicit(nested, field, path[1:])
+	}
+	if _, ok := object[path[0]]; ok {
+		return nil
+	}
+	switch {
+	case field.Kind() == reflect.Slice && field.IsNil():
+		field = reflect.MakeSlice(field.Type(), 0, 0)
+	case field.Kind() == reflect.Map && field.IsNil():
+		field = reflect.MakeMap(field.Type())
+	}
+	data, err := json.Marshal(field.Interface())
+	if err != nil {
+		return err
+	}
+	object[path[0]] = json.RawMessage(data)
+	return nil
+}
+
+// fieldByJSONName returns the field of the struct value with the JSON name, including fields of
+// embedded structs.
+func fieldByJSONName(value reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if found, ok := fieldByJSONName(value.Field(i), name); ok {
+				return found, true
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if jsonName, _, _ := strings.Cut(field.Tag.Get("json"), ","); jsonName == name {
+			return value.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
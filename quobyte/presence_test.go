@@ -0,0 +1,97 @@
+package quobyte
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSetExplicit(t *testing.T) {
+	request := &UpdateDeviceRequest{DeviceId: 3}
+	data, err := json.Marshal(request)
+	if err != nil || string(data) != `{"device_id":3,"set_device_health":{}}` {
+		t.Errorf("Unexpected JSON without explicit fields: %s, %v", data, err)
+	}
+	request.SetExplicit("draining", "device_tags")
+	data, err = json.Marshal(request)
+	// other fields are encoded as without explicit fields
+	if err != nil || string(data) != `{"device_id":3,"device_tags":[],"draining":false,"set_device_health":{}}` {
+		t.Errorf("Unexpected JSON with explicit fields: %s, %v", data, err)
+	}
+
+	task := &CreateTaskRequest{TaskType: TaskType_REBALANCE}
+	task.SetExplicit("rebalance_settings.underutilized_threshold_percentage", "scrub_settings")
+	data, err = json.Marshal(task)
+	expected := `{"catch_up_settings":{},"copy_files_settings":{},"delete_files_settings":{},"make_device_settings":{},` +
+		`"rebalance_settings":{"underutilized_threshold_percentage":0},"scrub_settings":{},"task_type":"REBALANCE"}`
+	if err != nil || string(data) != expected {
+		t.Errorf("Unexpected JSON of nested explicit fields: %s, %v", data, err)
+	}
+
+	task.SetExplicit("rebalance_settings.no_such_field")
+	if _, err := json.Marshal(task); err == nil || !strings.Contains(err.Error(), "RebalanceSettings has no field no_such_field") {
+		t.Errorf("Expected error for unknown field, got %v", err)
+	}
+}
+
+func TestRequestsAreComparable(t *testing.T) {
+	for _, request := range []interface{}{ResolveVolumeNameRequest{}, EraseVolumeRequest{}, AddCsrRequest{}, CertificateSigningRequest{}} {
+		if !reflect.TypeOf(request).Comparable() {
+			t.Errorf("%T is not comparable", request)
+		}
+	}
+	request := EraseVolumeRequest{VolumeUuid: "c858ffe2-4fa1-4c78-adbf-54c211734883"}
+	if copied := request; copied != request {
+		t.Errorf("Unexpected difference of copied request")
+	}
+	request.SetExplicit("force")
+	if request == (EraseVolumeRequest{VolumeUuid: "c858ffe2-4fa1-4c78-adbf-54c211734883"}) {
+		t.Errorf("Request with explicit fields equals request without")
+	}
+	// only params of methods track explicit fields
+	if _, ok := interface{}(&CertificateSigningRequest{}).(json.Marshaler); ok {
+		t.Errorf("CertificateSigningRequest must not have MarshalJSON")
+	}
+}
+
+func TestSetExplicitDoesNotChangeCopies(t *testing.T) {
+	template := EraseVolumeRequest{}
+	template.SetExplicit("volume_uuid")
+	request := template
+	request.SetExplicit("force")
+	if explicit := template.Explicit(); !reflect.DeepEqual(explicit, []string{"volume_uuid"}) {
+		t.Errorf("Template was changed by its copy: %v", explicit)
+	}
+	if explicit := request.Explicit(); !reflect.DeepEqual(explicit, []string{"volume_uuid", "force"}) {
+		t.Errorf("Unexpected explicit fields of the copy: %v", explicit)
+	}
+}
+
+func TestSetExplicitIsSent(t *testing.T) {
+	var params string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var rpcRequest struct {
+			ID     string          `json:"id"`
+			Params json.RawMessage `json:"params"`
+		}
+		json.NewDecoder(req.Body).Decode(&rpcRequest)
+		params = string(rpcRequest.Params)
+		w.Write([]byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `","result":{}}`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := &CreateSnapshotRequest{VolumeUuid: "c858ffe2-4fa1-4c78-adbf-54c211734883", Name: "daily"}
+	request.SetExplicit("pinned")
+	if _, err := client.CreateSnapshot(request); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(params, `"pinned":false`) || !strings.Contains(params, `"retry":"INTERACTIVE"`) {
+		t.Errorf("Unexpected params: %s", params)
+	}
+}
@@ -1,7 +1,11 @@
 package quobyte
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
@@ -17,29 +21,65 @@ const (
 var UUIDValidator = regexp.MustCompile("^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$")
 
 type QuobyteClient struct {
-	client         *http.Client
-	url            *url.URL
-	username       string
-	password       string
-	apiRetryPolicy string
+	client           *http.Client
+	endpoints        *endpointPool
+	authenticator    Authenticator
+	apiRetryPolicy   string
+	userAgent        string
+	retryConfig      RetryConfig
+	idempotency      map[string]Idempotency
+	interceptors     []Interceptor
+	metrics          *Metrics
+	logger           *slog.Logger
+	strict           bool
+	validateEnums    bool
+	validateRequests bool
+	limits           *limits
+	breaker          *circuitBreaker
 }
 
 // Extended ExtendedQuobyteApi with some utility methods - such as resolve volume name to uuid before
 // invoking ExtendedQuobyteApi etc
 //
+//go:generate go run ../cmd/quobyte-gen -root ..
 //go:generate mockgen -package=mocks -destination  ../mocks/mock_quobyte_api.go github.com/quobyte/api/quobyte ExtendedQuobyteApi
+//go:generate mockgen -package=mocks -destination ../mocks/mock_volume_api.go github.com/quobyte/api/quobyte VolumeAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_snapshot_api.go github.com/quobyte/api/quobyte SnapshotAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_tenant_api.go github.com/quobyte/api/quobyte TenantAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_quota_api.go github.com/quobyte/api/quobyte QuotaAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_label_api.go github.com/quobyte/api/quobyte LabelAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_task_api.go github.com/quobyte/api/quobyte TaskAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_device_api.go github.com/quobyte/api/quobyte DeviceAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_policy_api.go github.com/quobyte/api/quobyte PolicyAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_configuration_api.go github.com/quobyte/api/quobyte ConfigurationAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_security_api.go github.com/quobyte/api/quobyte SecurityAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_user_api.go github.com/quobyte/api/quobyte UserAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_monitoring_api.go github.com/quobyte/api/quobyte MonitoringAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_license_api.go github.com/quobyte/api/quobyte LicenseAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_cluster_api.go github.com/quobyte/api/quobyte ClusterAPI
+//go:generate mockgen -package=mocks -destination ../mocks/mock_support_api.go github.com/quobyte/api/quobyte SupportAPI
 type ExtendedQuobyteApi interface {
 	QuobyteApi
 	GetVolumeUUID(volume, tenant string) (string, error)
+	GetVolumeUUIDContext(ctx context.Context, volume, tenant string) (string, error)
 	GetTenantUUID(tenant string) (string, error)
+	GetTenantUUIDContext(ctx context.Context, tenant string) (string, error)
 	ResolveVolumeNameToUUID(volumeName, tenant string) (string, error)
+	ResolveVolumeNameToUUIDContext(ctx context.Context, volumeName, tenant string) (string, error)
 	DeleteVolumeByResolvingNamesToUUID(volume, tenant string) error
+	DeleteVolumeByResolvingNamesToUUIDContext(ctx context.Context, volume, tenant string) error
 	DeleteVolumeByName(volumeName, tenant string) error
+	DeleteVolumeByNameContext(ctx context.Context, volumeName, tenant string) error
 	EraseVolumeByResolvingNamesToUUID(volume, tenant string, force bool) error
+	EraseVolumeByResolvingNamesToUUIDContext(ctx context.Context, volume, tenant string, force bool) error
 	EraseVolumeByResolvingNamesToUUID_2X(volume, tenant string) error
+	EraseVolumeByResolvingNamesToUUID_2XContext(ctx context.Context, volume, tenant string) error
 	SetVolumeQuota(volumeUUID string, quotaSize int64) error
+	SetVolumeQuotaContext(ctx context.Context, volumeUUID string, quotaSize int64) error
 	GetTenantMap() (map[string]string, error)
+	GetTenantMapContext(ctx context.Context) (map[string]string, error)
 	ResolveTenantNameToUUID(name string) (string, error)
+	ResolveTenantNameToUUIDContext(ctx context.Context, name string) (string, error)
 	SetAPIRetryPolicy(retry string)
 	GetAPIRetryPolicy() string
 	SetTransport(t http.RoundTripper)
@@ -48,10 +88,6 @@ type ExtendedQuobyteApi interface {
 // compile time check for interface compatibility
 var _ ExtendedQuobyteApi = &QuobyteClient{}
 
-func (client *QuobyteClient) hasCookies() (bool, error) {
-	return client.client.Jar != nil && len(client.client.Jar.Cookies(client.url)) > 0, nil
-}
-
 func (client *QuobyteClient) SetAPIRetryPolicy(retry string) {
 	client.apiRetryPolicy = retry
 }
@@ -64,34 +100,68 @@ func (client *QuobyteClient) SetTransport(t http.RoundTripper) {
 	client.client.Transport = t
 }
 
-// NewQuobyteClient creates a new Quobyte API client
+// NewQuobyteClient creates a new Quobyte API client.
+// It terminates the program if the client cannot be created, prefer NewClient to handle the error.
 func NewQuobyteClient(urlStr string, username string, password string) *QuobyteClient {
-	url, err := url.Parse(urlStr)
+	client, err := newQuobyteClient([]string{urlStr}, BasicAuth(username, password), &http.Client{})
 	if err != nil {
-		log.Fatalf("could not parse url due to %s", err.Error())
+		log.Fatal(err.Error())
 	}
-	cookieJar, err := cookiejar.New(nil)
-	if err != nil {
-		log.Fatalf("could not initialize cookie jar due to %s", err.Error())
+	return client
+}
+
+// NewQuobyteClientWithEndpoints creates a new Quobyte API client that fails over between the
+// given API endpoints. Calls are sent to one endpoint until it fails with a connection error or
+// a 5xx response.
+func NewQuobyteClientWithEndpoints(urls []string, username string, password string) (*QuobyteClient, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("at least one API endpoint is required")
+	}
+	return NewClient(urls[0], WithCredentials(username, password), WithEndpoints(urls[1:]...))
+}
+
+func newQuobyteClient(urlStrs []string, authenticator Authenticator, httpClient *http.Client) (*QuobyteClient, error) {
+	urls := make([]*url.URL, 0, len(urlStrs))
+	for _, urlStr := range urlStrs {
+		url, err := url.Parse(urlStr)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse url due to %s", err.Error())
+		}
+		urls = append(urls, url)
 	}
-	return &QuobyteClient{
-		client:         &http.Client{Jar: cookieJar},
-		url:            url,
-		username:       username,
-		password:       password,
+	if httpClient.Jar == nil {
+		cookieJar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not initialize cookie jar due to %s", err.Error())
+		}
+		httpClient.Jar = cookieJar
+	}
+	client := &QuobyteClient{
+		client:         httpClient,
+		endpoints:      newEndpointPool(urls, httpClient.Jar),
+		authenticator:  authenticator,
 		apiRetryPolicy: RetryInteractive,
+		retryConfig:    DefaultRetryConfig(),
+		limits:         &limits{},
 	}
+	client.breaker = &circuitBreaker{client: client}
+	return client, nil
 }
 
 // GetVolumeUUID resolves the volumeUUID for the given volume and tenant name.
 // This method should be used when it is not clear if the given string is volume UUID or Name.
 func (client *QuobyteClient) GetVolumeUUID(volume, tenant string) (string, error) {
+	return client.GetVolumeUUIDContext(context.Background(), volume, tenant)
+}
+
+// GetVolumeUUIDContext is like GetVolumeUUID but uses ctx for the underlying requests.
+func (client *QuobyteClient) GetVolumeUUIDContext(ctx context.Context, volume, tenant string) (string, error) {
 	if len(volume) != 0 && !IsValidUUID(volume) {
-		tenantUUID, err := client.GetTenantUUID(tenant)
+		tenantUUID, err := client.GetTenantUUIDContext(ctx, tenant)
 		if err != nil {
 			return "", err
 		}
-		volUUID, err := client.ResolveVolumeNameToUUID(volume, tenantUUID)
+		volUUID, err := client.ResolveVolumeNameToUUIDContext(ctx, volume, tenantUUID)
 		if err != nil {
 			return "", err
 		}
@@ -104,8 +174,13 @@ func (client *QuobyteClient) GetVolumeUUID(volume, tenant string) (string, error
 // GetTenantUUID resolves the tenantUUID for the given name
 // This method should be used when it is not clear if the given string is Tenant UUID or Name.
 func (client *QuobyteClient) GetTenantUUID(tenant string) (string, error) {
+	return client.GetTenantUUIDContext(context.Background(), tenant)
+}
+
+// GetTenantUUIDContext is like GetTenantUUID but uses ctx for the underlying requests.
+func (client *QuobyteClient) GetTenantUUIDContext(ctx context.Context, tenant string) (string, error) {
 	if len(tenant) != 0 && !IsValidUUID(tenant) {
-		tenantUUID, err := client.ResolveTenantNameToUUID(tenant)
+		tenantUUID, err := client.ResolveTenantNameToUUIDContext(ctx, tenant)
 		if err != nil {
 			return "", err
 		}
@@ -114,16 +189,28 @@ func (client *QuobyteClient) GetTenantUUID(tenant string) (string, error) {
 	return tenant, nil
 }
 
-// ResolveVolumeNameToUUID resolves a volume name to a UUID
+// ResolveVolumeNameToUUID resolves a volume name to a UUID.
+// If the volume does not exist, the returned error matches ErrNotFound.
 func (client *QuobyteClient) ResolveVolumeNameToUUID(volumeName, tenant string) (string, error) {
+	return client.ResolveVolumeNameToUUIDContext(context.Background(), volumeName, tenant)
+}
+
+// ResolveVolumeNameToUUIDContext is like ResolveVolumeNameToUUID but uses ctx for the request.
+func (client *QuobyteClient) ResolveVolumeNameToUUIDContext(ctx context.Context, volumeName, tenant string) (string, error) {
 	request := &ResolveVolumeNameRequest{
 		VolumeName:   volumeName,
 		TenantDomain: tenant,
 	}
 	var response ResolveVolumeNameResponse
-	if err := client.sendRequest("resolveVolumeName", request, &response); err != nil {
+	if err := client.sendRequest(ctx, "resolveVolumeName", request, &response); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", fmt.Errorf("volume %s in tenant %s: %w", volumeName, tenant, err)
+		}
 		return "", err
 	}
+	if response.VolumeUuid == "" {
+		return "", fmt.Errorf("volume %s in tenant %s: %w", volumeName, tenant, ErrNotFound)
+	}
 
 	return response.VolumeUuid, nil
 }
@@ -133,53 +220,83 @@ func (client *QuobyteClient) ResolveVolumeNameToUUID(volumeName, tenant string)
 // respective UUID if required.
 // This method should be used if the given volume, tenant information is name or UUID.
 func (client *QuobyteClient) DeleteVolumeByResolvingNamesToUUID(volume, tenant string) error {
-	volumeUUID, err := client.GetVolumeUUID(volume, tenant)
+	return client.DeleteVolumeByResolvingNamesToUUIDContext(context.Background(), volume, tenant)
+}
+
+// Deprecated: Use Erase variant of the method instead.
+// DeleteVolumeByResolvingNamesToUUIDContext is like DeleteVolumeByResolvingNamesToUUID but uses ctx
+// for the underlying requests.
+func (client *QuobyteClient) DeleteVolumeByResolvingNamesToUUIDContext(ctx context.Context, volume, tenant string) error {
+	volumeUUID, err := client.GetVolumeUUIDContext(ctx, volume, tenant)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.DeleteVolume(&DeleteVolumeRequest{VolumeUuid: volumeUUID})
+	_, err = client.DeleteVolumeContext(ctx, &DeleteVolumeRequest{VolumeUuid: volumeUUID})
 	return err
 }
 
 // Deprecated: Use Erase variant of the method instead.
 // DeleteVolumeByName deletes a volume by a given name
 func (client *QuobyteClient) DeleteVolumeByName(volumeName, tenant string) error {
-	uuid, err := client.ResolveVolumeNameToUUID(volumeName, tenant)
+	return client.DeleteVolumeByNameContext(context.Background(), volumeName, tenant)
+}
+
+// Deprecated: Use Erase variant of the method instead.
+// DeleteVolumeByNameContext is like DeleteVolumeByName but uses ctx for the underlying requests.
+func (client *QuobyteClient) DeleteVolumeByNameContext(ctx context.Context, volumeName, tenant string) error {
+	uuid, err := client.ResolveVolumeNameToUUIDContext(ctx, volumeName, tenant)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.DeleteVolume(&DeleteVolumeRequest{VolumeUuid: uuid})
+	_, err = client.DeleteVolumeContext(ctx, &DeleteVolumeRequest{VolumeUuid: uuid})
 	return err
 }
 
 // EraseVolumeByResolvingNamesToUUID Erases the volume by resolving the volume name and tenant name
 // to respective UUID if required. (Use only against Quobyte 3.x)
 func (client *QuobyteClient) EraseVolumeByResolvingNamesToUUID(volume, tenant string, force bool) error {
-	volumeUUID, err := client.GetVolumeUUID(volume, tenant)
+	return client.EraseVolumeByResolvingNamesToUUIDContext(context.Background(), volume, tenant, force)
+}
+
+// EraseVolumeByResolvingNamesToUUIDContext is like EraseVolumeByResolvingNamesToUUID but uses ctx
+// for the underlying requests.
+func (client *QuobyteClient) EraseVolumeByResolvingNamesToUUIDContext(ctx context.Context, volume, tenant string, force bool) error {
+	volumeUUID, err := client.GetVolumeUUIDContext(ctx, volume, tenant)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.EraseVolume(&EraseVolumeRequest{VolumeUuid: volumeUUID, Force: force})
+	_, err = client.EraseVolumeContext(ctx, &EraseVolumeRequest{VolumeUuid: volumeUUID, Force: force})
 	return err
 }
 
 // EraseVolumeByResolvingNamesToUUID_2X Erases the volume by resolving the volume name and tenant name
 // to respective UUID if required. (Use against Quobyte 2.x or 3.x)
 func (client *QuobyteClient) EraseVolumeByResolvingNamesToUUID_2X(volume, tenant string) error {
-	volumeUUID, err := client.GetVolumeUUID(volume, tenant)
+	return client.EraseVolumeByResolvingNamesToUUID_2XContext(context.Background(), volume, tenant)
+}
+
+// EraseVolumeByResolvingNamesToUUID_2XContext is like EraseVolumeByResolvingNamesToUUID_2X but uses ctx
+// for the underlying requests.
+func (client *QuobyteClient) EraseVolumeByResolvingNamesToUUID_2XContext(ctx context.Context, volume, tenant string) error {
+	volumeUUID, err := client.GetVolumeUUIDContext(ctx, volume, tenant)
 	if err != nil {
 		return err
 	}
 
-	_, err = client.EraseVolume(&EraseVolumeRequest{VolumeUuid: volumeUUID})
+	_, err = client.EraseVolumeContext(ctx, &EraseVolumeRequest{VolumeUuid: volumeUUID})
 	return err
 }
 
 // SetVolumeQuota sets a Quota to the specified Volume
 func (client *QuobyteClient) SetVolumeQuota(volumeUUID string, quotaSize int64) error {
+	return client.SetVolumeQuotaContext(context.Background(), volumeUUID, quotaSize)
+}
+
+// SetVolumeQuotaContext is like SetVolumeQuota but uses ctx for the request.
+func (client *QuobyteClient) SetVolumeQuotaContext(ctx context.Context, volumeUUID string, quotaSize int64) error {
 	request := &SetQuotaRequest{
 		Quotas: []*Quota{
 			{
@@ -199,13 +316,18 @@ func (client *QuobyteClient) SetVolumeQuota(volumeUUID string, quotaSize int64)
 		},
 	}
 
-	return client.sendRequest("setQuota", request, nil)
+	return client.sendRequest(ctx, "setQuota", request, nil)
 }
 
 // GetTenantMap returns a map that contains all tenant names and there ID's
 func (client *QuobyteClient) GetTenantMap() (map[string]string, error) {
+	return client.GetTenantMapContext(context.Background())
+}
+
+// GetTenantMapContext is like GetTenantMap but uses ctx for the request.
+func (client *QuobyteClient) GetTenantMapContext(ctx context.Context) (map[string]string, error) {
 	result := map[string]string{}
-	response, err := client.GetTenant(&GetTenantRequest{})
+	response, err := client.GetTenantContext(ctx, &GetTenantRequest{})
 
 	if err != nil {
 		return result, err
@@ -223,16 +345,27 @@ func IsValidUUID(uuid string) bool {
 	return UUIDValidator.MatchString(uuid)
 }
 
-// ResolveTenantNameToUUID Returns UUID for given name, error if not found.
+// ResolveTenantNameToUUID Returns UUID for given name, error matching ErrNotFound if not found.
 func (client *QuobyteClient) ResolveTenantNameToUUID(name string) (string, error) {
+	return client.ResolveTenantNameToUUIDContext(context.Background(), name)
+}
+
+// ResolveTenantNameToUUIDContext is like ResolveTenantNameToUUID but uses ctx for the request.
+func (client *QuobyteClient) ResolveTenantNameToUUIDContext(ctx context.Context, name string) (string, error) {
 	request := &ResolveTenantNameRequest{
 		TenantName: name,
 	}
 
 	var response ResolveTenantNameResponse
-	err := client.sendRequest("resolveTenantName", request, &response)
+	err := client.sendRequest(ctx, "resolveTenantName", request, &response)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", fmt.Errorf("tenant %s: %w", name, err)
+		}
 		return "", err
 	}
+	if response.TenantId == "" {
+		return "", fmt.Errorf("tenant %s: %w", name, ErrNotFound)
+	}
 	return response.TenantId, nil
 }
@@ -0,0 +1,181 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Idempotency classifies whether an RPC may be sent again after a failure.
+type Idempotency int
+
+const (
+	// Idempotent calls (Get*, List*, Resolve*, ...) are safe to retry on any transport failure.
+	Idempotent Idempotency = iota
+	// NonIdempotent calls (Create*, Erase*, ...) are only retried if the request provably did
+	// not reach the server, i.e. the connection could not be established.
+	NonIdempotent
+)
+
+// prefixes of the JSON-RPC methods that do not modify state on the server
+var idempotentMethodPrefixes = []string{"get", "list", "resolve", "dump", "filter", "whoAmI"}
+
+// export methods that only read, exportVolume changes the exports of a volume
+var idempotentMethods = map[string]bool{
+	"exportCertificate":   true,
+	"exportConfiguration": true,
+	"exportPolicyRules":   true,
+}
+
+// MethodIdempotency returns the default idempotency class of a JSON-RPC method such as "getVolumeList".
+func MethodIdempotency(method string) Idempotency {
+	if idempotentMethods[method] {
+		return Idempotent
+	}
+	for _, prefix := range idempotentMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return Idempotent
+		}
+	}
+	return NonIdempotent
+}
+
+// RetryConfig configures the client side retries of failed calls. These are independent of the
+// server side RetryPolicy set with SetAPIRetryPolicy.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts per call including the first one.
+	// A value <= 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier is applied to the delay after every attempt.
+	Multiplier float64
+	// Jitter randomizes every delay by +/- the given fraction (0 to 1).
+	Jitter float64
+	// MaxElapsedTime is the time budget for all attempts of a call, 0 means no limit.
+	MaxElapsedTime time.Duration
+}
+
+// DefaultRetryConfig returns the retry configuration used by new clients.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		MaxElapsedTime: 30 * time.Second,
+	}
+}
+
+// NoRetries returns a retry configuration that disables client side retries.
+func NoRetries() RetryConfig {
+	return RetryConfig{MaxAttempts: 1}
+}
+
+// backoff returns the delay before the given retry (starting at 1).
+func (config *RetryConfig) backoff(retry int) time.Duration {
+	multiplier := config.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(config.InitialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if config.MaxBackoff > 0 && delay > float64(config.MaxBackoff) {
+		delay = float64(config.MaxBackoff)
+	}
+	if config.Jitter > 0 {
+		delay += delay * config.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
+
+// SetRetryConfig sets the client side retry configuration.
+func (client *QuobyteClient) SetRetryConfig(config RetryConfig) {
+	client.retryConfig = config
+}
+
+// GetRetryConfig returns the client side retry configuration.
+func (client *QuobyteClient) GetRetryConfig() RetryConfig {
+	return client.retryConfig
+}
+
+// SetMethodIdempotency overrides the idempotency class of a JSON-RPC method.
+func (client *QuobyteClient) SetMethodIdempotency(method string, idempotency Idempotency) {
+	if client.idempotency == nil {
+		client.idempotency = map[string]Idempotency{}
+	}
+	client.idempotency[method] = idempotency
+}
+
+func (client *QuobyteClient) methodIdempotency(method string) Idempotency {
+	if idempotency, ok := client.idempotency[method]; ok {
+		return idempotency
+	}
+	return MethodIdempotency(method)
+}
+
+// withRetries calls send until it succeeds, fails with an error that is not retryable for an
+// (non-)idempotent call or the retry budget is exhausted.
+func (client *QuobyteClient) withRetries(ctx context.Context, idempotent bool, send func() error) error {
+	config := client.retryConfig
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		err := send()
+		if err == nil || attempt >= config.MaxAttempts || ctx.Err() != nil || !isRetryable(err, idempotent) {
+			return err
+		}
+		delay := config.backoff(attempt)
+		if config.MaxElapsedTime > 0 && time.Since(start)+delay > config.MaxElapsedTime {
+			return err
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
+
+func isRetryable(err error, idempotent bool) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		// the request was never sent
+		return true
+	}
+	if !idempotent {
+		return false
+	}
+	var rpcErr *RPCError
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.HTTPStatus {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	// *url.Error implements net.Error for all errors of http.Client, look at the cause
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
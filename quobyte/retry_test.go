@@ -0,0 +1,218 @@
+package quobyte
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type methodIdempotencyTest struct {
+	method   string
+	expected Idempotency
+}
+
+func TestMethodIdempotency(t *testing.T) {
+	tests := []*methodIdempotencyTest{
+		{method: "getVolumeList", expected: Idempotent},
+		{method: "listSnapshots", expected: Idempotent},
+		{method: "resolveVolumeName", expected: Idempotent},
+		{method: "whoAmI", expected: Idempotent},
+		{method: "createVolume", expected: NonIdempotent},
+		{method: "eraseVolume", expected: NonIdempotent},
+		{method: "setQuota", expected: NonIdempotent},
+		{method: "exportConfiguration", expected: Idempotent},
+		{method: "exportVolume", expected: NonIdempotent},
+	}
+
+	for _, test := range tests {
+		if got := MethodIdempotency(test.method); got != test.expected {
+			t.Errorf("%s: got %v, want %v", test.method, got, test.expected)
+		}
+	}
+}
+
+func fastRetries() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+}
+
+// newFlakyServer fails the first failures requests with the given status.
+func newFlakyServer(t *testing.T, failures int64, status int) (*httptest.Server, *int64) {
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt64(&requests, 1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		w.Write(rpcResponse(req, `{"result":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestRetryIdempotentCall(t *testing.T) {
+	srv, requests := newFlakyServer(t, 2, http.StatusServiceUnavailable)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(fastRetries())
+
+	if _, err := client.GetVolumeList(&GetVolumeListRequest{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt64(requests); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	srv, requests := newFlakyServer(t, 5, http.StatusBadGateway)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(fastRetries())
+
+	_, err := client.GetVolumeList(&GetVolumeListRequest{})
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.HTTPStatus != http.StatusBadGateway {
+		t.Fatalf("Expected 502 error, got %v", err)
+	}
+	if got := atomic.LoadInt64(requests); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+}
+
+func TestNoRetryOfNonIdempotentCall(t *testing.T) {
+	srv, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(fastRetries())
+
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err == nil {
+		t.Fatal("Expected error")
+	}
+	if got := atomic.LoadInt64(requests); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+func TestRetryWithIdempotencyOverride(t *testing.T) {
+	srv, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(fastRetries())
+	client.SetMethodIdempotency("createVolume", Idempotent)
+
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt64(requests); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
+
+func TestNoRetryOfApplicationError(t *testing.T) {
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		w.Write(rpcResponse(req, `{"error":{"code":-32601,"message":""}}`))
+	}))
+	defer srv.Close()
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(fastRetries())
+
+	if _, err := client.GetVolumeList(&GetVolumeListRequest{}); !errors.Is(err, ErrMethodNotFound) {
+		t.Fatalf("Expected ErrMethodNotFound, got %v", err)
+	}
+	if got := atomic.LoadInt64(&requests); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+func TestNoRetryOfCertificateError(t *testing.T) {
+	var connections int64
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(`{"result":{}}`))
+	}))
+	srv.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&connections, 1)
+		}
+	}
+	srv.StartTLS()
+	defer srv.Close()
+	// the client does not trust the certificate of the test server
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(fastRetries())
+
+	_, err := client.GetVolumeList(&GetVolumeListRequest{})
+	var certErr *tls.CertificateVerificationError
+	if !errors.As(err, &certErr) {
+		t.Fatalf("Expected certificate error, got %v", err)
+	}
+	if isRetryable(err, true) {
+		t.Errorf("Certificate errors must not be retryable")
+	}
+	if got := atomic.LoadInt64(&connections); got != 1 {
+		t.Errorf("Expected 1 connection, got %d", got)
+	}
+}
+
+func TestRetryDialFailureOfNonIdempotentCall(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := "http://" + listener.Addr().String()
+	listener.Close()
+
+	client := NewQuobyteClient(url, "user", "pw")
+	client.SetRetryConfig(fastRetries())
+	_, err = client.CreateVolume(&CreateVolumeRequest{Name: "vol"})
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) || opErr.Op != "dial" {
+		t.Fatalf("Expected dial error, got %v", err)
+	}
+	if !isRetryable(err, false) {
+		t.Errorf("Dial errors must be retryable for non-idempotent calls")
+	}
+}
+
+func TestRetryRespectsContext(t *testing.T) {
+	srv, _ := newFlakyServer(t, 100, http.StatusServiceUnavailable)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(RetryConfig{MaxAttempts: 100, InitialBackoff: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.GetVolumeListContext(ctx, &GetVolumeListRequest{}); err == nil {
+		t.Fatal("Expected error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Retry did not stop on context cancellation, took %v", elapsed)
+	}
+}
+
+func TestRetryTimeBudget(t *testing.T) {
+	srv, requests := newFlakyServer(t, 100, http.StatusServiceUnavailable)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetRetryConfig(RetryConfig{
+		MaxAttempts:    100,
+		InitialBackoff: 20 * time.Millisecond,
+		MaxElapsedTime: 50 * time.Millisecond,
+	})
+
+	if _, err := client.GetVolumeList(&GetVolumeListRequest{}); err == nil {
+		t.Fatal("Expected error")
+	}
+	if got := atomic.LoadInt64(requests); got > 3 {
+		t.Errorf("Expected time budget to limit attempts, got %d requests", got)
+	}
+}
@@ -2,6 +2,7 @@ package quobyte
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,16 +12,16 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
-	"sync"
+	"strings"
+	"time"
 )
 
 const (
-	emptyResponse      string = "Empty result and no error occurred"
-	errorMessageFormat string = "method: %s error message: %s"
+	emptyResponse          string = "Empty result and no error occurred"
+	errorMessageFormat     string = "method: %s error message: %s"
+	httpErrorMessageFormat string = "JsonRPC failed with error (error code: %d) %s"
 )
 
-var mux sync.Mutex
-
 type request struct {
 	ID      string      `json:"id"`
 	Version string      `json:"jsonrpc"`
@@ -36,19 +37,22 @@ type response struct {
 }
 
 type rpcError struct {
-	Code    int64  `json:"code"`
-	Message string `json:"message"`
+	Code    int64           `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
 }
 
 func (err *rpcError) decodeErrorCode() string {
 	switch err.Code {
-	case -32600:
+	case ErrorCodeInvalidRequest:
 		return "ERROR_CODE_INVALID_REQUEST"
-	case -32603:
+	case ErrorCodeJSONEncodingFailed:
 		return "ERROR_CODE_JSON_ENCODING_FAILED"
-	case -32601:
+	case ErrorCodeMethodNotFound:
 		return "ERROR_CODE_METHOD_NOT_FOUND"
-	case -32700:
+	case ErrorCodeInvalidParams:
+		return "ERROR_CODE_INVALID_PARAMS"
+	case ErrorCodeParseError:
 		return "ERROR_CODE_PARSE_ERROR"
 	}
 
@@ -56,9 +60,17 @@ func (err *rpcError) decodeErrorCode() string {
 }
 
 func encodeRequest(method string, params interface{}) ([]byte, error) {
+	return encodeRequestWithID(newRequestID(), method, params)
+}
+
+// newRequestID generates a random ID and converts it to a string.
+func newRequestID() string {
+	return strconv.FormatInt(rand.Int63(), 10)
+}
+
+func encodeRequestWithID(id string, method string, params interface{}) ([]byte, error) {
 	return json.Marshal(&request{
-		// Generate random ID and convert it to a string
-		ID:      strconv.FormatInt(rand.Int63(), 10),
+		ID:      id,
 		Version: "2.0",
 		Method:  method,
 		Params:  params,
@@ -70,94 +82,313 @@ func decodeResponse(method string, ioReader io.Reader, reply interface{}) error
 	if err := json.NewDecoder(ioReader).Decode(&resp); err != nil {
 		return err
 	}
+	return decodeEnvelope(method, &resp, reply, false)
+}
+
+// SetStrictDecoding makes the client reject responses with fields that are unknown to types.go
+// with ErrUnknownField. It detects schema drift between the client and the API service.
+func (client *QuobyteClient) SetStrictDecoding(strict bool) {
+	client.strict = strict
+}
+
+// WithStrictDecoding makes the client reject responses with unknown fields, see SetStrictDecoding.
+func WithStrictDecoding() Option {
+	return func(opts *clientOptions) error {
+		opts.strict = true
+		return nil
+	}
+}
+
+// decodeStrict decodes data into v and fails with ErrUnknownField for fields that v does not have.
+func decodeStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		if strings.HasPrefix(err.Error(), "json: unknown field") {
+			return fmt.Errorf("%w: %s", ErrUnknownField, strings.TrimPrefix(err.Error(), "json: "))
+		}
+		return err
+	}
+	return nil
+}
+
+// checkEnvelope verifies that resp is a JSON-RPC 2.0 response to the request with the id.
+func checkEnvelope(method string, id string, resp *response, strict bool) error {
+	// older API services omit the version and the id, only strict decoding requires them
+	if resp.Version != "2.0" && (resp.Version != "" || strict) {
+		return fmt.Errorf("%w: method %s: response has version %q", ErrProtocol, method, resp.Version)
+	}
+	if resp.Result != nil && resp.Error != nil {
+		return fmt.Errorf("%w: method %s: response has result and error", ErrProtocol, method)
+	}
+	// the id is null if the server could not read it from the request
+	if resp.ID != id && !(resp.ID == "" && (resp.Error != nil || !strict)) {
+		return fmt.Errorf("%w: method %s: response id %q does not match request id %q", ErrProtocol, method, resp.ID, id)
+	}
+	return nil
+}
+
+// hasResult returns false if reply is nil or an empty struct, i.e. the method returns nothing,
+// or a *json.RawMessage, which takes any result.
+func hasResult(reply interface{}) bool {
+	if _, ok := reply.(*json.RawMessage); reply == nil || ok {
+		return false
+	}
+	value := reflect.ValueOf(reply)
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return false
+	}
+	value = reflect.Indirect(value)
+	return value.Kind() != reflect.Struct || value.NumField() > 0
+}
 
+// decodeEnvelope returns the error of the response envelope or decodes its result into reply.
+func decodeEnvelope(method string, resp *response, reply interface{}, strict bool) error {
 	if resp.Error != nil {
 		var rpcErr rpcError
 		if err := json.Unmarshal(*resp.Error, &rpcErr); err != nil {
 			return err
 		}
 
-		if rpcErr.Message != "" {
-			return fmt.Errorf(errorMessageFormat, method, rpcErr.Message)
+		return &RPCError{
+			Method:     method,
+			Code:       rpcErr.Code,
+			Message:    rpcErr.Message,
+			Data:       rpcErr.Data,
+			HTTPStatus: http.StatusOK,
 		}
+	}
 
-		respError := rpcErr.decodeErrorCode()
-		if respError != "" {
-			return fmt.Errorf(errorMessageFormat, method, respError)
+	if resp.Result == nil || string(*resp.Result) == "null" {
+		if hasResult(reply) {
+			return fmt.Errorf(errorMessageFormat, method, emptyResponse)
 		}
+		return nil
 	}
-
-	if resp.Result != nil && reply != nil {
-		return json.Unmarshal(*resp.Result, reply)
+	if reply == nil {
+		return nil
 	}
+	if strict {
+		if err := decodeStrict(*resp.Result, reply); err != nil {
+			return fmt.Errorf("method %s: %w", method, err)
+		}
+		return nil
+	}
+	return json.Unmarshal(*resp.Result, reply)
+}
 
-	return fmt.Errorf(errorMessageFormat, method, emptyResponse)
+func (client QuobyteClient) sendRequest(ctx context.Context, method string, request interface{}, response interface{}) error {
+	return client.sendRequestWith(ctx, method, request, response, client.invoke)
+}
+
+// sendRequestWith is sendRequest with invoke as the innermost Invoker of the interceptor chain.
+func (client QuobyteClient) sendRequestWith(ctx context.Context, method string, request interface{}, response interface{}, invoke Invoker) error {
+	if err := client.validateRequest(method, request); err != nil {
+		return err
+	}
+	if err := client.detectSupported(ctx, method); err != nil {
+		return err
+	}
+	client.setRetryPolicy(request)
+	info := callInfoFrom(ctx)
+	if info == nil {
+		info = &CallInfo{}
+		ctx = WithCallInfo(ctx, info)
+	}
+	err := chainInterceptors(client.interceptors, invoke)(ctx, method, request, response)
+	if errors.Is(err, ErrMethodNotFound) {
+		return client.unsupported(info.Endpoint, method, err)
+	}
+	return err
 }
 
-func (client QuobyteClient) sendRequest(method string, request interface{}, response interface{}) error {
-	etype := reflect.ValueOf(request).Elem()
-	field := etype.FieldByName("RetryPolicy")
-	if field.IsValid() {
+// setRetryPolicy sets the API retry policy of the client in the request, if it is a pointer to a
+// struct with a RetryPolicy string field.
+func (client QuobyteClient) setRetryPolicy(request interface{}) {
+	value := reflect.ValueOf(request)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+	field := value.Elem().FieldByName("RetryPolicy")
+	if field.IsValid() && field.Kind() == reflect.String && field.CanSet() {
 		field.SetString(client.GetAPIRetryPolicy())
 	}
-	message, err := encodeRequest(method, request)
+}
+
+// invoke is the innermost Invoker of the interceptor chain.
+func (client QuobyteClient) invoke(ctx context.Context, method string, request interface{}, response interface{}) error {
+	id := newRequestID()
+	message, err := encodeRequestWithID(id, method, request)
 	if err != nil {
 		return err
 	}
-	// If no cookies, serialize requests such that first successful request sets the cookies
-	for {
-		req, err := http.NewRequest("POST", client.url.String(), bytes.NewBuffer(message))
+	return client.send(ctx, method, client.methodIdempotency(method) == Idempotent, message, client.decodeInto(method, id, response))
+}
+
+// decodeInto returns a function that decodes the response body to the request with the id into
+// reply.
+func (client QuobyteClient) decodeInto(method string, id string, reply interface{}) func(io.Reader) error {
+	return func(body io.Reader) error {
+		data, err := io.ReadAll(body)
 		if err != nil {
 			return err
 		}
-		req.Header.Set("Content-Type", "application/json")
-		mux.Lock()
-		hasCookies, err := client.hasCookies()
-		if err != nil {
+		return client.decodeData(method, id, data, reply)
+	}
+}
+
+// decodeData decodes the response data to the request with the id into reply.
+func (client QuobyteClient) decodeData(method string, id string, data []byte, reply interface{}) error {
+	var resp response
+	var err error
+	if client.strict {
+		err = decodeStrict(data, &resp)
+	} else {
+		err = json.Unmarshal(data, &resp)
+	}
+	if err != nil {
+		return fmt.Errorf("method %s: %w", method, err)
+	}
+	if err := checkEnvelope(method, id, &resp, client.strict); err != nil {
+		return err
+	}
+	if err := decodeEnvelope(method, &resp, reply, client.strict); err != nil {
+		return err
+	}
+	if client.validateEnums {
+		return validateEnums(method, reply)
+	}
+	return nil
+}
+
+// send sends the encoded message with the metrics, retry and failover policies of the client
+// and decodes the response body with decode.
+func (client QuobyteClient) send(ctx context.Context, method string, idempotent bool, message []byte, decode func(io.Reader) error) error {
+	return client.withBreaker(ctx, method, func() error {
+		return client.withLimits(ctx, method, idempotent, func() error {
+			return client.withMetrics(method, func() error {
+				return client.withRetries(ctx, idempotent, func() error {
+					return client.withFailover(ctx, idempotent, func(ep *endpoint) error {
+						return client.sendMessage(ctx, ep, method, message, decode)
+					})
+				})
+			})
+		})
+	})
+}
+
+// sendMessage sends the encoded message to ep and re-authenticates if the server rejected the session.
+func (client QuobyteClient) sendMessage(ctx context.Context, ep *endpoint, method string, message []byte, decode func(io.Reader) error) error {
+	for reauthentications := 0; ; reauthentications++ {
+		retry, err := client.doRequest(ctx, ep, method, message, decode)
+		if !retry || !ep.session.reauthenticationsAllowed(reauthentications) {
 			return err
 		}
-		if !hasCookies {
-			req.SetBasicAuth(client.username, client.password)
-			// no cookies available, must hold lock until request is completed and
-			// new cookies are created by server
-			defer mux.Unlock()
-		} else {
-			// let every thread/routine send request using the cookie
-			mux.Unlock()
+	}
+}
+
+// doRequest sends a single request. It returns true if the server rejected the session and
+// the request should be resent with credentials.
+func (client QuobyteClient) doRequest(ctx context.Context, ep *endpoint, method string, message []byte, decode func(io.Reader) error) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", ep.url.String(), bytes.NewBuffer(message))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if client.userAgent != "" {
+		req.Header.Set("User-Agent", client.userAgent)
+	}
+	for key, values := range headerFrom(ctx) {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	// If there is no valid session, only one request sends the credentials. The others wait
+	// until it completed and then use the new session cookies.
+	login, err := ep.session.acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	if login && client.authenticator != nil {
+		if err := client.authenticator.Authenticate(ctx, req); err != nil {
+			ep.session.loginDone(nil)
+			return false, err
 		}
-		resp, err := client.client.Do(req)
+	}
+	debug := client.debugEnabled(ctx)
+	if debug {
+		client.logRequest(ctx, ep, method, message, login)
+	}
+	if client.metrics != nil {
+		client.metrics.addInFlight(1)
+	}
+	start := time.Now()
+	resp, err := client.client.Do(req)
+	if client.metrics != nil {
+		client.metrics.addInFlight(-1)
+	}
+	if login {
+		ep.session.loginDone(resp)
+	}
+	if err != nil {
+		if debug {
+			client.logFailure(ctx, ep, method, err, time.Since(start))
+		}
+		return false, err
+	}
+	defer resp.Body.Close()
+	body := resp.Body
+	if debug && isStreamedResponse(ctx) && resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		client.logStreamedResponse(ctx, ep, method, resp.StatusCode, time.Since(start))
+	} else if debug {
+		// the body is only buffered when it is logged
+		message, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return err
+			client.logFailure(ctx, ep, method, err, time.Since(start))
+			return false, err
 		}
-		defer resp.Body.Close()
+		client.logResponse(ctx, ep, method, resp.StatusCode, message, time.Since(start))
+		body = io.NopCloser(bytes.NewReader(message))
+	}
 
-		if resp.StatusCode < 200 || resp.StatusCode > 299 {
-			if resp.StatusCode == 401 {
-				_, ok := req.Header["Authorization"]
-				if ok {
-					return errors.New("Unable to authenticate with Quobyte API service")
-				}
-				// Session is not valid anymore (service restart, session invalidated etc)!!
-				// resend basic auth and get new cookies
-				// invalidate session cookies
-				cookieJar := client.client.Jar
-				if cookieJar != nil {
-					cookies := cookieJar.Cookies(client.url)
-					for _, cookie := range cookies {
-						cookie.MaxAge = -1
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if resp.StatusCode == 401 {
+			if login {
+				// credentials were rejected, retry only if the authenticator has new ones
+				// (rotated secret, expired token etc)
+				refreshed := false
+				if client.authenticator != nil {
+					if refreshed, err = client.authenticator.Refresh(ctx); err != nil {
+						return false, err
 					}
-					cookieJar.SetCookies(client.url, cookies)
 				}
-				// retry request with authorization header
-				continue
+				return refreshed, &RPCError{
+					Method:     method,
+					Message:    "Unable to authenticate with Quobyte API service",
+					HTTPStatus: resp.StatusCode,
+				}
+			}
+			// Session is not valid anymore (service restart, session invalidated etc)!!
+			// invalidate session cookies and retry request with authorization header
+			ep.session.invalidate()
+			if client.metrics != nil {
+				client.metrics.observeReauthentication()
 			}
-			body, err := ioutil.ReadAll(resp.Body)
-			if err != nil {
-				return (err)
+			return true, &RPCError{
+				Method:     method,
+				Message:    "Session was rejected by Quobyte API service",
+				HTTPStatus: resp.StatusCode,
 			}
-			return fmt.Errorf("JsonRPC failed with error (error code: %d) %s",
-				resp.StatusCode, string(body))
 		}
-		return decodeResponse(method, resp.Body, &response)
+		message, err := ioutil.ReadAll(body)
+		if err != nil {
+			return false, err
+		}
+		return false, &RPCError{
+			Method:     method,
+			Message:    string(message),
+			HTTPStatus: resp.StatusCode,
+		}
 	}
+	return false, decode(body)
 }
@@ -2,7 +2,9 @@ package quobyte
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +12,14 @@ import (
 	"testing"
 )
 
+// rpcResponse completes body, a JSON-RPC response object without id and version, to a response
+// to req.
+func rpcResponse(req *http.Request, body string) []byte {
+	var rpcRequest request
+	json.NewDecoder(req.Body).Decode(&rpcRequest)
+	return []byte(`{"jsonrpc":"2.0","id":"` + rpcRequest.ID + `",` + body[1:])
+}
+
 func TestSuccessfullEncodeRequest(t *testing.T) {
 	req := &CreateVolumeRequest{
 		RootUserId:  "root",
@@ -203,7 +213,7 @@ func TestSendRequest(t *testing.T) {
 	client := NewQuobyteClient(srv.URL, "user", "pw")
 	for i := 0; i < 2; i++ {
 		resp := &CreateVolumeResponse{}
-		if err := client.sendRequest("dummyMethod", &request{}, &resp); err != nil {
+		if err := client.sendRequest(context.Background(), "dummyMethod", &request{}, &resp); err != nil {
 			t.Fatalf("Unexpected error (i=%d): %+v", i, err)
 		}
 		if got, want := resp.VolumeUuid, "1234"; got != want {
@@ -211,3 +221,85 @@ func TestSendRequest(t *testing.T) {
 		}
 	}
 }
+
+type protocolTest struct {
+	name     string
+	strict   bool
+	response func(id string) string
+	call     func(client *QuobyteClient) error
+	expected error
+}
+
+func TestProtocolConformance(t *testing.T) {
+	getTenant := func(client *QuobyteClient) error {
+		_, err := client.GetTenant(&GetTenantRequest{})
+		return err
+	}
+	setQuota := func(client *QuobyteClient) error {
+		return client.SetVolumeQuota("1234", 1024)
+	}
+	tests := []protocolTest{
+		{"valid", false, func(id string) string { return `{"jsonrpc":"2.0","id":"` + id + `","result":{"tenant":[]}}` }, getTenant, nil},
+		{"wrong id", false, func(id string) string { return `{"jsonrpc":"2.0","id":"1` + id + `","result":{}}` }, getTenant, ErrProtocol},
+		{"wrong version", false, func(id string) string { return `{"jsonrpc":"1.0","id":"` + id + `","result":{}}` }, getTenant, ErrProtocol},
+		{"missing version", false, func(id string) string { return `{"id":"` + id + `","result":{}}` }, getTenant, nil},
+		{"missing version and id", false, func(id string) string { return `{"result":{"tenant":[]}}` }, getTenant, nil},
+		{"strict missing version", true, func(id string) string { return `{"id":"` + id + `","result":{}}` }, getTenant, ErrProtocol},
+		{"strict missing id", true, func(id string) string { return `{"jsonrpc":"2.0","result":{}}` }, getTenant, ErrProtocol},
+		{"result and error", false, func(id string) string {
+			return `{"jsonrpc":"2.0","id":"` + id + `","result":{},"error":{"code":-32601}}`
+		}, getTenant, ErrProtocol},
+		{"error without id", false, func(id string) string { return `{"jsonrpc":"2.0","id":null,"error":{"code":-32700}}` }, getTenant, ErrParseError},
+		{"void null result", false, func(id string) string { return `{"jsonrpc":"2.0","id":"` + id + `","result":null}` }, setQuota, nil},
+		{"void empty result", false, func(id string) string { return `{"jsonrpc":"2.0","id":"` + id + `","result":{}}` }, setQuota, nil},
+		{"void without result", false, func(id string) string { return `{"jsonrpc":"2.0","id":"` + id + `"}` }, setQuota, nil},
+		{"unknown field", false, func(id string) string {
+			return `{"jsonrpc":"2.0","id":"` + id + `","result":{"new_field":1}}`
+		}, getTenant, nil},
+		{"strict unknown field", true, func(id string) string {
+			return `{"jsonrpc":"2.0","id":"` + id + `","result":{"new_field":1}}`
+		}, getTenant, ErrUnknownField},
+		{"strict unknown envelope field", true, func(id string) string {
+			return `{"jsonrpc":"2.0","id":"` + id + `","result":{},"extra":true}`
+		}, getTenant, ErrUnknownField},
+	}
+	for _, test := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var rpcRequest request
+			json.NewDecoder(req.Body).Decode(&rpcRequest)
+			w.Write([]byte(test.response(rpcRequest.ID)))
+		}))
+		client, err := NewClient(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.SetStrictDecoding(test.strict)
+		err = test.call(client)
+		if (test.expected == nil && err != nil) || !errors.Is(err, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, err)
+		}
+		srv.Close()
+	}
+}
+
+func TestErrorData(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write(rpcResponse(req, `{"error":{"code":-32602,"message":"invalid quota","data":{"field":"limits"}}}`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.SetVolumeQuota("1234", 1024)
+	if err == nil || err.Error() != `method: setQuota error message: invalid quota (data: {"field":"limits"})` {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var rpcErr *RPCError
+	var data struct {
+		Field string `json:"field"`
+	}
+	if !errors.As(err, &rpcErr) || rpcErr.DecodeData(&data) != nil || data.Field != "limits" {
+		t.Errorf("Unable to decode error data of %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
+
+package quobyte
+
+// secretFields are the JSON names of fields that carry passwords, secrets or key material.
+var secretFields = map[string]bool{
+	"admin_password":        true,
+	"bind_user_secret":      true,
+	"client_secret":         true,
+	"encoded_encrypted_key": true,
+	"encoded_existing_keystore_slot_password_hash": true,
+	"encoded_key_derivation_salt":                  true,
+	"encoded_new_keystore_slot_password_hash":      true,
+	"encoded_new_keystore_slot_password_salt":      true,
+	"encoded_slot_password_hash":                   true,
+	"encoded_slot_password_salt":                   true,
+	"key":                                          true,
+	"master_keystore_slot_password":                true,
+	"password":                                     true,
+	"password_hash":                                true,
+	"password_hash_hex":                            true,
+	"private_key":                                  true,
+	"salt":                                         true,
+	"secret":                                       true,
+	"secret_access_key":                            true,
+	"status_server_token":                          true,
+}
@@ -0,0 +1,227 @@
+package quobyte
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// SessionState describes the state of the session a client holds with the API service.
+type SessionState string
+
+const (
+	// SessionNone means no session was established yet or it was invalidated.
+	SessionNone SessionState = "NONE"
+	// SessionEstablishing means a request carrying credentials is in flight.
+	SessionEstablishing SessionState = "ESTABLISHING"
+	// SessionActive means requests are sent with the session cookies.
+	SessionActive SessionState = "ACTIVE"
+)
+
+const (
+	defaultMaxReauthentications = 2
+	maxSessionRefreshMargin     = 30 * time.Second
+)
+
+// SessionInfo is a snapshot of the client session.
+type SessionInfo struct {
+	State SessionState
+	// EstablishedAt is the time the current session was created.
+	EstablishedAt time.Time
+	// ExpiresAt is the time the session is expected to expire, zero if unknown.
+	ExpiresAt time.Time
+	// Logins counts the requests that were sent with credentials.
+	Logins int64
+	// Reauthentications counts the sessions the server rejected with 401.
+	Reauthentications int64
+}
+
+// session tracks the cookie based session of a single QuobyteClient.
+// Only one request at a time may carry the credentials (single flight login), every other request
+// waits for it and then reuses the session cookies.
+type session struct {
+	jar http.CookieJar
+	url *url.URL
+
+	// loginSlot is held by the request that establishes the session. It is a channel rather than a
+	// sync.Mutex so that waiting for it can be aborted through the request context.
+	loginSlot chan struct{}
+
+	mu                   sync.Mutex
+	info                 SessionInfo
+	lifetime             time.Duration
+	maxReauthentications int
+	// server is the detected server version, nil until detected. It is reset with the session
+	// because the service may have been upgraded in between.
+	server *ServerInfo
+	// detected is set once the version detection of detectSupported ran in the session, even if
+	// it failed, so that it is not repeated for every call.
+	detected bool
+	// detecting is closed when the running version detection is done, nil if none is running.
+	detecting chan struct{}
+	// unsupported are the methods the server answered with method not found.
+	unsupported map[string]bool
+}
+
+func newSession(jar http.CookieJar, url *url.URL) *session {
+	return &session{
+		jar:                  jar,
+		url:                  url,
+		loginSlot:            make(chan struct{}, 1),
+		info:                 SessionInfo{State: SessionNone},
+		maxReauthentications: defaultMaxReauthentications,
+	}
+}
+
+// valid must be called with mu held.
+func (s *session) valid(now time.Time) bool {
+	if s.info.State != SessionActive {
+		return false
+	}
+	if s.jar == nil || len(s.jar.Cookies(s.url)) == 0 {
+		return false
+	}
+	if s.info.ExpiresAt.IsZero() {
+		return true
+	}
+	margin := s.info.ExpiresAt.Sub(s.info.EstablishedAt) / 10
+	if margin > maxSessionRefreshMargin {
+		margin = maxSessionRefreshMargin
+	}
+	return now.Before(s.info.ExpiresAt.Add(-margin))
+}
+
+// acquire returns true if the caller must send credentials. In this case the caller holds the
+// login slot and must call loginDone once the response was received.
+func (s *session) acquire(ctx context.Context) (bool, error) {
+	s.mu.Lock()
+	valid := s.valid(time.Now())
+	s.mu.Unlock()
+	if valid {
+		return false, nil
+	}
+
+	select {
+	case s.loginSlot <- struct{}{}:
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// another request may have established the session while we were waiting
+	if s.valid(time.Now()) {
+		<-s.loginSlot
+		return false, nil
+	}
+	s.expireCookies()
+	s.info.State = SessionEstablishing
+	s.info.Logins++
+	return true, nil
+}
+
+// loginDone releases the login slot. resp is nil if the request failed without a response.
+func (s *session) loginDone(resp *http.Response) {
+	defer func() { <-s.loginSlot }()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if resp == nil || resp.StatusCode < 200 || resp.StatusCode > 299 ||
+		s.jar == nil || len(s.jar.Cookies(s.url)) == 0 {
+		s.info.State = SessionNone
+		return
+	}
+	now := time.Now()
+	s.server = nil
+	s.detected = false
+	s.unsupported = nil
+	s.info.State = SessionActive
+	s.info.EstablishedAt = now
+	s.info.ExpiresAt = time.Time{}
+	for _, cookie := range resp.Cookies() {
+		var expires time.Time
+		if cookie.MaxAge > 0 {
+			expires = now.Add(time.Duration(cookie.MaxAge) * time.Second)
+		} else if !cookie.Expires.IsZero() {
+			expires = cookie.Expires
+		}
+		if !expires.IsZero() && (s.info.ExpiresAt.IsZero() || expires.Before(s.info.ExpiresAt)) {
+			s.info.ExpiresAt = expires
+		}
+	}
+	if s.info.ExpiresAt.IsZero() && s.lifetime > 0 {
+		s.info.ExpiresAt = now.Add(s.lifetime)
+	}
+}
+
+// invalidate drops the session after the server rejected it (service restart, session timeout etc).
+func (s *session) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.info.State == SessionActive {
+		s.info.State = SessionNone
+		s.info.Reauthentications++
+	}
+	s.server = nil
+	s.detected = false
+	s.unsupported = nil
+	s.expireCookies()
+}
+
+// expireCookies must be called with mu held.
+func (s *session) expireCookies() {
+	if s.jar == nil {
+		return
+	}
+	cookies := s.jar.Cookies(s.url)
+	for _, cookie := range cookies {
+		cookie.MaxAge = -1
+	}
+	s.jar.SetCookies(s.url, cookies)
+}
+
+// serverInfo returns the detected server version, nil if it was not detected in the session.
+func (s *session) serverInfo() *ServerInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.server
+}
+
+func (s *session) snapshot() SessionInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.info
+}
+
+// SessionInfo returns the current state of the session with the active API endpoint.
+func (client *QuobyteClient) SessionInfo() SessionInfo {
+	return client.endpoints.primary().session.snapshot()
+}
+
+// SetSessionLifetime sets the expected lifetime of a session for servers that do not announce the
+// cookie expiry. The session is refreshed shortly before it expires. 0 disables the refresh.
+func (client *QuobyteClient) SetSessionLifetime(lifetime time.Duration) {
+	for _, ep := range client.endpoints.endpoints {
+		ep.session.mu.Lock()
+		ep.session.lifetime = lifetime
+		ep.session.mu.Unlock()
+	}
+}
+
+// SetMaxReauthentications limits how often a single call re-authenticates after the server
+// rejected the session.
+func (client *QuobyteClient) SetMaxReauthentications(max int) {
+	for _, ep := range client.endpoints.endpoints {
+		ep.session.mu.Lock()
+		ep.session.maxReauthentications = max
+		ep.session.mu.Unlock()
+	}
+}
+
+func (s *session) reauthenticationsAllowed(attempts int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return attempts < s.maxReauthentications
+}
@@ -0,0 +1,198 @@
+package quobyte
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newSessionServer returns a server that hands out a new session cookie for every request with
+// basic auth and rejects requests without a known session.
+func newSessionServer(t *testing.T, delay time.Duration) (*httptest.Server, *int64) {
+	var logins int64
+	var mu sync.Mutex
+	sessions := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if _, _, ok := req.BasicAuth(); ok {
+			time.Sleep(delay)
+			id := strconv.FormatInt(atomic.AddInt64(&logins, 1), 10)
+			mu.Lock()
+			sessions[id] = true
+			mu.Unlock()
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: id})
+		} else {
+			cookie, err := req.Cookie("session")
+			mu.Lock()
+			valid := err == nil && sessions[cookie.Value]
+			mu.Unlock()
+			if !valid {
+				w.WriteHeader(401)
+				return
+			}
+		}
+		w.Write(rpcResponse(req, `{"result":{"volume_uuid":"1234"}}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &logins
+}
+
+func TestSessionSingleFlightLogin(t *testing.T) {
+	srv, logins := newSessionServer(t, 20*time.Millisecond)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if got := atomic.LoadInt64(logins); got != 1 {
+		t.Errorf("Expected a single login, got %d", got)
+	}
+	info := client.SessionInfo()
+	if info.State != SessionActive || info.Logins != 1 {
+		t.Errorf("Unexpected session info: %+v", info)
+	}
+}
+
+func TestSessionClientsDoNotBlockEachOther(t *testing.T) {
+	slow, _ := newSessionServer(t, time.Second)
+	fast, _ := newSessionServer(t, 0)
+	slowClient := NewQuobyteClient(slow.URL, "user", "pw")
+	fastClient := NewQuobyteClient(fast.URL, "user", "pw")
+
+	go slowClient.CreateVolume(&CreateVolumeRequest{Name: "vol"})
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if _, err := fastClient.CreateVolumeContext(ctx, &CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatalf("Login of one client blocked another client: %v", err)
+	}
+}
+
+func TestSessionReauthenticatesAfterRejection(t *testing.T) {
+	srv, logins := newSessionServer(t, 0)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// server forgets the session, e.g. after a restart
+	client.endpoints.primary().session.jar.SetCookies(client.endpoints.primary().url, []*http.Cookie{{Name: "session", Value: "unknown"}})
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt64(logins); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+	if got := client.SessionInfo().Reauthentications; got != 1 {
+		t.Errorf("Expected 1 reauthentication, got %d", got)
+	}
+}
+
+func TestSessionMaxReauthentications(t *testing.T) {
+	var requests int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		// accept every login but reject every session
+		if _, _, ok := req.BasicAuth(); ok {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+			w.Write(rpcResponse(req, `{"result":{}}`))
+			return
+		}
+		w.WriteHeader(401)
+	}))
+	defer srv.Close()
+
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetMaxReauthentications(0)
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"})
+	if !errors.Is(err, ErrAuthentication) {
+		t.Fatalf("Expected error to match ErrAuthentication, got %v", err)
+	}
+	if got := atomic.LoadInt64(&requests); got != 2 {
+		t.Errorf("Expected 2 requests, got %d", got)
+	}
+}
+
+func TestSessionRefreshBeforeExpiry(t *testing.T) {
+	srv, logins := newSessionServer(t, 0)
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	client.SetSessionLifetime(50 * time.Millisecond)
+
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt64(logins); got != 1 {
+		t.Fatalf("Expected 1 login, got %d", got)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, err := client.CreateVolume(&CreateVolumeRequest{Name: "vol"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt64(logins); got != 2 {
+		t.Errorf("Expected session refresh, got %d logins", got)
+	}
+	if got := client.SessionInfo().Reauthentications; got != 0 {
+		t.Errorf("Refresh must not count as reauthentication, got %d", got)
+	}
+}
+
+func TestSessionCancelReleasesLogin(t *testing.T) {
+	block := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-block:
+		case <-req.Context().Done():
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1"})
+		w.Write(rpcResponse(req, `{"result":{"volume_uuid":"1234"}}`))
+	}))
+	defer srv.Close()
+	defer close(block)
+
+	client := NewQuobyteClient(srv.URL, "user", "pw")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	// first request establishes the session and holds the login until it is canceled
+	if _, err := client.CreateVolumeContext(ctx, &CreateVolumeRequest{Name: "vol"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+	session := client.endpoints.primary().session
+	login, err := session.acquire(ctx2)
+	if err != nil || !login {
+		t.Fatalf("Login was not released after cancellation: %v", err)
+	}
+	session.loginDone(nil)
+	if got := client.SessionInfo().State; got != SessionNone {
+		t.Errorf("Expected state %s, got %s", SessionNone, got)
+	}
+}
@@ -1,149 +1,30 @@
-// Autogenerated code, do not edit.
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
 // Copyright 2020 Quobyte Inc. See LICENSE for license terms.
 
 package quobyte
 
+import "context"
+
 type retryPolicy struct {
 	RetryPolicy string `json:"retry,omitempty"`
 }
 
 type QuobyteApi interface {
-	AcceptTermsAndConditions(request *AcceptTermsAndConditionsRequest) (result *AcceptTermsAndConditionsResponse, err error)
-	AcknowledgeAlert(request *AcknowledgeAlertRequest) (result *AcknowledgeAlertResponse, err error)
-	AddCa(request *AddCaRequest) (result *AddCaResponse, err error)
-	AddCertificate(request *AddCertificateRequest) (result *AddCertificateResponse, err error)
-	AddCsr(request *AddCsrRequest) (result *AddCsrResponse, err error)
-	AddRegistryReplica(request *AddRegistryReplicaRequest) (result *AddRegistryReplicaResponse, err error)
-	AnalyzeVolumes(request *AnalyzeVolumesRequest) (result *AnalyzeVolumesResponse, err error)
-	CancelNetworkTest(request *CancelNetworkTestRequest) (result *CancelNetworkTestResponse, err error)
-	CancelQuery(request *CancelQueryRequest) (result *CancelQueryResponse, err error)
-	CancelSupportDump(request *CancelSupportDumpRequest) (result *CancelSupportDumpResponse, err error)
-	CancelTask(request *CancelTaskRequest) (result *CancelTaskResponse, err error)
-	CancelVolumeErasure(request *CancelVolumeErasureRequest) (result *CancelVolumeErasureResponse, err error)
-	ChangePolicyRulePriority(request *ChangePolicyRulePriorityRequest) (result *ChangePolicyRulePriorityResponse, err error)
-	ConfigureRule(request *ConfigureRuleRequest) (result *ConfigureRuleResponse, err error)
-	CreateAccessKeyCredentials(request *CreateAccessKeyCredentialsRequest) (result *CreateAccessKeyCredentialsResponse, err error)
-	CreateMasterKeystoreSlot(request *CreateMasterKeystoreSlotRequest) (result *CreateMasterKeystoreSlotResponse, err error)
-	CreateMirroredVolume(request *CreateMirroredVolumeRequest) (result *CreateMirroredVolumeResponse, err error)
-	CreateNewUserKeystoreSlot(request *CreateNewUserKeystoreSlotRequest) (result *CreateNewUserKeystoreSlotResponse, err error)
-	CreateNotificationRule(request *CreateNotificationRuleRequest) (result *CreateNotificationRuleResponse, err error)
-	CreatePolicyRule(request *CreatePolicyRuleRequest) (result *CreatePolicyRuleResponse, err error)
-	CreatePolicyRuleSet(request *CreatePolicyRuleSetRequest) (result *CreatePolicyRuleSetResponse, err error)
-	CreateSnapshot(request *CreateSnapshotRequest) (result *CreateSnapshotResponse, err error)
-	CreateTask(request *CreateTaskRequest) (result *CreateTaskResponse, err error)
-	CreateUser(request *CreateUserRequest) (result *CreateUserResponse, err error)
-	CreateVolume(request *CreateVolumeRequest) (result *CreateVolumeResponse, err error)
-	DecideCsr(request *DecideCsrRequest) (result *DecideCsrResponse, err error)
-	DeleteAccessKeyCredentials(request *DeleteAccessKeyCredentialsRequest) (result *DeleteAccessKeyCredentialsResponse, err error)
-	DeleteCa(request *DeleteCaRequest) (result *DeleteCaResponse, err error)
-	DeleteCertificate(request *DeleteCertificateRequest) (result *DeleteCertificateResponse, err error)
-	DeleteConfiguration(request *DeleteConfigurationRequest) (result *DeleteConfigurationResponse, err error)
-	DeleteCsr(request *DeleteCsrRequest) (result *DeleteCsrResponse, err error)
-	DeleteLabels(request *DeleteLabelsRequest) (result *DeleteLabelsResponse, err error)
-	DeleteNotificationRule(request *DeleteNotificationRuleRequest) (result *DeleteNotificationRuleResponse, err error)
-	DeletePolicyRules(request *DeletePolicyRulesRequest) (result *DeletePolicyRulesResponse, err error)
-	DeleteSnapshot(request *DeleteSnapshotRequest) (result *DeleteSnapshotResponse, err error)
-	DeleteTenant(request *DeleteTenantRequest) (result *DeleteTenantResponse, err error)
-	DeleteUser(request *DeleteUserRequest) (result *DeleteUserResponse, err error)
-	DeleteVolume(request *DeleteVolumeRequest) (result *DeleteVolumeResponse, err error)
-	DeregisterService(request *DeregisterServiceRequest) (result *DeregisterServiceResponse, err error)
-	DisconnectMirroredVolume(request *DisconnectMirroredVolumeRequest) (result *DisconnectMirroredVolumeResponse, err error)
-	DumpEffectivePolicyRules(request *DumpEffectivePolicyRulesRequest) (result *DumpEffectivePolicyRulesResponse, err error)
-	DumpPolicyPresets(request *DumpPolicyPresetsRequest) (result *DumpPolicyPresetsResponse, err error)
-	EraseSnapshot(request *EraseSnapshotRequest) (result *EraseSnapshotResponse, err error)
-	EraseVolume(request *EraseVolumeRequest) (result *EraseVolumeResponse, err error)
-	ExportCertificate(request *ExportCertificateRequest) (result *ExportCertificateResponse, err error)
-	ExportConfiguration(request *ExportConfigurationRequest) (result *ExportConfigurationResponse, err error)
-	ExportPolicyRules(request *ExportPolicyRulesRequest) (result *ExportPolicyRulesResponse, err error)
-	ExportVolume(request *ExportVolumeRequest) (result *ExportVolumeResponse, err error)
-	FilterPolicyRules(request *FilterPolicyRulesRequest) (result *FilterPolicyRulesResponse, err error)
-	GenerateAsyncSupportDump(request *GenerateAsyncSupportDumpRequest) (result *GenerateAsyncSupportDumpResponse, err error)
-	GetAccounting(request *GetAccountingRequest) (result *GetAccountingResponse, err error)
-	GetAddKeySlotData(request *GetAddKeySlotDataRequest) (result *GetAddKeySlotDataResponse, err error)
-	GetAnalyzeReports(request *GetAnalyzeReportsRequest) (result *GetAnalyzeReportsResponse, err error)
-	GetAuditLog(request *GetAuditLogRequest) (result *GetAuditLogResponse, err error)
-	GetCertificateSubject(request *GetCertificateSubjectRequest) (result *GetCertificateSubjectResponse, err error)
-	GetClientList(request *GetClientListRequest) (result *GetClientListResponse, err error)
-	GetConfiguration(request *GetConfigurationRequest) (result *GetConfigurationResponse, err error)
-	GetDefaultKeyStoreSlotParams(request *GetDefaultKeyStoreSlotParamsRequest) (result *GetDefaultKeyStoreSlotParamsResponse, err error)
-	GetDeviceGroups(request *GetDeviceGroupsRequest) (result *GetDeviceGroupsResponse, err error)
-	GetDeviceIds(request *GetDeviceIdsRequest) (result *GetDeviceIdsResponse, err error)
-	GetDeviceList(request *GetDeviceListRequest) (result *GetDeviceListResponse, err error)
-	GetDeviceNetworkEndpoints(request *GetDeviceNetworkEndpointsRequest) (result *GetDeviceNetworkEndpointsResponse, err error)
-	GetDeviceTags(request *GetDeviceTagsRequest) (result *GetDeviceTagsResponse, err error)
-	GetEffectiveVolumeConfiguration(request *GetEffectiveVolumeConfigurationRequest) (result *GetEffectiveVolumeConfigurationResponse, err error)
-	GetEncryptStatus(request *GetEncryptStatusRequest) (result *GetEncryptStatusResponse, err error)
-	GetEncryptedVolumeKey(request *GetEncryptedVolumeKeyRequest) (result *GetEncryptedVolumeKeyResponse, err error)
-	GetFileMetadataDump(request *GetFileMetadataDumpRequest) (result *GetFileMetadataDumpResponse, err error)
-	GetFiringRules(request *GetFiringRulesRequest) (result *GetFiringRulesResponse, err error)
-	GetHealthManagerStatus(request *GetHealthManagerStatusRequest) (result *GetHealthManagerStatusResponse, err error)
-	GetInformation(request *GetInformationRequest) (result *GetInformationResponse, err error)
-	GetKeyStoreSlotWithoutHash(request *GetKeyStoreSlotWithoutHashRequest) (result *GetKeyStoreSlotWithoutHashResponse, err error)
-	GetLabels(request *GetLabelsRequest) (result *GetLabelsResponse, err error)
-	GetLatestEvent(request *GetLatestEventRequest) (result *GetLatestEventResponse, err error)
-	GetLicense(request *GetLicenseRequest) (result *GetLicenseResponse, err error)
-	GetMasterKeystoreSlots(request *GetMasterKeystoreSlotsRequest) (result *GetMasterKeystoreSlotsResponse, err error)
-	GetNetworkTestResult(request *GetNetworkTestResultRequest) (result *GetNetworkTestResultResponse, err error)
-	GetNotificationRules(request *GetNotificationRulesRequest) (result *GetNotificationRulesResponse, err error)
-	GetPolicyPresets(request *GetPolicyPresetsRequest) (result *GetPolicyPresetsResponse, err error)
-	GetPolicyRuleSets(request *GetPolicyRuleSetsRequest) (result *GetPolicyRuleSetsResponse, err error)
-	GetPolicyRules(request *GetPolicyRulesRequest) (result *GetPolicyRulesResponse, err error)
-	GetQueryProgress(request *GetQueryProgressRequest) (result *GetQueryProgressResponse, err error)
-	GetQuota(request *GetQuotaRequest) (result *GetQuotaResponse, err error)
-	GetRules(request *GetRulesRequest) (result *GetRulesResponse, err error)
-	GetServiceDump(request *GetServiceDumpRequest) (result *GetServiceDumpResponse, err error)
-	GetServices(request *GetServicesRequest) (result *GetServicesResponse, err error)
-	GetSupportDump(request *GetSupportDumpRequest) (result *GetSupportDumpResponse, err error)
-	GetSupportDumpStatus(request *GetSupportDumpStatusRequest) (result *GetSupportDumpStatusResponse, err error)
-	GetSystemStatistics(request *GetSystemStatisticsRequest) (result *GetSystemStatisticsResponse, err error)
-	GetTaskList(request *GetTaskListRequest) (result *GetTaskListResponse, err error)
-	GetTenant(request *GetTenantRequest) (result *GetTenantResponse, err error)
-	GetTopCapacityConsumer(request *GetTopCapacityConsumerRequest) (result *GetTopCapacityConsumerResponse, err error)
-	GetUnformattedDevices(request *GetUnformattedDevicesRequest) (result *GetUnformattedDevicesResponse, err error)
-	GetUsers(request *GetUsersRequest) (result *GetUsersResponse, err error)
-	GetVolumeList(request *GetVolumeListRequest) (result *GetVolumeListResponse, err error)
-	ImportAccessKeys(request *ImportAccessKeysRequest) (result *ImportAccessKeysResponse, err error)
-	ImportConfiguration(request *ImportConfigurationRequest) (result *ImportConfigurationResponse, err error)
-	ImportPolicyRules(request *ImportPolicyRulesRequest) (result *ImportPolicyRulesResponse, err error)
-	ListCa(request *ListCaRequest) (result *ListCaResponse, err error)
-	ListCertificates(request *ListCertificatesRequest) (result *ListCertificatesResponse, err error)
-	ListCsr(request *ListCsrRequest) (result *ListCsrResponse, err error)
-	ListRegistryReplicas(request *ListRegistryReplicasRequest) (result *ListRegistryReplicasResponse, err error)
-	ListSnapshots(request *ListSnapshotsRequest) (result *ListSnapshotsResponse, err error)
-	MakeDevice(request *MakeDeviceRequest) (result *MakeDeviceResponse, err error)
-	PublishBucketVolume(request *PublishBucketVolumeRequest) (result *PublishBucketVolumeResponse, err error)
-	QueryFiles(request *QueryFilesRequest) (result *QueryFilesResponse, err error)
-	RegenerateDatabase(request *RegenerateDatabaseRequest) (result *RegenerateDatabaseResponse, err error)
-	RemoveKeystoreSlot(request *RemoveKeystoreSlotRequest) (result *RemoveKeystoreSlotResponse, err error)
-	RemoveMasterKeystoreSlot(request *RemoveMasterKeystoreSlotRequest) (result *RemoveMasterKeystoreSlotResponse, err error)
-	RemoveRegistryReplica(request *RemoveRegistryReplicaRequest) (result *RemoveRegistryReplicaResponse, err error)
-	ResolveGlobalFileId(request *ResolveGlobalFileIdRequest) (result *ResolveGlobalFileIdResponse, err error)
-	ResolvePolicyRuleName(request *ResolvePolicyRuleNameRequest) (result *ResolvePolicyRuleNameResponse, err error)
-	ResolveTenantName(request *ResolveTenantNameRequest) (result *ResolveTenantNameResponse, err error)
-	ResolveVolumeName(request *ResolveVolumeNameRequest) (result *ResolveVolumeNameResponse, err error)
-	ResumeTask(request *ResumeTaskRequest) (result *ResumeTaskResponse, err error)
-	RetryTask(request *RetryTaskRequest) (result *RetryTaskResponse, err error)
-	RevokeCertificate(request *RevokeCertificateRequest) (result *RevokeCertificateResponse, err error)
-	SetCertificateOwner(request *SetCertificateOwnerRequest) (result *SetCertificateOwnerResponse, err error)
-	SetCertificateSubject(request *SetCertificateSubjectRequest) (result *SetCertificateSubjectResponse, err error)
-	SetConfiguration(request *SetConfigurationRequest) (result *SetConfigurationResponse, err error)
-	SetEncryptedVolumeKey(request *SetEncryptedVolumeKeyRequest) (result *SetEncryptedVolumeKeyResponse, err error)
-	SetLabels(request *SetLabelsRequest) (result *SetLabelsResponse, err error)
-	SetLicenseKey(request *SetLicenseKeyRequest) (result *SetLicenseKeyResponse, err error)
-	SetNotificationRule(request *SetNotificationRuleRequest) (result *SetNotificationRuleResponse, err error)
-	SetQuota(request *SetQuotaRequest) (result *SetQuotaResponse, err error)
-	SetTenant(request *SetTenantRequest) (result *SetTenantResponse, err error)
-	SilenceAlert(request *SilenceAlertRequest) (result *SilenceAlertResponse, err error)
-	StartNetworkTest(request *StartNetworkTestRequest) (result *StartNetworkTestResponse, err error)
-	TriggerVolumeCheckpoint(request *TriggerVolumeCheckpointRequest) (result *TriggerVolumeCheckpointResponse, err error)
-	UnlockMasterKeystoreSlot(request *UnlockMasterKeystoreSlotRequest) (result *UnlockMasterKeystoreSlotResponse, err error)
-	UnpublishBucketVolume(request *UnpublishBucketVolumeRequest) (result *UnpublishBucketVolumeResponse, err error)
-	UpdateDevice(request *UpdateDeviceRequest) (result *UpdateDeviceResponse, err error)
-	UpdatePolicyRules(request *UpdatePolicyRulesRequest) (result *UpdatePolicyRulesResponse, err error)
-	UpdateUser(request *UpdateUserRequest) (result *UpdateUserResponse, err error)
-	UpdateVolume(request *UpdateVolumeRequest) (result *UpdateVolumeResponse, err error)
-	VerifyLicense(request *VerifyLicenseRequest) (result *VerifyLicenseResponse, err error)
-	WhoAmI(request *WhoAmIRequest) (result *WhoAmIResponse, err error)
+	VolumeAPI
+	SnapshotAPI
+	TenantAPI
+	QuotaAPI
+	LabelAPI
+	TaskAPI
+	DeviceAPI
+	PolicyAPI
+	ConfigurationAPI
+	SecurityAPI
+	UserAPI
+	MonitoringAPI
+	LicenseAPI
+	ClusterAPI
+	SupportAPI
 }
 
 type AccessKeyType string
@@ -433,6 +314,7 @@ const (
 type AcceptTermsAndConditionsRequest struct {
 	VersionOfTermsAndConditions string `json:"version_of_terms_and_conditions,omitempty"`
 	retryPolicy
+	presence
 }
 
 type AcceptTermsAndConditionsResponse struct {
@@ -544,6 +426,7 @@ type AcknowledgeAlertRequest struct {
 	AlertIdentifier string     `json:"alert_identifier,omitempty"`
 	Qualifiers      FiringRule `json:"qualifiers,omitempty"`
 	retryPolicy
+	presence
 }
 
 type AcknowledgeAlertResponse struct {
@@ -555,6 +438,7 @@ type AddCaRequest struct {
 	// CA description
 	CertificateAuthority CertificateAuthority `json:"certificate_authority,omitempty"`
 	retryPolicy
+	presence
 }
 
 type AddCaResponse struct {
@@ -566,6 +450,7 @@ type AddCertificateRequest struct {
 	// Optional CSR that is approved by this certificate
 	CsrId int64 `json:"csr_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type AddCertificateResponse struct {
@@ -577,6 +462,7 @@ type AddCsrRequest struct {
 	// CSR
 	Csr CertificateSigningRequest `json:"csr,omitempty"`
 	retryPolicy
+	presence
 }
 
 type AddCsrResponse struct {
@@ -590,6 +476,7 @@ type AddRegistryReplicaRequest struct {
 	// Optional comment field for auditing.
 	Comment string `json:"comment,omitempty"`
 	retryPolicy
+	presence
 }
 
 type AddRegistryReplicaResponse struct {
@@ -613,6 +500,7 @@ type AnalyzeVolumesRequest struct {
 	RestrictToTenants []string `json:"restrict_to_tenants,omitempty"`
 	RestrictToVolumes []string `json:"restrict_to_volumes,omitempty"`
 	retryPolicy
+	presence
 }
 
 type AnalyzeVolumesResponse struct {
@@ -664,6 +552,7 @@ const (
 
 type CancelNetworkTestRequest struct {
 	retryPolicy
+	presence
 }
 
 type CancelNetworkTestResponse struct {
@@ -672,6 +561,7 @@ type CancelNetworkTestResponse struct {
 type CancelQueryRequest struct {
 	QueryId string `json:"query_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CancelQueryResponse struct {
@@ -679,6 +569,7 @@ type CancelQueryResponse struct {
 
 type CancelSupportDumpRequest struct {
 	retryPolicy
+	presence
 }
 
 type CancelSupportDumpResponse struct {
@@ -692,6 +583,7 @@ type CancelTaskRequest struct {
 	// Remove the task from the registry database entirely (restarting the target services is advised)
 	Delete bool `json:"delete,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CancelTaskResponse struct {
@@ -700,6 +592,7 @@ type CancelTaskResponse struct {
 type CancelVolumeErasureRequest struct {
 	VolumeUuid string `json:"volume_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CancelVolumeErasureResponse struct {
@@ -768,6 +661,7 @@ type ChangePolicyRulePriorityRequest struct {
 	PolicyRuleUuid string                                         `json:"policy_rule_uuid,omitempty"`
 	PriorityChange ChangePolicyRulePriorityRequest_PriorityChange `json:"priority_change,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ChangePolicyRulePriorityRequest_PriorityChange string
@@ -884,6 +778,7 @@ type ConfigureRuleRequest struct {
 	// List of one or more actions to be invoked
 	ObsoleteSetActions []*RuleAction `json:"obsolete_set_actions,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ConfigureRuleResponse struct {
@@ -1016,6 +911,7 @@ type CreateAccessKeyCredentialsRequest struct {
 	// e.g. S3 for S3 credentials
 	AccessKeyType AccessKeyType `json:"access_key_type,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateAccessKeyCredentialsResponse struct {
@@ -1026,6 +922,7 @@ type CreateMasterKeystoreSlotRequest struct {
 	MasterKeystoreSlotPassword string `json:"master_keystore_slot_password,omitempty"`
 	MasterKeystoreSlotName     string `json:"master_keystore_slot_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateMasterKeystoreSlotResponse struct {
@@ -1040,6 +937,7 @@ type CreateMirroredVolumeRequest struct {
 	RemoteVolumeUuid             string   `json:"remote_volume_uuid,omitempty"`
 	ObsoleteRemoteRegistryTarget []string `json:"OBSOLETE_remote_registry_target,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateMirroredVolumeResponse struct {
@@ -1051,6 +949,7 @@ type CreateNewUserKeystoreSlotRequest struct {
 	EncodedNewKeystoreSlotPasswordHash string `json:"encoded_new_keystore_slot_password_hash,omitempty"`
 	EncodedNewKeystoreSlotPasswordSalt string `json:"encoded_new_keystore_slot_password_salt,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateNewUserKeystoreSlotResponse struct {
@@ -1060,6 +959,7 @@ type CreateNewUserKeystoreSlotResponse struct {
 type CreateNotificationRuleRequest struct {
 	Rule NotificationRule `json:"rule,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateNotificationRuleResponse struct {
@@ -1070,6 +970,7 @@ type CreatePolicyRuleRequest struct {
 	// Must omit UUID; will be generated. ordering_number may be omitted. If omitted, set to next highest. Disallows default. Default policy rules can't be created.
 	PolicyRule PolicyRule `json:"policy_rule,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreatePolicyRuleResponse struct {
@@ -1083,6 +984,7 @@ type CreatePolicyRuleSetRequest struct {
 	Creator    string        `json:"creator,omitempty"`
 	Comment    string        `json:"comment,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreatePolicyRuleSetResponse struct {
@@ -1098,6 +1000,7 @@ type CreateSnapshotRequest struct {
 	// Create pinned snapshot (will not be deleted by cleanup)
 	Pinned bool `json:"pinned,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateSnapshotResponse struct {
@@ -1130,6 +1033,7 @@ type CreateTaskRequest struct {
 	// Task priority - lower priority tasks may get preempted if a higher priority task gets                 scheduled.
 	TaskPriority TaskPriority `json:"task_priority,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateTaskResponse struct {
@@ -1147,6 +1051,7 @@ type CreateUserRequest struct {
 	MemberOfGroup    []string `json:"member_of_group,omitempty"`
 	PrimaryGroup     string   `json:"primary_group,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateUserResponse struct {
@@ -1179,6 +1084,7 @@ type CreateVolumeRequest struct {
 	// Initial ACL of the root directory
 	RootAcl AccessControlList `json:"root_acl,omitempty"`
 	retryPolicy
+	presence
 }
 
 type CreateVolumeRequest_VolumeEncryptionProfile string
@@ -1208,6 +1114,7 @@ type DecideCsrRequest struct {
 	// Approve CSR
 	Approve bool `json:"approve,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DecideCsrResponse struct {
@@ -1240,6 +1147,7 @@ type DeleteAccessKeyCredentialsRequest struct {
 	UserName    string `json:"user_name,omitempty"`
 	AccessKeyId string `json:"access_key_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteAccessKeyCredentialsResponse struct {
@@ -1249,6 +1157,7 @@ type DeleteCaRequest struct {
 	// CA name
 	Name string `json:"name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteCaResponse struct {
@@ -1258,6 +1167,7 @@ type DeleteCertificateRequest struct {
 	// X.509 certificate fingerprint
 	Fingerprint string `json:"fingerprint,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteCertificateResponse struct {
@@ -1269,6 +1179,7 @@ type DeleteConfigurationRequest struct {
 	// Name of the configuration to be deleted
 	ConfigurationName string `json:"configuration_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteConfigurationResponse struct {
@@ -1277,6 +1188,7 @@ type DeleteConfigurationResponse struct {
 type DeleteCsrRequest struct {
 	CsrId int64 `json:"csr_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteCsrResponse struct {
@@ -1289,6 +1201,7 @@ type DeleteFilesSettings struct {
 type DeleteLabelsRequest struct {
 	Label []*Label `json:"label,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteLabelsResponse struct {
@@ -1297,6 +1210,7 @@ type DeleteLabelsResponse struct {
 type DeleteNotificationRuleRequest struct {
 	Uuid string `json:"uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteNotificationRuleResponse struct {
@@ -1305,6 +1219,7 @@ type DeleteNotificationRuleResponse struct {
 type DeletePolicyRulesRequest struct {
 	PolicyRuleUuid []string `json:"policy_rule_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeletePolicyRulesResponse struct {
@@ -1316,6 +1231,7 @@ type DeleteSnapshotRequest struct {
 	// Snapshot name
 	Name string `json:"name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteSnapshotResponse struct {
@@ -1324,6 +1240,7 @@ type DeleteSnapshotResponse struct {
 type DeleteTenantRequest struct {
 	TenantId string `json:"tenant_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteTenantResponse struct {
@@ -1332,6 +1249,7 @@ type DeleteTenantResponse struct {
 type DeleteUserRequest struct {
 	UserName string `json:"user_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteUserResponse struct {
@@ -1340,6 +1258,7 @@ type DeleteUserResponse struct {
 type DeleteVolumeRequest struct {
 	VolumeUuid string `json:"volume_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeleteVolumeResponse struct {
@@ -1348,6 +1267,7 @@ type DeleteVolumeResponse struct {
 type DeregisterServiceRequest struct {
 	ServiceUuid string `json:"service_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DeregisterServiceResponse struct {
@@ -1561,6 +1481,7 @@ type DisconnectMirroredVolumeRequest struct {
 	// The volume UUID.
 	VolumeUuid string `json:"volume_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DisconnectMirroredVolumeResponse struct {
@@ -1583,6 +1504,7 @@ type DumpEffectivePolicyRulesRequest struct {
 	// If true, returns only effective Policies. Otherwise, all effective PolicyRules are returned.
 	PoliciesOnly bool `json:"policies_only,omitempty"`
 	retryPolicy
+	presence
 }
 
 type DumpEffectivePolicyRulesResponse struct {
@@ -1592,6 +1514,7 @@ type DumpEffectivePolicyRulesResponse struct {
 
 type DumpPolicyPresetsRequest struct {
 	retryPolicy
+	presence
 }
 
 type DumpPolicyPresetsResponse struct {
@@ -1664,6 +1587,7 @@ type EraseSnapshotRequest struct {
 	// Snapshot name
 	Name string `json:"name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type EraseSnapshotResponse struct {
@@ -1673,6 +1597,7 @@ type EraseVolumeRequest struct {
 	VolumeUuid string `json:"volume_uuid,omitempty"`
 	Force      bool   `json:"force,omitempty"`
 	retryPolicy
+	presence
 }
 
 type EraseVolumeResponse struct {
@@ -1682,6 +1607,7 @@ type ExportCertificateRequest struct {
 	// X.509 certificate fingerprint
 	Fingerprint string `json:"fingerprint,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ExportCertificateResponse struct {
@@ -1695,6 +1621,7 @@ type ExportConfigurationRequest struct {
 	// Name of the configuration
 	ConfigurationName string `json:"configuration_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ExportConfigurationResponse struct {
@@ -1708,6 +1635,7 @@ type ExportPolicyRulesRequest struct {
 	// If true, also include default policy rules. Otherwise, they will not be exported.
 	IncludeDefaultPolicyRules bool `json:"include_default_policy_rules,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ExportPolicyRulesResponse struct {
@@ -1728,6 +1656,7 @@ type ExportVolumeRequest struct {
 	// True if volume should be made unavailable
 	RemoveExport bool `json:"remove_export,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ExportVolumeResponse struct {
@@ -1855,9 +1784,8 @@ type FileLayoutSettings struct {
 	// Size of an individual block. Multiple of 512 bytes. Default: 4k.
 	BlockSizeBytes int32 `json:"block_size_bytes,omitempty"`
 	// Size of an object, the unit of replication. Multiple of block size. Default: 8M.
-	ObjectSizeBytes int64 `json:"object_size_bytes,omitempty"`
-	//
-	StripingMethod FileLayoutSettings_StripingMethod `json:"striping_method,omitempty"`
+	ObjectSizeBytes int64                             `json:"object_size_bytes,omitempty"`
+	StripingMethod  FileLayoutSettings_StripingMethod `json:"striping_method,omitempty"`
 	// Size of a segment, the unit for splitting files across devices. Multiple of object size. Default: 10G.
 	SegmentSizeBytes int64                           `json:"segment_size_bytes,omitempty"`
 	CrcMethod        FileLayoutSettings_CrcMethod    `json:"crc_method,omitempty"`
@@ -2044,6 +1972,7 @@ type FilterPolicyRulesRequest struct {
 	// If not set, returns latest version.
 	PolicyRuleSetVersion int64 `json:"policy_rule_set_version,omitempty"`
 	retryPolicy
+	presence
 }
 
 type FilterPolicyRulesRequest_PolicySubject struct {
@@ -2128,6 +2057,7 @@ const (
 type GenerateAsyncSupportDumpRequest struct {
 	SupportTicketId int32 `json:"support_ticket_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GenerateAsyncSupportDumpResponse struct {
@@ -2137,6 +2067,7 @@ type GenerateAsyncSupportDumpResponse struct {
 type GetAccountingRequest struct {
 	Entity []*ConsumingEntity `json:"entity,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetAccountingResponse struct {
@@ -2153,6 +2084,7 @@ type GetAddKeySlotDataRequest struct {
 	ExistingKeystoreSlotUuid string `json:"existing_keystore_slot_uuid,omitempty"`
 	VolumeUuid               string `json:"volume_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetAddKeySlotDataResponse struct {
@@ -2166,6 +2098,7 @@ type GetAnalyzeReportsRequest struct {
 	TaskId     string                          `json:"task_id,omitempty"`
 	Format     GetAnalyzeReportsRequest_Format `json:"format,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetAnalyzeReportsRequest_Format string
@@ -2192,6 +2125,7 @@ type GetAuditLogRequest struct {
 	// Database lookup direction
 	OldestLogFirst bool `json:"oldest_log_first,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetAuditLogRequest_AuditDatabaseKey struct {
@@ -2209,6 +2143,7 @@ type GetCertificateSubjectRequest struct {
 	// X.509 certificate fingerprint
 	Fingerprint string `json:"fingerprint,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetCertificateSubjectResponse struct {
@@ -2220,6 +2155,7 @@ type GetClientListRequest struct {
 	// Only list clients in this domain
 	TenantDomain string `json:"tenant_domain,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetClientListResponse struct {
@@ -2233,6 +2169,7 @@ type GetConfigurationRequest struct {
 	// Name of the requested configuration (leave empty for a full list)
 	ConfigurationName string `json:"configuration_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetConfigurationResponse struct {
@@ -2247,6 +2184,7 @@ type GetConfigurationResponse struct {
 
 type GetDefaultKeyStoreSlotParamsRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetDefaultKeyStoreSlotParamsResponse struct {
@@ -2255,6 +2193,7 @@ type GetDefaultKeyStoreSlotParamsResponse struct {
 
 type GetDeviceGroupsRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetDeviceGroupsResponse struct {
@@ -2264,6 +2203,7 @@ type GetDeviceGroupsResponse struct {
 type GetDeviceIdsRequest struct {
 	ServiceUuid string `json:"service_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetDeviceIdsResponse struct {
@@ -2276,6 +2216,7 @@ type GetDeviceListRequest struct {
 	// Specify types to retrieve only devices with matching type.
 	DeviceType []*DeviceContent_ContentType `json:"device_type,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetDeviceListResponse struct {
@@ -2286,6 +2227,7 @@ type GetDeviceNetworkEndpointsRequest struct {
 	// Specify device id to retrieve data for specific devices. Retrieve all if not set.
 	DeviceId int64 `json:"device_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetDeviceNetworkEndpointsResponse struct {
@@ -2294,6 +2236,7 @@ type GetDeviceNetworkEndpointsResponse struct {
 
 type GetDeviceTagsRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetDeviceTagsResponse struct {
@@ -2304,6 +2247,7 @@ type GetEffectiveVolumeConfigurationRequest struct {
 	//Call deprecated, might be removed in the future.
 	VolumeUuid string `json:"volume_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetEffectiveVolumeConfigurationResponse struct {
@@ -2312,6 +2256,7 @@ type GetEffectiveVolumeConfigurationResponse struct {
 
 type GetEncryptStatusRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetEncryptStatusResponse struct {
@@ -2325,6 +2270,7 @@ type GetEncryptedVolumeKeyRequest struct {
 	VolumeUuid              string `json:"volume_uuid,omitempty"`
 	KeyVersion              int32  `json:"key_version,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetEncryptedVolumeKeyResponse struct {
@@ -2343,6 +2289,7 @@ type GetFileMetadataDumpRequest struct {
 	// optional
 	StripeNumber int32 `json:"stripe_number,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetFileMetadataDumpResponse struct {
@@ -2357,6 +2304,7 @@ type GetFileMetadataDumpResponse struct {
 type GetFiringRulesRequest struct {
 	Filteroutsilenced bool `json:"filterOutSilenced,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetFiringRulesResponse struct {
@@ -2366,6 +2314,7 @@ type GetFiringRulesResponse struct {
 
 type GetHealthManagerStatusRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetHealthManagerStatusResponse struct {
@@ -2374,6 +2323,7 @@ type GetHealthManagerStatusResponse struct {
 
 type GetInformationRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetInformationResponse struct {
@@ -2382,6 +2332,7 @@ type GetInformationResponse struct {
 type GetKeyStoreSlotWithoutHashRequest struct {
 	KeystoreSlotUuid string `json:"keystore_slot_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetKeyStoreSlotWithoutHashResponse struct {
@@ -2394,6 +2345,7 @@ type GetLabelsRequest struct {
 	FilterEntityId   string           `json:"filter_entity_id,omitempty"`
 	LabelName        string           `json:"label_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetLabelsResponse struct {
@@ -2404,6 +2356,7 @@ type GetLatestEventRequest struct {
 	SubjectType AuditEvent_SubjectType `json:"subject_type,omitempty"`
 	SubjectId   string                 `json:"subject_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetLatestEventResponse struct {
@@ -2412,6 +2365,7 @@ type GetLatestEventResponse struct {
 
 type GetLicenseRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetLicenseResponse struct {
@@ -2429,6 +2383,7 @@ type GetLicenseResponse struct {
 
 type GetMasterKeystoreSlotsRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetMasterKeystoreSlotsResponse struct {
@@ -2437,6 +2392,7 @@ type GetMasterKeystoreSlotsResponse struct {
 
 type GetNetworkTestResultRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetNetworkTestResultResponse struct {
@@ -2479,6 +2435,7 @@ type GetNetworkTestResultResponse_ConnectionTestJobResult_HistogramBucket struct
 
 type GetNotificationRulesRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetNotificationRulesResponse struct {
@@ -2487,6 +2444,7 @@ type GetNotificationRulesResponse struct {
 
 type GetPolicyPresetsRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetPolicyPresetsResponse struct {
@@ -2495,6 +2453,7 @@ type GetPolicyPresetsResponse struct {
 
 type GetPolicyRuleSetsRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetPolicyRuleSetsResponse struct {
@@ -2507,6 +2466,7 @@ type GetPolicyRulesRequest struct {
 	// If not set, returns latest version.
 	PolicyRuleSetVersion int64 `json:"policy_rule_set_version,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetPolicyRulesRequest_PolicySubject struct {
@@ -2566,6 +2526,7 @@ type GetPolicyRulesResponse struct {
 type GetQueryProgressRequest struct {
 	QueryId string `json:"query_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetQueryProgressResponse struct {
@@ -2592,6 +2553,7 @@ type GetQuotaRequest struct {
 	OnlyResourceType     []*Resource_Type   `json:"only_resource_type,omitempty"`
 	IncludeDefaultQuotas bool               `json:"include_default_quotas,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetQuotaResponse struct {
@@ -2600,6 +2562,7 @@ type GetQuotaResponse struct {
 
 type GetRulesRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetRulesResponse struct {
@@ -2610,6 +2573,7 @@ type GetServiceDumpRequest struct {
 	ServiceUuid     string `json:"service_uuid,omitempty"`
 	SupportTicketId int32  `json:"support_ticket_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetServiceDumpResponse struct {
@@ -2625,6 +2589,7 @@ type GetServicesRequest struct {
 	// Filter for service UUID
 	Serviceuuid string `json:"serviceUuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetServicesResponse struct {
@@ -2634,6 +2599,7 @@ type GetServicesResponse struct {
 type GetSupportDumpRequest struct {
 	SupportDumpId string `json:"support_dump_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetSupportDumpResponse struct {
@@ -2642,6 +2608,7 @@ type GetSupportDumpResponse struct {
 
 type GetSupportDumpStatusRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetSupportDumpStatusResponse struct {
@@ -2665,6 +2632,7 @@ const (
 
 type GetSystemStatisticsRequest struct {
 	retryPolicy
+	presence
 }
 
 type GetSystemStatisticsResponse struct {
@@ -2697,6 +2665,7 @@ type GetTaskListRequest struct {
 	ByParentTaskId         string `json:"by_parent_task_id,omitempty"`
 	ObsoleteOnlyUiSampling bool   `json:"OBSOLETE_only_ui_sampling,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetTaskListResponse struct {
@@ -2707,6 +2676,7 @@ type GetTaskListResponse struct {
 type GetTenantRequest struct {
 	TenantId []string `json:"tenant_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetTenantResponse struct {
@@ -2720,6 +2690,7 @@ type GetTopCapacityConsumerRequest struct {
 	OnlyConsumerType []*ConsumingEntity_Type             `json:"only_consumer_type,omitempty"`
 	OnlyResourceType []*AccountingResource_Type          `json:"only_resource_type,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetTopCapacityConsumerRequest_Scope string
@@ -2748,6 +2719,7 @@ type GetTopCapacityConsumerResponse_TopConsumer struct {
 type GetUnformattedDevicesRequest struct {
 	ServiceUuid string `json:"service_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetUnformattedDevicesResponse struct {
@@ -2757,6 +2729,7 @@ type GetUnformattedDevicesResponse struct {
 type GetUsersRequest struct {
 	UserId []string `json:"user_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetUsersResponse struct {
@@ -2769,6 +2742,7 @@ type GetVolumeListRequest struct {
 	// Restrict query to tenant domain
 	TenantDomain string `json:"tenant_domain,omitempty"`
 	retryPolicy
+	presence
 }
 
 type GetVolumeListResponse struct {
@@ -2833,6 +2807,7 @@ type ImportAccessKeysRequest struct {
 	UserName         string              `json:"user_name,omitempty"`
 	AccessKeyDetails []*AccessKeyDetails `json:"access_key_details,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ImportAccessKeysResponse struct {
@@ -2846,6 +2821,7 @@ type ImportConfigurationRequest struct {
 	// Textual representation of the configuration. The configuration is being deleted if this is empty.
 	ProtoDump string `json:"proto_dump,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ImportConfigurationResponse struct {
@@ -2856,6 +2832,7 @@ type ImportPolicyRulesRequest struct {
 	// Must have the format of EditablePolicyRuleSet. If only a single policy rule is defined: Update it, if UUID is set, and create it otherwise. If multiple are defined, set all as current set. Must not import default policy rules.
 	ProtoDump string `json:"proto_dump,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ImportPolicyRulesResponse struct {
@@ -2937,6 +2914,7 @@ type LabelPattern struct {
 
 type ListCaRequest struct {
 	retryPolicy
+	presence
 }
 
 type ListCaResponse struct {
@@ -2946,6 +2924,7 @@ type ListCaResponse struct {
 
 type ListCertificatesRequest struct {
 	retryPolicy
+	presence
 }
 
 type ListCertificatesResponse struct {
@@ -2957,6 +2936,7 @@ type ListCsrRequest struct {
 	// Limit to state
 	State CsrState `json:"state,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ListCsrResponse struct {
@@ -2966,6 +2946,7 @@ type ListCsrResponse struct {
 
 type ListRegistryReplicasRequest struct {
 	retryPolicy
+	presence
 }
 
 type ListRegistryReplicasResponse struct {
@@ -2977,6 +2958,7 @@ type ListSnapshotsRequest struct {
 	// Volume uuid
 	VolumeUuid string `json:"volume_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ListSnapshotsResponse struct {
@@ -2991,6 +2973,7 @@ type MakeDeviceRequest struct {
 	Comment          string                        `json:"comment,omitempty"`
 	InitialDeviceTag []string                      `json:"initial_device_tag,omitempty"`
 	retryPolicy
+	presence
 }
 
 type MakeDeviceResponse struct {
@@ -3248,6 +3231,7 @@ type PublishBucketVolumeRequest struct {
 	// Make the volume available as S3 bucket using the given name.
 	BucketName string `json:"bucket_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type PublishBucketVolumeResponse struct {
@@ -3269,6 +3253,7 @@ type QueryFilesRequest struct {
 	// Also iterate unlinked files, and files in snapshots. Slower, and not for all management roles.
 	IterateAll bool `json:"iterate_all,omitempty"`
 	retryPolicy
+	presence
 }
 
 type QueryFilesResponse struct {
@@ -3308,6 +3293,7 @@ type RegenerateDatabaseRequest struct {
 	// Database identifier, like a volume UUID for VOLUME_ACCOUNTING database type for example.
 	DatabaseIdentifier string `json:"database_identifier,omitempty"`
 	retryPolicy
+	presence
 }
 
 type RegenerateDatabaseRequest_DatabaseType string
@@ -3323,6 +3309,7 @@ type RegenerateDatabaseResponse struct {
 type RemoveKeystoreSlotRequest struct {
 	KeystoreSlotUuid string `json:"keystore_slot_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type RemoveKeystoreSlotResponse struct {
@@ -3331,6 +3318,7 @@ type RemoveKeystoreSlotResponse struct {
 type RemoveMasterKeystoreSlotRequest struct {
 	KeystoreSlotUuid string `json:"keystore_slot_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type RemoveMasterKeystoreSlotResponse struct {
@@ -3342,6 +3330,7 @@ type RemoveRegistryReplicaRequest struct {
 	// Optional comment field for auditing.
 	Comment string `json:"comment,omitempty"`
 	retryPolicy
+	presence
 }
 
 type RemoveRegistryReplicaResponse struct {
@@ -3374,6 +3363,7 @@ type ReplicationRedundancyDetailsPolicy_FileStructure struct {
 type ResolveGlobalFileIdRequest struct {
 	GlobalFileId string `json:"global_file_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ResolveGlobalFileIdResponse struct {
@@ -3385,6 +3375,7 @@ type ResolveGlobalFileIdResponse struct {
 type ResolvePolicyRuleNameRequest struct {
 	PolicyRuleName string `json:"policy_rule_name,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ResolvePolicyRuleNameResponse struct {
@@ -3397,6 +3388,7 @@ type ResolveTenantNameRequest struct {
 	// resolve tenant name by tenant id
 	TenantId string `json:"tenant_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ResolveTenantNameResponse struct {
@@ -3408,6 +3400,7 @@ type ResolveVolumeNameRequest struct {
 	VolumeName   string `json:"volume_name,omitempty"`
 	TenantDomain string `json:"tenant_domain,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ResolveVolumeNameResponse struct {
@@ -3463,6 +3456,7 @@ type ResumeTaskRequest struct {
 	// List of one or more IDs of the tasks to be resumed
 	TaskId []string `json:"task_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type ResumeTaskResponse struct {
@@ -3472,6 +3466,7 @@ type RetryTaskRequest struct {
 	// List of one or more IDs of the tasks to be restarted
 	TaskId []string `json:"task_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type RetryTaskResponse struct {
@@ -3483,6 +3478,7 @@ type RevokeCertificateRequest struct {
 	// Reason to revoke according to RFC 5280
 	CrlReason CrlReason `json:"crl_reason,omitempty"`
 	retryPolicy
+	presence
 }
 
 type RevokeCertificateResponse struct {
@@ -3626,6 +3622,7 @@ type SetCertificateOwnerRequest struct {
 	// Tenant id
 	TenantId string `json:"tenant_id,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetCertificateOwnerResponse struct {
@@ -3637,6 +3634,7 @@ type SetCertificateSubjectRequest struct {
 	// Certificate subject description
 	Subject CertificateSubject `json:"subject,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetCertificateSubjectResponse struct {
@@ -3652,6 +3650,7 @@ type SetConfigurationRequest struct {
 	RuleConfiguration          RuleConfiguration          `json:"rule_configuration,omitempty"`
 	VirtualIps                 VirtualIpGroup             `json:"virtual_ips,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetConfigurationResponse struct {
@@ -3667,6 +3666,7 @@ type SetEncryptedVolumeKeyRequest struct {
 	NewKeystoreSlotUuid                     string              `json:"new_keystore_slot_uuid,omitempty"`
 	EncodedNewKeystoreSlotPasswordHash      string              `json:"encoded_new_keystore_slot_password_hash,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetEncryptedVolumeKeyResponse struct {
@@ -3675,6 +3675,7 @@ type SetEncryptedVolumeKeyResponse struct {
 type SetLabelsRequest struct {
 	Label []*Label `json:"label,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetLabelsResponse struct {
@@ -3683,6 +3684,7 @@ type SetLabelsResponse struct {
 type SetLicenseKeyRequest struct {
 	Key string `json:"key,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetLicenseKeyResponse struct {
@@ -3692,6 +3694,7 @@ type SetLicenseKeyResponse struct {
 type SetNotificationRuleRequest struct {
 	Rule NotificationRule `json:"rule,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetNotificationRuleResponse struct {
@@ -3700,6 +3703,7 @@ type SetNotificationRuleResponse struct {
 type SetQuotaRequest struct {
 	Quotas []*Quota `json:"quotas,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetQuotaResponse struct {
@@ -3710,6 +3714,7 @@ type SetTenantRequest struct {
 	// Labels to set for the tenant prior to its creation. Name and value are sufficient.
 	OnCreateLabel []*Label `json:"on_create_label,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SetTenantResponse struct {
@@ -3721,6 +3726,7 @@ type SilenceAlertRequest struct {
 	Qualifiers      FiringRule `json:"qualifiers,omitempty"`
 	SilenceForS     int64      `json:"silence_for_s,omitempty"`
 	retryPolicy
+	presence
 }
 
 type SilenceAlertResponse struct {
@@ -3733,6 +3739,7 @@ type SpecialFilePlacementPolicy struct {
 
 type StartNetworkTestRequest struct {
 	retryPolicy
+	presence
 }
 
 type StartNetworkTestResponse struct {
@@ -4517,6 +4524,7 @@ type TriggerVolumeCheckpointRequest struct {
 	// Volume uuid
 	VolumeUuid string `json:"volume_uuid,omitempty"`
 	retryPolicy
+	presence
 }
 
 type TriggerVolumeCheckpointResponse struct {
@@ -4538,6 +4546,7 @@ type UnformattedDevice struct {
 type UnlockMasterKeystoreSlotRequest struct {
 	MasterKeystoreSlotPassword string `json:"master_keystore_slot_password,omitempty"`
 	retryPolicy
+	presence
 }
 
 type UnlockMasterKeystoreSlotResponse struct {
@@ -4549,6 +4558,7 @@ type UnpublishBucketVolumeRequest struct {
 	ObsoleteBucketName  string `json:"OBSOLETE_bucket_name,omitempty"`
 	ObsoleteBucketOwner string `json:"OBSOLETE_bucket_owner,omitempty"`
 	retryPolicy
+	presence
 }
 
 type UnpublishBucketVolumeResponse struct {
@@ -4577,6 +4587,7 @@ type UpdateDeviceRequest struct {
 	SetFilesystemCheckBeforeMount Device_FileSystemCheckBeforeMount `json:"set_filesystem_check_before_mount,omitempty"`
 	SetTrimDeviceMethod           Device_TrimDeviceMethod           `json:"set_trim_device_method,omitempty"`
 	retryPolicy
+	presence
 }
 
 type UpdateDeviceResponse struct {
@@ -4586,6 +4597,7 @@ type UpdatePolicyRulesRequest struct {
 	// Disallows default. Default policy Rules can't be created.
 	PolicyRule []*PolicyRule `json:"policy_rule,omitempty"`
 	retryPolicy
+	presence
 }
 
 type UpdatePolicyRulesResponse struct {
@@ -4604,6 +4616,7 @@ type UpdateUserRequest struct {
 	MemberOfGroup    []string `json:"member_of_group,omitempty"`
 	PrimaryGroup     string   `json:"primary_group,omitempty"`
 	retryPolicy
+	presence
 }
 
 type UpdateUserResponse struct {
@@ -4630,6 +4643,7 @@ type UpdateVolumeRequest struct {
 	ObsoleteBucketOwner  string `json:"OBSOLETE_bucket_owner,omitempty"`
 	ObsoleteBucketDomain string `json:"OBSOLETE_bucket_domain,omitempty"`
 	retryPolicy
+	presence
 }
 
 type UpdateVolumeResponse struct {
@@ -4694,6 +4708,7 @@ const (
 type VerifyLicenseRequest struct {
 	Key string `json:"key,omitempty"`
 	retryPolicy
+	presence
 }
 
 type VerifyLicenseResponse struct {
@@ -4856,6 +4871,7 @@ type VolumeSnapshotRetentionPolicy struct {
 
 type WhoAmIRequest struct {
 	retryPolicy
+	presence
 }
 
 type WhoAmIResponse struct {
@@ -4893,1088 +4909,1632 @@ type XAttr struct {
 }
 
 func (client *QuobyteClient) AcceptTermsAndConditions(request *AcceptTermsAndConditionsRequest) (result *AcceptTermsAndConditionsResponse, err error) {
+	return client.AcceptTermsAndConditionsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) AcceptTermsAndConditionsContext(ctx context.Context, request *AcceptTermsAndConditionsRequest) (result *AcceptTermsAndConditionsResponse, err error) {
 	var response AcceptTermsAndConditionsResponse
-	if err = client.sendRequest("acceptTermsAndConditions", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodAcceptTermsAndConditions, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) AcknowledgeAlert(request *AcknowledgeAlertRequest) (result *AcknowledgeAlertResponse, err error) {
+	return client.AcknowledgeAlertContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) AcknowledgeAlertContext(ctx context.Context, request *AcknowledgeAlertRequest) (result *AcknowledgeAlertResponse, err error) {
 	var response AcknowledgeAlertResponse
-	if err = client.sendRequest("acknowledgeAlert", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodAcknowledgeAlert, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) AddCa(request *AddCaRequest) (result *AddCaResponse, err error) {
+	return client.AddCaContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) AddCaContext(ctx context.Context, request *AddCaRequest) (result *AddCaResponse, err error) {
 	var response AddCaResponse
-	if err = client.sendRequest("addCa", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodAddCa, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) AddCertificate(request *AddCertificateRequest) (result *AddCertificateResponse, err error) {
+	return client.AddCertificateContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) AddCertificateContext(ctx context.Context, request *AddCertificateRequest) (result *AddCertificateResponse, err error) {
 	var response AddCertificateResponse
-	if err = client.sendRequest("addCertificate", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodAddCertificate, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) AddCsr(request *AddCsrRequest) (result *AddCsrResponse, err error) {
+	return client.AddCsrContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) AddCsrContext(ctx context.Context, request *AddCsrRequest) (result *AddCsrResponse, err error) {
 	var response AddCsrResponse
-	if err = client.sendRequest("addCsr", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodAddCsr, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) AddRegistryReplica(request *AddRegistryReplicaRequest) (result *AddRegistryReplicaResponse, err error) {
+	return client.AddRegistryReplicaContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) AddRegistryReplicaContext(ctx context.Context, request *AddRegistryReplicaRequest) (result *AddRegistryReplicaResponse, err error) {
 	var response AddRegistryReplicaResponse
-	if err = client.sendRequest("addRegistryReplica", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodAddRegistryReplica, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) AnalyzeVolumes(request *AnalyzeVolumesRequest) (result *AnalyzeVolumesResponse, err error) {
+	return client.AnalyzeVolumesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) AnalyzeVolumesContext(ctx context.Context, request *AnalyzeVolumesRequest) (result *AnalyzeVolumesResponse, err error) {
 	var response AnalyzeVolumesResponse
-	if err = client.sendRequest("analyzeVolumes", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodAnalyzeVolumes, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CancelNetworkTest(request *CancelNetworkTestRequest) (result *CancelNetworkTestResponse, err error) {
+	return client.CancelNetworkTestContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CancelNetworkTestContext(ctx context.Context, request *CancelNetworkTestRequest) (result *CancelNetworkTestResponse, err error) {
 	var response CancelNetworkTestResponse
-	if err = client.sendRequest("cancelNetworkTest", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCancelNetworkTest, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CancelQuery(request *CancelQueryRequest) (result *CancelQueryResponse, err error) {
+	return client.CancelQueryContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CancelQueryContext(ctx context.Context, request *CancelQueryRequest) (result *CancelQueryResponse, err error) {
 	var response CancelQueryResponse
-	if err = client.sendRequest("cancelQuery", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCancelQuery, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CancelSupportDump(request *CancelSupportDumpRequest) (result *CancelSupportDumpResponse, err error) {
+	return client.CancelSupportDumpContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CancelSupportDumpContext(ctx context.Context, request *CancelSupportDumpRequest) (result *CancelSupportDumpResponse, err error) {
 	var response CancelSupportDumpResponse
-	if err = client.sendRequest("cancelSupportDump", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCancelSupportDump, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CancelTask(request *CancelTaskRequest) (result *CancelTaskResponse, err error) {
+	return client.CancelTaskContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CancelTaskContext(ctx context.Context, request *CancelTaskRequest) (result *CancelTaskResponse, err error) {
 	var response CancelTaskResponse
-	if err = client.sendRequest("cancelTask", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCancelTask, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CancelVolumeErasure(request *CancelVolumeErasureRequest) (result *CancelVolumeErasureResponse, err error) {
+	return client.CancelVolumeErasureContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CancelVolumeErasureContext(ctx context.Context, request *CancelVolumeErasureRequest) (result *CancelVolumeErasureResponse, err error) {
 	var response CancelVolumeErasureResponse
-	if err = client.sendRequest("cancelVolumeErasure", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCancelVolumeErasure, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ChangePolicyRulePriority(request *ChangePolicyRulePriorityRequest) (result *ChangePolicyRulePriorityResponse, err error) {
+	return client.ChangePolicyRulePriorityContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ChangePolicyRulePriorityContext(ctx context.Context, request *ChangePolicyRulePriorityRequest) (result *ChangePolicyRulePriorityResponse, err error) {
 	var response ChangePolicyRulePriorityResponse
-	if err = client.sendRequest("changePolicyRulePriority", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodChangePolicyRulePriority, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ConfigureRule(request *ConfigureRuleRequest) (result *ConfigureRuleResponse, err error) {
+	return client.ConfigureRuleContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ConfigureRuleContext(ctx context.Context, request *ConfigureRuleRequest) (result *ConfigureRuleResponse, err error) {
 	var response ConfigureRuleResponse
-	if err = client.sendRequest("configureRule", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodConfigureRule, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateAccessKeyCredentials(request *CreateAccessKeyCredentialsRequest) (result *CreateAccessKeyCredentialsResponse, err error) {
+	return client.CreateAccessKeyCredentialsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateAccessKeyCredentialsContext(ctx context.Context, request *CreateAccessKeyCredentialsRequest) (result *CreateAccessKeyCredentialsResponse, err error) {
 	var response CreateAccessKeyCredentialsResponse
-	if err = client.sendRequest("createAccessKeyCredentials", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateAccessKeyCredentials, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateMasterKeystoreSlot(request *CreateMasterKeystoreSlotRequest) (result *CreateMasterKeystoreSlotResponse, err error) {
+	return client.CreateMasterKeystoreSlotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateMasterKeystoreSlotContext(ctx context.Context, request *CreateMasterKeystoreSlotRequest) (result *CreateMasterKeystoreSlotResponse, err error) {
 	var response CreateMasterKeystoreSlotResponse
-	if err = client.sendRequest("createMasterKeystoreSlot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateMasterKeystoreSlot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateMirroredVolume(request *CreateMirroredVolumeRequest) (result *CreateMirroredVolumeResponse, err error) {
+	return client.CreateMirroredVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateMirroredVolumeContext(ctx context.Context, request *CreateMirroredVolumeRequest) (result *CreateMirroredVolumeResponse, err error) {
 	var response CreateMirroredVolumeResponse
-	if err = client.sendRequest("createMirroredVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateMirroredVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateNewUserKeystoreSlot(request *CreateNewUserKeystoreSlotRequest) (result *CreateNewUserKeystoreSlotResponse, err error) {
+	return client.CreateNewUserKeystoreSlotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateNewUserKeystoreSlotContext(ctx context.Context, request *CreateNewUserKeystoreSlotRequest) (result *CreateNewUserKeystoreSlotResponse, err error) {
 	var response CreateNewUserKeystoreSlotResponse
-	if err = client.sendRequest("createNewUserKeystoreSlot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateNewUserKeystoreSlot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateNotificationRule(request *CreateNotificationRuleRequest) (result *CreateNotificationRuleResponse, err error) {
+	return client.CreateNotificationRuleContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateNotificationRuleContext(ctx context.Context, request *CreateNotificationRuleRequest) (result *CreateNotificationRuleResponse, err error) {
 	var response CreateNotificationRuleResponse
-	if err = client.sendRequest("createNotificationRule", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateNotificationRule, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreatePolicyRule(request *CreatePolicyRuleRequest) (result *CreatePolicyRuleResponse, err error) {
+	return client.CreatePolicyRuleContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreatePolicyRuleContext(ctx context.Context, request *CreatePolicyRuleRequest) (result *CreatePolicyRuleResponse, err error) {
 	var response CreatePolicyRuleResponse
-	if err = client.sendRequest("createPolicyRule", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreatePolicyRule, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreatePolicyRuleSet(request *CreatePolicyRuleSetRequest) (result *CreatePolicyRuleSetResponse, err error) {
+	return client.CreatePolicyRuleSetContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreatePolicyRuleSetContext(ctx context.Context, request *CreatePolicyRuleSetRequest) (result *CreatePolicyRuleSetResponse, err error) {
 	var response CreatePolicyRuleSetResponse
-	if err = client.sendRequest("createPolicyRuleSet", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreatePolicyRuleSet, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateSnapshot(request *CreateSnapshotRequest) (result *CreateSnapshotResponse, err error) {
+	return client.CreateSnapshotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateSnapshotContext(ctx context.Context, request *CreateSnapshotRequest) (result *CreateSnapshotResponse, err error) {
 	var response CreateSnapshotResponse
-	if err = client.sendRequest("createSnapshot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateSnapshot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateTask(request *CreateTaskRequest) (result *CreateTaskResponse, err error) {
+	return client.CreateTaskContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateTaskContext(ctx context.Context, request *CreateTaskRequest) (result *CreateTaskResponse, err error) {
 	var response CreateTaskResponse
-	if err = client.sendRequest("createTask", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateTask, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateUser(request *CreateUserRequest) (result *CreateUserResponse, err error) {
+	return client.CreateUserContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateUserContext(ctx context.Context, request *CreateUserRequest) (result *CreateUserResponse, err error) {
 	var response CreateUserResponse
-	if err = client.sendRequest("createUser", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateUser, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) CreateVolume(request *CreateVolumeRequest) (result *CreateVolumeResponse, err error) {
+	return client.CreateVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) CreateVolumeContext(ctx context.Context, request *CreateVolumeRequest) (result *CreateVolumeResponse, err error) {
 	var response CreateVolumeResponse
-	if err = client.sendRequest("createVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodCreateVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DecideCsr(request *DecideCsrRequest) (result *DecideCsrResponse, err error) {
+	return client.DecideCsrContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DecideCsrContext(ctx context.Context, request *DecideCsrRequest) (result *DecideCsrResponse, err error) {
 	var response DecideCsrResponse
-	if err = client.sendRequest("decideCsr", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDecideCsr, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteAccessKeyCredentials(request *DeleteAccessKeyCredentialsRequest) (result *DeleteAccessKeyCredentialsResponse, err error) {
+	return client.DeleteAccessKeyCredentialsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteAccessKeyCredentialsContext(ctx context.Context, request *DeleteAccessKeyCredentialsRequest) (result *DeleteAccessKeyCredentialsResponse, err error) {
 	var response DeleteAccessKeyCredentialsResponse
-	if err = client.sendRequest("deleteAccessKeyCredentials", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteAccessKeyCredentials, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteCa(request *DeleteCaRequest) (result *DeleteCaResponse, err error) {
+	return client.DeleteCaContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteCaContext(ctx context.Context, request *DeleteCaRequest) (result *DeleteCaResponse, err error) {
 	var response DeleteCaResponse
-	if err = client.sendRequest("deleteCa", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteCa, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteCertificate(request *DeleteCertificateRequest) (result *DeleteCertificateResponse, err error) {
+	return client.DeleteCertificateContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteCertificateContext(ctx context.Context, request *DeleteCertificateRequest) (result *DeleteCertificateResponse, err error) {
 	var response DeleteCertificateResponse
-	if err = client.sendRequest("deleteCertificate", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteCertificate, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteConfiguration(request *DeleteConfigurationRequest) (result *DeleteConfigurationResponse, err error) {
+	return client.DeleteConfigurationContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteConfigurationContext(ctx context.Context, request *DeleteConfigurationRequest) (result *DeleteConfigurationResponse, err error) {
 	var response DeleteConfigurationResponse
-	if err = client.sendRequest("deleteConfiguration", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteConfiguration, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteCsr(request *DeleteCsrRequest) (result *DeleteCsrResponse, err error) {
+	return client.DeleteCsrContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteCsrContext(ctx context.Context, request *DeleteCsrRequest) (result *DeleteCsrResponse, err error) {
 	var response DeleteCsrResponse
-	if err = client.sendRequest("deleteCsr", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteCsr, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteLabels(request *DeleteLabelsRequest) (result *DeleteLabelsResponse, err error) {
+	return client.DeleteLabelsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteLabelsContext(ctx context.Context, request *DeleteLabelsRequest) (result *DeleteLabelsResponse, err error) {
 	var response DeleteLabelsResponse
-	if err = client.sendRequest("deleteLabels", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteLabels, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteNotificationRule(request *DeleteNotificationRuleRequest) (result *DeleteNotificationRuleResponse, err error) {
+	return client.DeleteNotificationRuleContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteNotificationRuleContext(ctx context.Context, request *DeleteNotificationRuleRequest) (result *DeleteNotificationRuleResponse, err error) {
 	var response DeleteNotificationRuleResponse
-	if err = client.sendRequest("deleteNotificationRule", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteNotificationRule, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeletePolicyRules(request *DeletePolicyRulesRequest) (result *DeletePolicyRulesResponse, err error) {
+	return client.DeletePolicyRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeletePolicyRulesContext(ctx context.Context, request *DeletePolicyRulesRequest) (result *DeletePolicyRulesResponse, err error) {
 	var response DeletePolicyRulesResponse
-	if err = client.sendRequest("deletePolicyRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeletePolicyRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteSnapshot(request *DeleteSnapshotRequest) (result *DeleteSnapshotResponse, err error) {
+	return client.DeleteSnapshotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteSnapshotContext(ctx context.Context, request *DeleteSnapshotRequest) (result *DeleteSnapshotResponse, err error) {
 	var response DeleteSnapshotResponse
-	if err = client.sendRequest("deleteSnapshot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteSnapshot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteTenant(request *DeleteTenantRequest) (result *DeleteTenantResponse, err error) {
+	return client.DeleteTenantContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteTenantContext(ctx context.Context, request *DeleteTenantRequest) (result *DeleteTenantResponse, err error) {
 	var response DeleteTenantResponse
-	if err = client.sendRequest("deleteTenant", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteTenant, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteUser(request *DeleteUserRequest) (result *DeleteUserResponse, err error) {
+	return client.DeleteUserContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteUserContext(ctx context.Context, request *DeleteUserRequest) (result *DeleteUserResponse, err error) {
 	var response DeleteUserResponse
-	if err = client.sendRequest("deleteUser", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteUser, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeleteVolume(request *DeleteVolumeRequest) (result *DeleteVolumeResponse, err error) {
+	return client.DeleteVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeleteVolumeContext(ctx context.Context, request *DeleteVolumeRequest) (result *DeleteVolumeResponse, err error) {
 	var response DeleteVolumeResponse
-	if err = client.sendRequest("deleteVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeleteVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DeregisterService(request *DeregisterServiceRequest) (result *DeregisterServiceResponse, err error) {
+	return client.DeregisterServiceContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DeregisterServiceContext(ctx context.Context, request *DeregisterServiceRequest) (result *DeregisterServiceResponse, err error) {
 	var response DeregisterServiceResponse
-	if err = client.sendRequest("deregisterService", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDeregisterService, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DisconnectMirroredVolume(request *DisconnectMirroredVolumeRequest) (result *DisconnectMirroredVolumeResponse, err error) {
+	return client.DisconnectMirroredVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DisconnectMirroredVolumeContext(ctx context.Context, request *DisconnectMirroredVolumeRequest) (result *DisconnectMirroredVolumeResponse, err error) {
 	var response DisconnectMirroredVolumeResponse
-	if err = client.sendRequest("disconnectMirroredVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDisconnectMirroredVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DumpEffectivePolicyRules(request *DumpEffectivePolicyRulesRequest) (result *DumpEffectivePolicyRulesResponse, err error) {
+	return client.DumpEffectivePolicyRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DumpEffectivePolicyRulesContext(ctx context.Context, request *DumpEffectivePolicyRulesRequest) (result *DumpEffectivePolicyRulesResponse, err error) {
 	var response DumpEffectivePolicyRulesResponse
-	if err = client.sendRequest("dumpEffectivePolicyRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDumpEffectivePolicyRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) DumpPolicyPresets(request *DumpPolicyPresetsRequest) (result *DumpPolicyPresetsResponse, err error) {
+	return client.DumpPolicyPresetsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) DumpPolicyPresetsContext(ctx context.Context, request *DumpPolicyPresetsRequest) (result *DumpPolicyPresetsResponse, err error) {
 	var response DumpPolicyPresetsResponse
-	if err = client.sendRequest("dumpPolicyPresets", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodDumpPolicyPresets, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) EraseSnapshot(request *EraseSnapshotRequest) (result *EraseSnapshotResponse, err error) {
+	return client.EraseSnapshotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) EraseSnapshotContext(ctx context.Context, request *EraseSnapshotRequest) (result *EraseSnapshotResponse, err error) {
 	var response EraseSnapshotResponse
-	if err = client.sendRequest("eraseSnapshot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodEraseSnapshot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) EraseVolume(request *EraseVolumeRequest) (result *EraseVolumeResponse, err error) {
+	return client.EraseVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) EraseVolumeContext(ctx context.Context, request *EraseVolumeRequest) (result *EraseVolumeResponse, err error) {
 	var response EraseVolumeResponse
-	if err = client.sendRequest("eraseVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodEraseVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ExportCertificate(request *ExportCertificateRequest) (result *ExportCertificateResponse, err error) {
+	return client.ExportCertificateContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ExportCertificateContext(ctx context.Context, request *ExportCertificateRequest) (result *ExportCertificateResponse, err error) {
 	var response ExportCertificateResponse
-	if err = client.sendRequest("exportCertificate", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodExportCertificate, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ExportConfiguration(request *ExportConfigurationRequest) (result *ExportConfigurationResponse, err error) {
+	return client.ExportConfigurationContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ExportConfigurationContext(ctx context.Context, request *ExportConfigurationRequest) (result *ExportConfigurationResponse, err error) {
 	var response ExportConfigurationResponse
-	if err = client.sendRequest("exportConfiguration", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodExportConfiguration, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ExportPolicyRules(request *ExportPolicyRulesRequest) (result *ExportPolicyRulesResponse, err error) {
+	return client.ExportPolicyRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ExportPolicyRulesContext(ctx context.Context, request *ExportPolicyRulesRequest) (result *ExportPolicyRulesResponse, err error) {
 	var response ExportPolicyRulesResponse
-	if err = client.sendRequest("exportPolicyRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodExportPolicyRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ExportVolume(request *ExportVolumeRequest) (result *ExportVolumeResponse, err error) {
+	return client.ExportVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ExportVolumeContext(ctx context.Context, request *ExportVolumeRequest) (result *ExportVolumeResponse, err error) {
 	var response ExportVolumeResponse
-	if err = client.sendRequest("exportVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodExportVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) FilterPolicyRules(request *FilterPolicyRulesRequest) (result *FilterPolicyRulesResponse, err error) {
+	return client.FilterPolicyRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) FilterPolicyRulesContext(ctx context.Context, request *FilterPolicyRulesRequest) (result *FilterPolicyRulesResponse, err error) {
 	var response FilterPolicyRulesResponse
-	if err = client.sendRequest("filterPolicyRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodFilterPolicyRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GenerateAsyncSupportDump(request *GenerateAsyncSupportDumpRequest) (result *GenerateAsyncSupportDumpResponse, err error) {
+	return client.GenerateAsyncSupportDumpContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GenerateAsyncSupportDumpContext(ctx context.Context, request *GenerateAsyncSupportDumpRequest) (result *GenerateAsyncSupportDumpResponse, err error) {
 	var response GenerateAsyncSupportDumpResponse
-	if err = client.sendRequest("generateAsyncSupportDump", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGenerateAsyncSupportDump, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetAccounting(request *GetAccountingRequest) (result *GetAccountingResponse, err error) {
+	return client.GetAccountingContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetAccountingContext(ctx context.Context, request *GetAccountingRequest) (result *GetAccountingResponse, err error) {
 	var response GetAccountingResponse
-	if err = client.sendRequest("getAccounting", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetAccounting, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetAddKeySlotData(request *GetAddKeySlotDataRequest) (result *GetAddKeySlotDataResponse, err error) {
+	return client.GetAddKeySlotDataContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetAddKeySlotDataContext(ctx context.Context, request *GetAddKeySlotDataRequest) (result *GetAddKeySlotDataResponse, err error) {
 	var response GetAddKeySlotDataResponse
-	if err = client.sendRequest("getAddKeySlotData", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetAddKeySlotData, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetAnalyzeReports(request *GetAnalyzeReportsRequest) (result *GetAnalyzeReportsResponse, err error) {
+	return client.GetAnalyzeReportsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetAnalyzeReportsContext(ctx context.Context, request *GetAnalyzeReportsRequest) (result *GetAnalyzeReportsResponse, err error) {
 	var response GetAnalyzeReportsResponse
-	if err = client.sendRequest("getAnalyzeReports", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetAnalyzeReports, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetAuditLog(request *GetAuditLogRequest) (result *GetAuditLogResponse, err error) {
+	return client.GetAuditLogContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetAuditLogContext(ctx context.Context, request *GetAuditLogRequest) (result *GetAuditLogResponse, err error) {
 	var response GetAuditLogResponse
-	if err = client.sendRequest("getAuditLog", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetAuditLog, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetCertificateSubject(request *GetCertificateSubjectRequest) (result *GetCertificateSubjectResponse, err error) {
+	return client.GetCertificateSubjectContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetCertificateSubjectContext(ctx context.Context, request *GetCertificateSubjectRequest) (result *GetCertificateSubjectResponse, err error) {
 	var response GetCertificateSubjectResponse
-	if err = client.sendRequest("getCertificateSubject", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetCertificateSubject, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetClientList(request *GetClientListRequest) (result *GetClientListResponse, err error) {
+	return client.GetClientListContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetClientListContext(ctx context.Context, request *GetClientListRequest) (result *GetClientListResponse, err error) {
 	var response GetClientListResponse
-	if err = client.sendRequest("getClientList", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetClientList, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetConfiguration(request *GetConfigurationRequest) (result *GetConfigurationResponse, err error) {
+	return client.GetConfigurationContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetConfigurationContext(ctx context.Context, request *GetConfigurationRequest) (result *GetConfigurationResponse, err error) {
 	var response GetConfigurationResponse
-	if err = client.sendRequest("getConfiguration", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetConfiguration, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetDefaultKeyStoreSlotParams(request *GetDefaultKeyStoreSlotParamsRequest) (result *GetDefaultKeyStoreSlotParamsResponse, err error) {
+	return client.GetDefaultKeyStoreSlotParamsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetDefaultKeyStoreSlotParamsContext(ctx context.Context, request *GetDefaultKeyStoreSlotParamsRequest) (result *GetDefaultKeyStoreSlotParamsResponse, err error) {
 	var response GetDefaultKeyStoreSlotParamsResponse
-	if err = client.sendRequest("getDefaultKeyStoreSlotParams", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetDefaultKeyStoreSlotParams, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetDeviceGroups(request *GetDeviceGroupsRequest) (result *GetDeviceGroupsResponse, err error) {
+	return client.GetDeviceGroupsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetDeviceGroupsContext(ctx context.Context, request *GetDeviceGroupsRequest) (result *GetDeviceGroupsResponse, err error) {
 	var response GetDeviceGroupsResponse
-	if err = client.sendRequest("getDeviceGroups", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetDeviceGroups, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetDeviceIds(request *GetDeviceIdsRequest) (result *GetDeviceIdsResponse, err error) {
+	return client.GetDeviceIdsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetDeviceIdsContext(ctx context.Context, request *GetDeviceIdsRequest) (result *GetDeviceIdsResponse, err error) {
 	var response GetDeviceIdsResponse
-	if err = client.sendRequest("getDeviceIds", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetDeviceIds, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetDeviceList(request *GetDeviceListRequest) (result *GetDeviceListResponse, err error) {
+	return client.GetDeviceListContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetDeviceListContext(ctx context.Context, request *GetDeviceListRequest) (result *GetDeviceListResponse, err error) {
 	var response GetDeviceListResponse
-	if err = client.sendRequest("getDeviceList", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetDeviceList, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetDeviceNetworkEndpoints(request *GetDeviceNetworkEndpointsRequest) (result *GetDeviceNetworkEndpointsResponse, err error) {
+	return client.GetDeviceNetworkEndpointsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetDeviceNetworkEndpointsContext(ctx context.Context, request *GetDeviceNetworkEndpointsRequest) (result *GetDeviceNetworkEndpointsResponse, err error) {
 	var response GetDeviceNetworkEndpointsResponse
-	if err = client.sendRequest("getDeviceNetworkEndpoints", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetDeviceNetworkEndpoints, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetDeviceTags(request *GetDeviceTagsRequest) (result *GetDeviceTagsResponse, err error) {
+	return client.GetDeviceTagsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetDeviceTagsContext(ctx context.Context, request *GetDeviceTagsRequest) (result *GetDeviceTagsResponse, err error) {
 	var response GetDeviceTagsResponse
-	if err = client.sendRequest("getDeviceTags", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetDeviceTags, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetEffectiveVolumeConfiguration(request *GetEffectiveVolumeConfigurationRequest) (result *GetEffectiveVolumeConfigurationResponse, err error) {
+	return client.GetEffectiveVolumeConfigurationContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetEffectiveVolumeConfigurationContext(ctx context.Context, request *GetEffectiveVolumeConfigurationRequest) (result *GetEffectiveVolumeConfigurationResponse, err error) {
 	var response GetEffectiveVolumeConfigurationResponse
-	if err = client.sendRequest("getEffectiveVolumeConfiguration", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetEffectiveVolumeConfiguration, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetEncryptStatus(request *GetEncryptStatusRequest) (result *GetEncryptStatusResponse, err error) {
+	return client.GetEncryptStatusContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetEncryptStatusContext(ctx context.Context, request *GetEncryptStatusRequest) (result *GetEncryptStatusResponse, err error) {
 	var response GetEncryptStatusResponse
-	if err = client.sendRequest("getEncryptStatus", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetEncryptStatus, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetEncryptedVolumeKey(request *GetEncryptedVolumeKeyRequest) (result *GetEncryptedVolumeKeyResponse, err error) {
+	return client.GetEncryptedVolumeKeyContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetEncryptedVolumeKeyContext(ctx context.Context, request *GetEncryptedVolumeKeyRequest) (result *GetEncryptedVolumeKeyResponse, err error) {
 	var response GetEncryptedVolumeKeyResponse
-	if err = client.sendRequest("getEncryptedVolumeKey", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetEncryptedVolumeKey, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetFileMetadataDump(request *GetFileMetadataDumpRequest) (result *GetFileMetadataDumpResponse, err error) {
+	return client.GetFileMetadataDumpContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetFileMetadataDumpContext(ctx context.Context, request *GetFileMetadataDumpRequest) (result *GetFileMetadataDumpResponse, err error) {
 	var response GetFileMetadataDumpResponse
-	if err = client.sendRequest("getFileMetadataDump", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetFileMetadataDump, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetFiringRules(request *GetFiringRulesRequest) (result *GetFiringRulesResponse, err error) {
+	return client.GetFiringRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetFiringRulesContext(ctx context.Context, request *GetFiringRulesRequest) (result *GetFiringRulesResponse, err error) {
 	var response GetFiringRulesResponse
-	if err = client.sendRequest("getFiringRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetFiringRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetHealthManagerStatus(request *GetHealthManagerStatusRequest) (result *GetHealthManagerStatusResponse, err error) {
+	return client.GetHealthManagerStatusContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetHealthManagerStatusContext(ctx context.Context, request *GetHealthManagerStatusRequest) (result *GetHealthManagerStatusResponse, err error) {
 	var response GetHealthManagerStatusResponse
-	if err = client.sendRequest("getHealthManagerStatus", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetHealthManagerStatus, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetInformation(request *GetInformationRequest) (result *GetInformationResponse, err error) {
+	return client.GetInformationContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetInformationContext(ctx context.Context, request *GetInformationRequest) (result *GetInformationResponse, err error) {
 	var response GetInformationResponse
-	if err = client.sendRequest("getInformation", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetInformation, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetKeyStoreSlotWithoutHash(request *GetKeyStoreSlotWithoutHashRequest) (result *GetKeyStoreSlotWithoutHashResponse, err error) {
+	return client.GetKeyStoreSlotWithoutHashContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetKeyStoreSlotWithoutHashContext(ctx context.Context, request *GetKeyStoreSlotWithoutHashRequest) (result *GetKeyStoreSlotWithoutHashResponse, err error) {
 	var response GetKeyStoreSlotWithoutHashResponse
-	if err = client.sendRequest("getKeyStoreSlotWithoutHash", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetKeyStoreSlotWithoutHash, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetLabels(request *GetLabelsRequest) (result *GetLabelsResponse, err error) {
+	return client.GetLabelsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetLabelsContext(ctx context.Context, request *GetLabelsRequest) (result *GetLabelsResponse, err error) {
 	var response GetLabelsResponse
-	if err = client.sendRequest("getLabels", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetLabels, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetLatestEvent(request *GetLatestEventRequest) (result *GetLatestEventResponse, err error) {
+	return client.GetLatestEventContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetLatestEventContext(ctx context.Context, request *GetLatestEventRequest) (result *GetLatestEventResponse, err error) {
 	var response GetLatestEventResponse
-	if err = client.sendRequest("getLatestEvent", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetLatestEvent, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetLicense(request *GetLicenseRequest) (result *GetLicenseResponse, err error) {
+	return client.GetLicenseContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetLicenseContext(ctx context.Context, request *GetLicenseRequest) (result *GetLicenseResponse, err error) {
 	var response GetLicenseResponse
-	if err = client.sendRequest("getLicense", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetLicense, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetMasterKeystoreSlots(request *GetMasterKeystoreSlotsRequest) (result *GetMasterKeystoreSlotsResponse, err error) {
+	return client.GetMasterKeystoreSlotsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetMasterKeystoreSlotsContext(ctx context.Context, request *GetMasterKeystoreSlotsRequest) (result *GetMasterKeystoreSlotsResponse, err error) {
 	var response GetMasterKeystoreSlotsResponse
-	if err = client.sendRequest("getMasterKeystoreSlots", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetMasterKeystoreSlots, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetNetworkTestResult(request *GetNetworkTestResultRequest) (result *GetNetworkTestResultResponse, err error) {
+	return client.GetNetworkTestResultContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetNetworkTestResultContext(ctx context.Context, request *GetNetworkTestResultRequest) (result *GetNetworkTestResultResponse, err error) {
 	var response GetNetworkTestResultResponse
-	if err = client.sendRequest("getNetworkTestResult", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetNetworkTestResult, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetNotificationRules(request *GetNotificationRulesRequest) (result *GetNotificationRulesResponse, err error) {
+	return client.GetNotificationRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetNotificationRulesContext(ctx context.Context, request *GetNotificationRulesRequest) (result *GetNotificationRulesResponse, err error) {
 	var response GetNotificationRulesResponse
-	if err = client.sendRequest("getNotificationRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetNotificationRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetPolicyPresets(request *GetPolicyPresetsRequest) (result *GetPolicyPresetsResponse, err error) {
+	return client.GetPolicyPresetsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetPolicyPresetsContext(ctx context.Context, request *GetPolicyPresetsRequest) (result *GetPolicyPresetsResponse, err error) {
 	var response GetPolicyPresetsResponse
-	if err = client.sendRequest("getPolicyPresets", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetPolicyPresets, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetPolicyRuleSets(request *GetPolicyRuleSetsRequest) (result *GetPolicyRuleSetsResponse, err error) {
+	return client.GetPolicyRuleSetsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetPolicyRuleSetsContext(ctx context.Context, request *GetPolicyRuleSetsRequest) (result *GetPolicyRuleSetsResponse, err error) {
 	var response GetPolicyRuleSetsResponse
-	if err = client.sendRequest("getPolicyRuleSets", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetPolicyRuleSets, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetPolicyRules(request *GetPolicyRulesRequest) (result *GetPolicyRulesResponse, err error) {
+	return client.GetPolicyRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetPolicyRulesContext(ctx context.Context, request *GetPolicyRulesRequest) (result *GetPolicyRulesResponse, err error) {
 	var response GetPolicyRulesResponse
-	if err = client.sendRequest("getPolicyRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetPolicyRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetQueryProgress(request *GetQueryProgressRequest) (result *GetQueryProgressResponse, err error) {
+	return client.GetQueryProgressContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetQueryProgressContext(ctx context.Context, request *GetQueryProgressRequest) (result *GetQueryProgressResponse, err error) {
 	var response GetQueryProgressResponse
-	if err = client.sendRequest("getQueryProgress", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetQueryProgress, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetQuota(request *GetQuotaRequest) (result *GetQuotaResponse, err error) {
+	return client.GetQuotaContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetQuotaContext(ctx context.Context, request *GetQuotaRequest) (result *GetQuotaResponse, err error) {
 	var response GetQuotaResponse
-	if err = client.sendRequest("getQuota", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetQuota, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetRules(request *GetRulesRequest) (result *GetRulesResponse, err error) {
+	return client.GetRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetRulesContext(ctx context.Context, request *GetRulesRequest) (result *GetRulesResponse, err error) {
 	var response GetRulesResponse
-	if err = client.sendRequest("getRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetServiceDump(request *GetServiceDumpRequest) (result *GetServiceDumpResponse, err error) {
+	return client.GetServiceDumpContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetServiceDumpContext(ctx context.Context, request *GetServiceDumpRequest) (result *GetServiceDumpResponse, err error) {
 	var response GetServiceDumpResponse
-	if err = client.sendRequest("getServiceDump", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetServiceDump, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetServices(request *GetServicesRequest) (result *GetServicesResponse, err error) {
+	return client.GetServicesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetServicesContext(ctx context.Context, request *GetServicesRequest) (result *GetServicesResponse, err error) {
 	var response GetServicesResponse
-	if err = client.sendRequest("getServices", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetServices, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetSupportDump(request *GetSupportDumpRequest) (result *GetSupportDumpResponse, err error) {
+	return client.GetSupportDumpContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetSupportDumpContext(ctx context.Context, request *GetSupportDumpRequest) (result *GetSupportDumpResponse, err error) {
 	var response GetSupportDumpResponse
-	if err = client.sendRequest("getSupportDump", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetSupportDump, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetSupportDumpStatus(request *GetSupportDumpStatusRequest) (result *GetSupportDumpStatusResponse, err error) {
+	return client.GetSupportDumpStatusContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetSupportDumpStatusContext(ctx context.Context, request *GetSupportDumpStatusRequest) (result *GetSupportDumpStatusResponse, err error) {
 	var response GetSupportDumpStatusResponse
-	if err = client.sendRequest("getSupportDumpStatus", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetSupportDumpStatus, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetSystemStatistics(request *GetSystemStatisticsRequest) (result *GetSystemStatisticsResponse, err error) {
+	return client.GetSystemStatisticsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetSystemStatisticsContext(ctx context.Context, request *GetSystemStatisticsRequest) (result *GetSystemStatisticsResponse, err error) {
 	var response GetSystemStatisticsResponse
-	if err = client.sendRequest("getSystemStatistics", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetSystemStatistics, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetTaskList(request *GetTaskListRequest) (result *GetTaskListResponse, err error) {
+	return client.GetTaskListContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetTaskListContext(ctx context.Context, request *GetTaskListRequest) (result *GetTaskListResponse, err error) {
 	var response GetTaskListResponse
-	if err = client.sendRequest("getTaskList", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetTaskList, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetTenant(request *GetTenantRequest) (result *GetTenantResponse, err error) {
+	return client.GetTenantContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetTenantContext(ctx context.Context, request *GetTenantRequest) (result *GetTenantResponse, err error) {
 	var response GetTenantResponse
-	if err = client.sendRequest("getTenant", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetTenant, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetTopCapacityConsumer(request *GetTopCapacityConsumerRequest) (result *GetTopCapacityConsumerResponse, err error) {
+	return client.GetTopCapacityConsumerContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetTopCapacityConsumerContext(ctx context.Context, request *GetTopCapacityConsumerRequest) (result *GetTopCapacityConsumerResponse, err error) {
 	var response GetTopCapacityConsumerResponse
-	if err = client.sendRequest("getTopCapacityConsumer", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetTopCapacityConsumer, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetUnformattedDevices(request *GetUnformattedDevicesRequest) (result *GetUnformattedDevicesResponse, err error) {
+	return client.GetUnformattedDevicesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetUnformattedDevicesContext(ctx context.Context, request *GetUnformattedDevicesRequest) (result *GetUnformattedDevicesResponse, err error) {
 	var response GetUnformattedDevicesResponse
-	if err = client.sendRequest("getUnformattedDevices", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetUnformattedDevices, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetUsers(request *GetUsersRequest) (result *GetUsersResponse, err error) {
+	return client.GetUsersContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetUsersContext(ctx context.Context, request *GetUsersRequest) (result *GetUsersResponse, err error) {
 	var response GetUsersResponse
-	if err = client.sendRequest("getUsers", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetUsers, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) GetVolumeList(request *GetVolumeListRequest) (result *GetVolumeListResponse, err error) {
+	return client.GetVolumeListContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) GetVolumeListContext(ctx context.Context, request *GetVolumeListRequest) (result *GetVolumeListResponse, err error) {
 	var response GetVolumeListResponse
-	if err = client.sendRequest("getVolumeList", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodGetVolumeList, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ImportAccessKeys(request *ImportAccessKeysRequest) (result *ImportAccessKeysResponse, err error) {
+	return client.ImportAccessKeysContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ImportAccessKeysContext(ctx context.Context, request *ImportAccessKeysRequest) (result *ImportAccessKeysResponse, err error) {
 	var response ImportAccessKeysResponse
-	if err = client.sendRequest("importAccessKeys", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodImportAccessKeys, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ImportConfiguration(request *ImportConfigurationRequest) (result *ImportConfigurationResponse, err error) {
+	return client.ImportConfigurationContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ImportConfigurationContext(ctx context.Context, request *ImportConfigurationRequest) (result *ImportConfigurationResponse, err error) {
 	var response ImportConfigurationResponse
-	if err = client.sendRequest("importConfiguration", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodImportConfiguration, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ImportPolicyRules(request *ImportPolicyRulesRequest) (result *ImportPolicyRulesResponse, err error) {
+	return client.ImportPolicyRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ImportPolicyRulesContext(ctx context.Context, request *ImportPolicyRulesRequest) (result *ImportPolicyRulesResponse, err error) {
 	var response ImportPolicyRulesResponse
-	if err = client.sendRequest("importPolicyRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodImportPolicyRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ListCa(request *ListCaRequest) (result *ListCaResponse, err error) {
+	return client.ListCaContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ListCaContext(ctx context.Context, request *ListCaRequest) (result *ListCaResponse, err error) {
 	var response ListCaResponse
-	if err = client.sendRequest("listCa", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodListCa, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ListCertificates(request *ListCertificatesRequest) (result *ListCertificatesResponse, err error) {
+	return client.ListCertificatesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ListCertificatesContext(ctx context.Context, request *ListCertificatesRequest) (result *ListCertificatesResponse, err error) {
 	var response ListCertificatesResponse
-	if err = client.sendRequest("listCertificates", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodListCertificates, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ListCsr(request *ListCsrRequest) (result *ListCsrResponse, err error) {
+	return client.ListCsrContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ListCsrContext(ctx context.Context, request *ListCsrRequest) (result *ListCsrResponse, err error) {
 	var response ListCsrResponse
-	if err = client.sendRequest("listCsr", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodListCsr, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ListRegistryReplicas(request *ListRegistryReplicasRequest) (result *ListRegistryReplicasResponse, err error) {
+	return client.ListRegistryReplicasContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ListRegistryReplicasContext(ctx context.Context, request *ListRegistryReplicasRequest) (result *ListRegistryReplicasResponse, err error) {
 	var response ListRegistryReplicasResponse
-	if err = client.sendRequest("listRegistryReplicas", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodListRegistryReplicas, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ListSnapshots(request *ListSnapshotsRequest) (result *ListSnapshotsResponse, err error) {
+	return client.ListSnapshotsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ListSnapshotsContext(ctx context.Context, request *ListSnapshotsRequest) (result *ListSnapshotsResponse, err error) {
 	var response ListSnapshotsResponse
-	if err = client.sendRequest("listSnapshots", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodListSnapshots, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) MakeDevice(request *MakeDeviceRequest) (result *MakeDeviceResponse, err error) {
+	return client.MakeDeviceContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) MakeDeviceContext(ctx context.Context, request *MakeDeviceRequest) (result *MakeDeviceResponse, err error) {
 	var response MakeDeviceResponse
-	if err = client.sendRequest("makeDevice", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodMakeDevice, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) PublishBucketVolume(request *PublishBucketVolumeRequest) (result *PublishBucketVolumeResponse, err error) {
+	return client.PublishBucketVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) PublishBucketVolumeContext(ctx context.Context, request *PublishBucketVolumeRequest) (result *PublishBucketVolumeResponse, err error) {
 	var response PublishBucketVolumeResponse
-	if err = client.sendRequest("publishBucketVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodPublishBucketVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) QueryFiles(request *QueryFilesRequest) (result *QueryFilesResponse, err error) {
+	return client.QueryFilesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) QueryFilesContext(ctx context.Context, request *QueryFilesRequest) (result *QueryFilesResponse, err error) {
 	var response QueryFilesResponse
-	if err = client.sendRequest("queryFiles", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodQueryFiles, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) RegenerateDatabase(request *RegenerateDatabaseRequest) (result *RegenerateDatabaseResponse, err error) {
+	return client.RegenerateDatabaseContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) RegenerateDatabaseContext(ctx context.Context, request *RegenerateDatabaseRequest) (result *RegenerateDatabaseResponse, err error) {
 	var response RegenerateDatabaseResponse
-	if err = client.sendRequest("regenerateDatabase", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodRegenerateDatabase, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) RemoveKeystoreSlot(request *RemoveKeystoreSlotRequest) (result *RemoveKeystoreSlotResponse, err error) {
+	return client.RemoveKeystoreSlotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) RemoveKeystoreSlotContext(ctx context.Context, request *RemoveKeystoreSlotRequest) (result *RemoveKeystoreSlotResponse, err error) {
 	var response RemoveKeystoreSlotResponse
-	if err = client.sendRequest("removeKeystoreSlot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodRemoveKeystoreSlot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) RemoveMasterKeystoreSlot(request *RemoveMasterKeystoreSlotRequest) (result *RemoveMasterKeystoreSlotResponse, err error) {
+	return client.RemoveMasterKeystoreSlotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) RemoveMasterKeystoreSlotContext(ctx context.Context, request *RemoveMasterKeystoreSlotRequest) (result *RemoveMasterKeystoreSlotResponse, err error) {
 	var response RemoveMasterKeystoreSlotResponse
-	if err = client.sendRequest("removeMasterKeystoreSlot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodRemoveMasterKeystoreSlot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) RemoveRegistryReplica(request *RemoveRegistryReplicaRequest) (result *RemoveRegistryReplicaResponse, err error) {
+	return client.RemoveRegistryReplicaContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) RemoveRegistryReplicaContext(ctx context.Context, request *RemoveRegistryReplicaRequest) (result *RemoveRegistryReplicaResponse, err error) {
 	var response RemoveRegistryReplicaResponse
-	if err = client.sendRequest("removeRegistryReplica", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodRemoveRegistryReplica, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ResolveGlobalFileId(request *ResolveGlobalFileIdRequest) (result *ResolveGlobalFileIdResponse, err error) {
+	return client.ResolveGlobalFileIdContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ResolveGlobalFileIdContext(ctx context.Context, request *ResolveGlobalFileIdRequest) (result *ResolveGlobalFileIdResponse, err error) {
 	var response ResolveGlobalFileIdResponse
-	if err = client.sendRequest("resolveGlobalFileId", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodResolveGlobalFileId, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ResolvePolicyRuleName(request *ResolvePolicyRuleNameRequest) (result *ResolvePolicyRuleNameResponse, err error) {
+	return client.ResolvePolicyRuleNameContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ResolvePolicyRuleNameContext(ctx context.Context, request *ResolvePolicyRuleNameRequest) (result *ResolvePolicyRuleNameResponse, err error) {
 	var response ResolvePolicyRuleNameResponse
-	if err = client.sendRequest("resolvePolicyRuleName", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodResolvePolicyRuleName, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ResolveTenantName(request *ResolveTenantNameRequest) (result *ResolveTenantNameResponse, err error) {
+	return client.ResolveTenantNameContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ResolveTenantNameContext(ctx context.Context, request *ResolveTenantNameRequest) (result *ResolveTenantNameResponse, err error) {
 	var response ResolveTenantNameResponse
-	if err = client.sendRequest("resolveTenantName", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodResolveTenantName, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ResolveVolumeName(request *ResolveVolumeNameRequest) (result *ResolveVolumeNameResponse, err error) {
+	return client.ResolveVolumeNameContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ResolveVolumeNameContext(ctx context.Context, request *ResolveVolumeNameRequest) (result *ResolveVolumeNameResponse, err error) {
 	var response ResolveVolumeNameResponse
-	if err = client.sendRequest("resolveVolumeName", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodResolveVolumeName, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) ResumeTask(request *ResumeTaskRequest) (result *ResumeTaskResponse, err error) {
+	return client.ResumeTaskContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) ResumeTaskContext(ctx context.Context, request *ResumeTaskRequest) (result *ResumeTaskResponse, err error) {
 	var response ResumeTaskResponse
-	if err = client.sendRequest("resumeTask", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodResumeTask, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) RetryTask(request *RetryTaskRequest) (result *RetryTaskResponse, err error) {
+	return client.RetryTaskContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) RetryTaskContext(ctx context.Context, request *RetryTaskRequest) (result *RetryTaskResponse, err error) {
 	var response RetryTaskResponse
-	if err = client.sendRequest("retryTask", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodRetryTask, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) RevokeCertificate(request *RevokeCertificateRequest) (result *RevokeCertificateResponse, err error) {
+	return client.RevokeCertificateContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) RevokeCertificateContext(ctx context.Context, request *RevokeCertificateRequest) (result *RevokeCertificateResponse, err error) {
 	var response RevokeCertificateResponse
-	if err = client.sendRequest("revokeCertificate", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodRevokeCertificate, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetCertificateOwner(request *SetCertificateOwnerRequest) (result *SetCertificateOwnerResponse, err error) {
+	return client.SetCertificateOwnerContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetCertificateOwnerContext(ctx context.Context, request *SetCertificateOwnerRequest) (result *SetCertificateOwnerResponse, err error) {
 	var response SetCertificateOwnerResponse
-	if err = client.sendRequest("setCertificateOwner", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetCertificateOwner, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetCertificateSubject(request *SetCertificateSubjectRequest) (result *SetCertificateSubjectResponse, err error) {
+	return client.SetCertificateSubjectContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetCertificateSubjectContext(ctx context.Context, request *SetCertificateSubjectRequest) (result *SetCertificateSubjectResponse, err error) {
 	var response SetCertificateSubjectResponse
-	if err = client.sendRequest("setCertificateSubject", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetCertificateSubject, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetConfiguration(request *SetConfigurationRequest) (result *SetConfigurationResponse, err error) {
+	return client.SetConfigurationContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetConfigurationContext(ctx context.Context, request *SetConfigurationRequest) (result *SetConfigurationResponse, err error) {
 	var response SetConfigurationResponse
-	if err = client.sendRequest("setConfiguration", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetConfiguration, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetEncryptedVolumeKey(request *SetEncryptedVolumeKeyRequest) (result *SetEncryptedVolumeKeyResponse, err error) {
+	return client.SetEncryptedVolumeKeyContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetEncryptedVolumeKeyContext(ctx context.Context, request *SetEncryptedVolumeKeyRequest) (result *SetEncryptedVolumeKeyResponse, err error) {
 	var response SetEncryptedVolumeKeyResponse
-	if err = client.sendRequest("setEncryptedVolumeKey", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetEncryptedVolumeKey, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetLabels(request *SetLabelsRequest) (result *SetLabelsResponse, err error) {
+	return client.SetLabelsContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetLabelsContext(ctx context.Context, request *SetLabelsRequest) (result *SetLabelsResponse, err error) {
 	var response SetLabelsResponse
-	if err = client.sendRequest("setLabels", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetLabels, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetLicenseKey(request *SetLicenseKeyRequest) (result *SetLicenseKeyResponse, err error) {
+	return client.SetLicenseKeyContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetLicenseKeyContext(ctx context.Context, request *SetLicenseKeyRequest) (result *SetLicenseKeyResponse, err error) {
 	var response SetLicenseKeyResponse
-	if err = client.sendRequest("setLicenseKey", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetLicenseKey, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetNotificationRule(request *SetNotificationRuleRequest) (result *SetNotificationRuleResponse, err error) {
+	return client.SetNotificationRuleContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetNotificationRuleContext(ctx context.Context, request *SetNotificationRuleRequest) (result *SetNotificationRuleResponse, err error) {
 	var response SetNotificationRuleResponse
-	if err = client.sendRequest("setNotificationRule", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetNotificationRule, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetQuota(request *SetQuotaRequest) (result *SetQuotaResponse, err error) {
+	return client.SetQuotaContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetQuotaContext(ctx context.Context, request *SetQuotaRequest) (result *SetQuotaResponse, err error) {
 	var response SetQuotaResponse
-	if err = client.sendRequest("setQuota", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetQuota, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SetTenant(request *SetTenantRequest) (result *SetTenantResponse, err error) {
+	return client.SetTenantContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SetTenantContext(ctx context.Context, request *SetTenantRequest) (result *SetTenantResponse, err error) {
 	var response SetTenantResponse
-	if err = client.sendRequest("setTenant", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSetTenant, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) SilenceAlert(request *SilenceAlertRequest) (result *SilenceAlertResponse, err error) {
+	return client.SilenceAlertContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) SilenceAlertContext(ctx context.Context, request *SilenceAlertRequest) (result *SilenceAlertResponse, err error) {
 	var response SilenceAlertResponse
-	if err = client.sendRequest("silenceAlert", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodSilenceAlert, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) StartNetworkTest(request *StartNetworkTestRequest) (result *StartNetworkTestResponse, err error) {
+	return client.StartNetworkTestContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) StartNetworkTestContext(ctx context.Context, request *StartNetworkTestRequest) (result *StartNetworkTestResponse, err error) {
 	var response StartNetworkTestResponse
-	if err = client.sendRequest("startNetworkTest", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodStartNetworkTest, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) TriggerVolumeCheckpoint(request *TriggerVolumeCheckpointRequest) (result *TriggerVolumeCheckpointResponse, err error) {
+	return client.TriggerVolumeCheckpointContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) TriggerVolumeCheckpointContext(ctx context.Context, request *TriggerVolumeCheckpointRequest) (result *TriggerVolumeCheckpointResponse, err error) {
 	var response TriggerVolumeCheckpointResponse
-	if err = client.sendRequest("triggerVolumeCheckpoint", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodTriggerVolumeCheckpoint, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) UnlockMasterKeystoreSlot(request *UnlockMasterKeystoreSlotRequest) (result *UnlockMasterKeystoreSlotResponse, err error) {
+	return client.UnlockMasterKeystoreSlotContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) UnlockMasterKeystoreSlotContext(ctx context.Context, request *UnlockMasterKeystoreSlotRequest) (result *UnlockMasterKeystoreSlotResponse, err error) {
 	var response UnlockMasterKeystoreSlotResponse
-	if err = client.sendRequest("unlockMasterKeystoreSlot", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodUnlockMasterKeystoreSlot, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) UnpublishBucketVolume(request *UnpublishBucketVolumeRequest) (result *UnpublishBucketVolumeResponse, err error) {
+	return client.UnpublishBucketVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) UnpublishBucketVolumeContext(ctx context.Context, request *UnpublishBucketVolumeRequest) (result *UnpublishBucketVolumeResponse, err error) {
 	var response UnpublishBucketVolumeResponse
-	if err = client.sendRequest("unpublishBucketVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodUnpublishBucketVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) UpdateDevice(request *UpdateDeviceRequest) (result *UpdateDeviceResponse, err error) {
+	return client.UpdateDeviceContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) UpdateDeviceContext(ctx context.Context, request *UpdateDeviceRequest) (result *UpdateDeviceResponse, err error) {
 	var response UpdateDeviceResponse
-	if err = client.sendRequest("updateDevice", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodUpdateDevice, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) UpdatePolicyRules(request *UpdatePolicyRulesRequest) (result *UpdatePolicyRulesResponse, err error) {
+	return client.UpdatePolicyRulesContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) UpdatePolicyRulesContext(ctx context.Context, request *UpdatePolicyRulesRequest) (result *UpdatePolicyRulesResponse, err error) {
 	var response UpdatePolicyRulesResponse
-	if err = client.sendRequest("updatePolicyRules", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodUpdatePolicyRules, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) UpdateUser(request *UpdateUserRequest) (result *UpdateUserResponse, err error) {
+	return client.UpdateUserContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) UpdateUserContext(ctx context.Context, request *UpdateUserRequest) (result *UpdateUserResponse, err error) {
 	var response UpdateUserResponse
-	if err = client.sendRequest("updateUser", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodUpdateUser, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) UpdateVolume(request *UpdateVolumeRequest) (result *UpdateVolumeResponse, err error) {
+	return client.UpdateVolumeContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) UpdateVolumeContext(ctx context.Context, request *UpdateVolumeRequest) (result *UpdateVolumeResponse, err error) {
 	var response UpdateVolumeResponse
-	if err = client.sendRequest("updateVolume", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodUpdateVolume, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) VerifyLicense(request *VerifyLicenseRequest) (result *VerifyLicenseResponse, err error) {
+	return client.VerifyLicenseContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) VerifyLicenseContext(ctx context.Context, request *VerifyLicenseRequest) (result *VerifyLicenseResponse, err error) {
 	var response VerifyLicenseResponse
-	if err = client.sendRequest("verifyLicense", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodVerifyLicense, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
 func (client *QuobyteClient) WhoAmI(request *WhoAmIRequest) (result *WhoAmIResponse, err error) {
+	return client.WhoAmIContext(context.Background(), request)
+}
+
+func (client *QuobyteClient) WhoAmIContext(ctx context.Context, request *WhoAmIRequest) (result *WhoAmIResponse, err error) {
 	var response WhoAmIResponse
-	if err = client.sendRequest("whoAmI", request, &response); err != nil {
+	if err = client.sendRequest(ctx, MethodWhoAmI, request, &response); err != nil {
 		return nil, err
 	}
 	return &response, nil
@@ -0,0 +1,1194 @@
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
+
+package quobyte
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *AcceptTermsAndConditionsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *AcknowledgeAlertRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *AddCaRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *AddCertificateRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *AddCsrRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *AddRegistryReplicaRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *AnalyzeVolumesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CancelNetworkTestRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CancelQueryRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CancelSupportDumpRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CancelTaskRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CancelVolumeErasureRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ChangePolicyRulePriorityRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ConfigureRuleRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateAccessKeyCredentialsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateMasterKeystoreSlotRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateMirroredVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateNewUserKeystoreSlotRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateNotificationRuleRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreatePolicyRuleRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreatePolicyRuleSetRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateSnapshotRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateTaskRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateUserRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *CreateVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DecideCsrRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteAccessKeyCredentialsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteCaRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteCertificateRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteConfigurationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteCsrRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteLabelsRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteNotificationRuleRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeletePolicyRulesRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteSnapshotRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteTenantRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteUserRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeleteVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DeregisterServiceRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DisconnectMirroredVolumeRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DumpEffectivePolicyRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *DumpPolicyPresetsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *EraseSnapshotRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *EraseVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ExportCertificateRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ExportConfigurationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ExportPolicyRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ExportVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *FilterPolicyRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GenerateAsyncSupportDumpRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetAccountingRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetAddKeySlotDataRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetAnalyzeReportsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetAuditLogRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetCertificateSubjectRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetClientListRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetConfigurationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetDefaultKeyStoreSlotParamsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetDeviceGroupsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetDeviceIdsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetDeviceListRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetDeviceNetworkEndpointsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetDeviceTagsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetEffectiveVolumeConfigurationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetEncryptStatusRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetEncryptedVolumeKeyRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetFileMetadataDumpRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetFiringRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetHealthManagerStatusRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetInformationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetKeyStoreSlotWithoutHashRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetLabelsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetLatestEventRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetLicenseRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetMasterKeystoreSlotsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetNetworkTestResultRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetNotificationRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetPolicyPresetsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetPolicyRuleSetsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetPolicyRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetQueryProgressRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetQuotaRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetServiceDumpRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetServicesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetSupportDumpRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetSupportDumpStatusRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetSystemStatisticsRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetTaskListRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetTenantRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetTopCapacityConsumerRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetUnformattedDevicesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetUsersRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *GetVolumeListRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ImportAccessKeysRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ImportConfigurationRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ImportPolicyRulesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ListCaRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ListCertificatesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ListCsrRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ListRegistryReplicasRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ListSnapshotsRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *MakeDeviceRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *PublishBucketVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *QueryFilesRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *RegenerateDatabaseRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *RemoveKeystoreSlotRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *RemoveMasterKeystoreSlotRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *RemoveRegistryReplicaRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ResolveGlobalFileIdRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ResolvePolicyRuleNameRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ResolveTenantNameRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ResolveVolumeNameRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *ResumeTaskRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *RetryTaskRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *RevokeCertificateRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetCertificateOwnerRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetCertificateSubjectRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetConfigurationRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetEncryptedVolumeKeyRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetLabelsRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetLicenseKeyRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetNotificationRuleRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetQuotaRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SetTenantRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *SilenceAlertRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *StartNetworkTestRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *TriggerVolumeCheckpointRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *UnlockMasterKeystoreSlotRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *UnpublishBucketVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *UpdateDeviceRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *UpdatePolicyRulesRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *UpdateUserRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *UpdateVolumeRequest) Validate() error {
+	var v validator
+	request.validate(&v, "")
+	return v.err()
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *VerifyLicenseRequest) Validate() error {
+	return nil
+}
+
+// Validate checks the request against the rules of the API schema. The error lists all
+// violations as *ValidationError and matches ErrInvalidParams.
+func (request *WhoAmIRequest) Validate() error {
+	return nil
+}
+
+func (message *AlertConfiguration) validate(v *validator, path string) {
+	message.RestrictTime.validate(v, path+"restrict_time.")
+}
+
+func (message *CancelTaskRequest) validate(v *validator, path string) {
+	v.required(path+"task_id", len(message.TaskId) > 0)
+}
+
+func (message *CancelVolumeErasureRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *ChangePolicyRulePriorityRequest) validate(v *validator, path string) {
+	v.required(path+"policy_rule_uuid", message.PolicyRuleUuid != "")
+	v.uuid(path+"policy_rule_uuid", message.PolicyRuleUuid)
+}
+
+func (message *ConfigureRuleRequest) validate(v *validator, path string) {
+	message.SetAlertConfiguration.validate(v, path+"set_alert_configuration.")
+}
+
+func (message *ConsumingEntity) validate(v *validator, path string) {
+	v.required(path+"type", message.Type != "")
+}
+
+func (message *CreateMirroredVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"remote_volume_uuid", message.RemoteVolumeUuid != "")
+	v.uuid(path+"remote_volume_uuid", message.RemoteVolumeUuid)
+}
+
+func (message *CreatePolicyRuleRequest) validate(v *validator, path string) {
+	message.PolicyRule.validate(v, path+"policy_rule.")
+}
+
+func (message *CreatePolicyRuleSetRequest) validate(v *validator, path string) {
+	for i, elem := range message.PolicyRule {
+		if elem != nil {
+			elem.validate(v, element(path+"policy_rule", i)+".")
+		}
+	}
+}
+
+func (message *CreateSnapshotRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+	v.required(path+"name", message.Name != "")
+}
+
+func (message *CreateTaskRequest) validate(v *validator, path string) {
+	v.required(path+"task_type", message.TaskType != "")
+	message.RebalanceSettings.validate(v, path+"rebalance_settings.")
+}
+
+func (message *CreateUserRequest) validate(v *validator, path string) {
+	v.required(path+"user_name", message.UserName != "")
+}
+
+func (message *CreateVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"name", message.Name != "")
+	for i, elem := range message.Label {
+		if elem != nil {
+			elem.validate(v, element(path+"label", i)+".")
+		}
+	}
+}
+
+func (message *DeleteLabelsRequest) validate(v *validator, path string) {
+	for i, elem := range message.Label {
+		if elem != nil {
+			elem.validate(v, element(path+"label", i)+".")
+		}
+	}
+}
+
+func (message *DeletePolicyRulesRequest) validate(v *validator, path string) {
+	v.required(path+"policy_rule_uuid", len(message.PolicyRuleUuid) > 0)
+	for i, elem := range message.PolicyRuleUuid {
+		v.uuid(element(path+"policy_rule_uuid", i), elem)
+	}
+}
+
+func (message *DeleteSnapshotRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+	v.required(path+"name", message.Name != "")
+}
+
+func (message *DeleteTenantRequest) validate(v *validator, path string) {
+	v.required(path+"tenant_id", message.TenantId != "")
+	v.uuid(path+"tenant_id", message.TenantId)
+}
+
+func (message *DeleteUserRequest) validate(v *validator, path string) {
+	v.required(path+"user_name", message.UserName != "")
+}
+
+func (message *DeleteVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *EraseSnapshotRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+	v.required(path+"name", message.Name != "")
+}
+
+func (message *EraseVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *ExportVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *FileScope) validate(v *validator, path string) {
+	v.oneOf(path, []string{"text_value", "numeric_value", "xattr"}, message.TextValue != "", message.NumericValue != 0, !isZero(message.Xattr))
+}
+
+func (message *GetAccountingRequest) validate(v *validator, path string) {
+	for i, elem := range message.Entity {
+		if elem != nil {
+			elem.validate(v, element(path+"entity", i)+".")
+		}
+	}
+}
+
+func (message *GetQuotaRequest) validate(v *validator, path string) {
+	for i, elem := range message.OnlyEntity {
+		if elem != nil {
+			elem.validate(v, element(path+"only_entity", i)+".")
+		}
+	}
+}
+
+func (message *GetTenantRequest) validate(v *validator, path string) {
+	for i, elem := range message.TenantId {
+		v.uuid(element(path+"tenant_id", i), elem)
+	}
+}
+
+func (message *GetVolumeListRequest) validate(v *validator, path string) {
+	for i, elem := range message.VolumeUuid {
+		v.uuid(element(path+"volume_uuid", i), elem)
+	}
+}
+
+func (message *Label) validate(v *validator, path string) {
+	v.required(path+"name", message.Name != "")
+}
+
+func (message *ListSnapshotsRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *PolicyRule) validate(v *validator, path string) {
+	message.Scope.validate(v, path+"scope.")
+	v.oneOf(path, []string{"policy_preset", "policies"}, !isZero(message.PolicyPreset), !isZero(message.Policies))
+}
+
+func (message *PolicyScope) validate(v *validator, path string) {
+	for i, elem := range message.File {
+		if elem != nil {
+			elem.validate(v, element(path+"file", i)+".")
+		}
+	}
+}
+
+func (message *PublishBucketVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *Quota) validate(v *validator, path string) {
+	v.required(path+"consumer", len(message.Consumer) > 0)
+	for i, elem := range message.Consumer {
+		if elem != nil {
+			elem.validate(v, element(path+"consumer", i)+".")
+		}
+	}
+	for i, elem := range message.Limits {
+		if elem != nil {
+			elem.validate(v, element(path+"limits", i)+".")
+		}
+	}
+	for i, elem := range message.CurrentUsage {
+		if elem != nil {
+			elem.validate(v, element(path+"current_usage", i)+".")
+		}
+	}
+}
+
+func (message *RebalanceSettings) validate(v *validator, path string) {
+	if message.UnderutilizedThresholdPercentage != 0 {
+		v.atLeast(path+"underutilized_threshold_percentage", int64(message.UnderutilizedThresholdPercentage), 5)
+		v.atMost(path+"underutilized_threshold_percentage", int64(message.UnderutilizedThresholdPercentage), 95)
+	}
+	if message.OverutilizedThresholdPercentage != 0 {
+		v.atLeast(path+"overutilized_threshold_percentage", int64(message.OverutilizedThresholdPercentage), 5)
+		v.atMost(path+"overutilized_threshold_percentage", int64(message.OverutilizedThresholdPercentage), 95)
+	}
+}
+
+func (message *ResolveVolumeNameRequest) validate(v *validator, path string) {
+	v.required(path+"volume_name", message.VolumeName != "")
+}
+
+func (message *Resource) validate(v *validator, path string) {
+	v.required(path+"type", message.Type != "")
+}
+
+func (message *RestrictTime) validate(v *validator, path string) {
+	for i, elem := range message.RestrictToHours {
+		v.atLeast(element(path+"restrict_to_hours", i), int64(elem), 0)
+		v.atMost(element(path+"restrict_to_hours", i), int64(elem), 24)
+	}
+	for i, elem := range message.RestrictToWeekdays {
+		v.atLeast(element(path+"restrict_to_weekdays", i), int64(elem), 0)
+		v.atMost(element(path+"restrict_to_weekdays", i), int64(elem), 6)
+	}
+	for i, elem := range message.RestrictToWeekOfYear {
+		v.atLeast(element(path+"restrict_to_week_of_year", i), int64(elem), 0)
+		v.atMost(element(path+"restrict_to_week_of_year", i), int64(elem), 53)
+	}
+}
+
+func (message *RuleConfiguration) validate(v *validator, path string) {
+	message.RestrictTime.validate(v, path+"restrict_time.")
+}
+
+func (message *SetConfigurationRequest) validate(v *validator, path string) {
+	message.SystemConfiguration.validate(v, path+"system_configuration.")
+	message.RuleConfiguration.validate(v, path+"rule_configuration.")
+}
+
+func (message *SetEncryptedVolumeKeyRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *SetLabelsRequest) validate(v *validator, path string) {
+	for i, elem := range message.Label {
+		if elem != nil {
+			elem.validate(v, element(path+"label", i)+".")
+		}
+	}
+}
+
+func (message *SetQuotaRequest) validate(v *validator, path string) {
+	v.required(path+"quotas", len(message.Quotas) > 0)
+	for i, elem := range message.Quotas {
+		if elem != nil {
+			elem.validate(v, element(path+"quotas", i)+".")
+		}
+	}
+}
+
+func (message *SetTenantRequest) validate(v *validator, path string) {
+	for i, elem := range message.OnCreateLabel {
+		if elem != nil {
+			elem.validate(v, element(path+"on_create_label", i)+".")
+		}
+	}
+}
+
+func (message *SystemConfiguration) validate(v *validator, path string) {
+	message.HealthManagerConfig.validate(v, path+"health_manager_config.")
+}
+
+func (message *SystemConfiguration_HealthManagerConfig) validate(v *validator, path string) {
+	for i, elem := range message.MaintenanceWindow {
+		if elem != nil {
+			elem.validate(v, element(path+"maintenance_window", i)+".")
+		}
+	}
+}
+
+func (message *UnpublishBucketVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
+
+func (message *UpdateDeviceRequest) validate(v *validator, path string) {
+	v.required(path+"device_id", message.DeviceId != 0)
+}
+
+func (message *UpdatePolicyRulesRequest) validate(v *validator, path string) {
+	for i, elem := range message.PolicyRule {
+		if elem != nil {
+			elem.validate(v, element(path+"policy_rule", i)+".")
+		}
+	}
+}
+
+func (message *UpdateVolumeRequest) validate(v *validator, path string) {
+	v.required(path+"volume_uuid", message.VolumeUuid != "")
+	v.uuid(path+"volume_uuid", message.VolumeUuid)
+}
@@ -0,0 +1,119 @@
+package quobyte
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidationError reports a request field that violates the rules of the API schema. Requests
+// are validated before they are sent, the error matches ErrInvalidParams like the error of the
+// API service for invalid params.
+type ValidationError struct {
+	// Field is the path of the field in the request, e.g. "quotas[0].consumer", or of the
+	// message with exclusive fields, empty for the request itself.
+	Field   string
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	if err.Field == "" {
+		return "invalid request: " + err.Message
+	}
+	return "invalid request: " + err.Field + " " + err.Message
+}
+
+func (err *ValidationError) Is(target error) bool {
+	return target == ErrInvalidParams
+}
+
+// SetRequestValidation makes the client validate requests before they are sent. Invalid requests
+// fail without a round trip with an error that matches ErrInvalidParams, see ValidationError.
+// The rules are maintained by hand in schema/api.json and the API service has the final say, so
+// validation is disabled by default.
+func (client *QuobyteClient) SetRequestValidation(validate bool) {
+	client.validateRequests = validate
+}
+
+// WithRequestValidation makes the client validate requests before they are sent, see
+// SetRequestValidation.
+func WithRequestValidation() Option {
+	return func(opts *clientOptions) error {
+		opts.validateRequests = true
+		return nil
+	}
+}
+
+// validateRequest returns the violations of the request if it has a Validate method.
+func (client QuobyteClient) validateRequest(method string, request interface{}) error {
+	validatable, ok := request.(interface{ Validate() error })
+	if !client.validateRequests || !ok {
+		return nil
+	}
+	if err := validatable.Validate(); err != nil {
+		return fmt.Errorf("method %s: %w", method, err)
+	}
+	return nil
+}
+
+// validator collects the violations found by the generated validate methods.
+type validator struct {
+	errs []error
+}
+
+func (v *validator) fail(path string, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Field: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) err() error {
+	return errors.Join(v.errs...)
+}
+
+func (v *validator) required(path string, set bool) {
+	if !set {
+		v.fail(path, "is required")
+	}
+}
+
+func (v *validator) uuid(path string, value string) {
+	if value != "" && !UUIDValidator.MatchString(value) {
+		v.fail(path, "is not a UUID: %q", value)
+	}
+}
+
+func (v *validator) atLeast(path string, value int64, min int64) {
+	if value < min {
+		v.fail(path, "must be at least %d, is %d", min, value)
+	}
+}
+
+func (v *validator) atMost(path string, value int64, max int64) {
+	if value > max {
+		v.fail(path, "must be at most %d, is %d", max, value)
+	}
+}
+
+// oneOf checks that exactly one of the fields with the names is set.
+func (v *validator) oneOf(path string, names []string, set ...bool) {
+	count := 0
+	for _, isSet := range set {
+		if isSet {
+			count++
+		}
+	}
+	if count != 1 {
+		v.fail(strings.TrimSuffix(path, "."), "must set exactly one of %s", strings.Join(names, ", "))
+	}
+}
+
+// element returns the path of the element i of the list at path.
+func element(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// isZero returns true for messages without any field set.
+func isZero(value interface{}) bool {
+	return reflect.ValueOf(value).IsZero()
+}
@@ -0,0 +1,77 @@
+package quobyte
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	const uuid = "c858ffe2-4fa1-4c78-adbf-54c211734883"
+	tests := []struct {
+		name     string
+		request  interface{ Validate() error }
+		expected string
+	}{
+		{"valid", &DeleteVolumeRequest{VolumeUuid: uuid}, ""},
+		{"required", &DeleteVolumeRequest{}, "volume_uuid is required"},
+		{"uuid", &DeleteVolumeRequest{VolumeUuid: "data"}, `volume_uuid is not a UUID: "data"`},
+		{"uuid list", &GetVolumeListRequest{VolumeUuid: []string{uuid, "data"}}, "volume_uuid[1] is not a UUID"},
+		{"unknown enum", &CreateUserRequest{UserName: "alice", Role: "OWNER"}, ""},
+		{"nested", &SetQuotaRequest{Quotas: []*Quota{{Consumer: []*ConsumingEntity{{Identifier: uuid}}}}},
+			"quotas[0].consumer[0].type is required"},
+		{"one of", &CreatePolicyRuleRequest{PolicyRule: PolicyRule{Name: "rule"}},
+			"policy_rule must set exactly one of policy_preset, policies"},
+		{"range", &CreateTaskRequest{TaskType: TaskType_REBALANCE, RebalanceSettings: RebalanceSettings{UnderutilizedThresholdPercentage: 99}},
+			"rebalance_settings.underutilized_threshold_percentage must be at most 95, is 99"},
+		{"range list", &ConfigureRuleRequest{SetAlertConfiguration: AlertConfiguration{RestrictTime: RestrictTime{RestrictToWeekdays: []int32{0, 7}}}},
+			"set_alert_configuration.restrict_time.restrict_to_weekdays[1] must be at most 6, is 7"},
+		{"hours", &ConfigureRuleRequest{SetAlertConfiguration: AlertConfiguration{RestrictTime: RestrictTime{RestrictToHours: []int32{0, 24, 25}}}},
+			"set_alert_configuration.restrict_time.restrict_to_hours[2] must be at most 24, is 25"},
+		{"no rules", &GetTenantRequest{}, ""},
+	}
+	for _, test := range tests {
+		err := test.request.Validate()
+		if test.expected == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			continue
+		}
+		var validationErr *ValidationError
+		if !errors.Is(err, ErrInvalidParams) || !errors.As(err, &validationErr) || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s: expected %q, got %v", test.name, test.expected, err)
+		}
+	}
+}
+
+func TestRequestValidation(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		w.Write(rpcResponse(req, `{"result":{}}`))
+	}))
+	defer srv.Close()
+	client, err := NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// requests are not validated by default
+	if _, err := client.DeleteVolume(&DeleteVolumeRequest{VolumeUuid: "data"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+
+	client.SetRequestValidation(true)
+	_, err = client.DeleteVolume(&DeleteVolumeRequest{VolumeUuid: "data"})
+	if err == nil || err.Error() != `method deleteVolume: invalid request: volume_uuid is not a UUID: "data"` {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Invalid request was sent")
+	}
+}
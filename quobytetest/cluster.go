@@ -0,0 +1,731 @@
+package quobytetest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	quobyte "github.com/quobyte/api/quobyte"
+)
+
+// errorCodeFailed is the JSON-RPC error code of failed API calls.
+const errorCodeFailed int64 = -32000
+
+func failed(format string, args ...interface{}) error {
+	return &quobyte.RPCError{Code: errorCodeFailed, Message: fmt.Sprintf(format, args...)}
+}
+
+func invalidParams(format string, args ...interface{}) error {
+	return &quobyte.RPCError{Code: quobyte.ErrorCodeInvalidParams, Message: fmt.Sprintf(format, args...)}
+}
+
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func nowMs() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// deepCopy copies src into dst, so that callers can not modify the state of the cluster.
+func deepCopy(dst, src interface{}) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		panic(err)
+	}
+}
+
+// cluster is the in-memory state of a fake Quobyte installation.
+type cluster struct {
+	mu        sync.Mutex
+	username  string
+	version   string
+	tenants   map[string]*quobyte.TenantDomainConfiguration
+	volumes   map[string]*quobyte.Volume
+	labels    []*quobyte.Label
+	quotas    []*quobyte.Quota
+	snapshots map[string][]*quobyte.VolumeSnapshot
+	tasks     map[string]*quobyte.TaskInfo
+	devices   map[int64]*quobyte.Device
+}
+
+func newCluster(username string) *cluster {
+	return &cluster{
+		username:  username,
+		tenants:   map[string]*quobyte.TenantDomainConfiguration{},
+		volumes:   map[string]*quobyte.Volume{},
+		snapshots: map[string][]*quobyte.VolumeSnapshot{},
+		tasks:     map[string]*quobyte.TaskInfo{},
+		devices:   map[int64]*quobyte.Device{},
+	}
+}
+
+func (c *cluster) addDevice(device *quobyte.Device) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var copied quobyte.Device
+	deepCopy(&copied, device)
+	c.devices[copied.DeviceId] = &copied
+}
+
+func (c *cluster) setVersion(version string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.version = version
+}
+
+func (c *cluster) setTaskState(taskID string, state quobyte.TaskState) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	task, ok := c.tasks[taskID]
+	if !ok {
+		return failed("Task %s does not exist", taskID)
+	}
+	task.State = state
+	if isFinal(state) {
+		task.EndTimestampMs = nowMs()
+	}
+	return nil
+}
+
+// tenant returns the tenant with the id or name. Must be called with mu held.
+func (c *cluster) tenant(idOrName string) (*quobyte.TenantDomainConfiguration, error) {
+	if tenant, ok := c.tenants[idOrName]; ok {
+		return tenant, nil
+	}
+	for _, tenant := range c.tenants {
+		if tenant.Name == idOrName {
+			return tenant, nil
+		}
+	}
+	return nil, failed("Tenant %s does not exist", idOrName)
+}
+
+// volume returns the volume with the uuid. Must be called with mu held.
+func (c *cluster) volume(uuid string) (*quobyte.Volume, error) {
+	if volume, ok := c.volumes[uuid]; ok {
+		return volume, nil
+	}
+	return nil, failed("Volume %s does not exist", uuid)
+}
+
+// volumeByName returns the volume with the name in the tenant. Must be called with mu held.
+func (c *cluster) volumeByName(name, tenantID string) *quobyte.Volume {
+	for _, volume := range c.volumes {
+		if volume.Name == name && volume.TenantDomain == tenantID {
+			return volume
+		}
+	}
+	return nil
+}
+
+func (c *cluster) whoAmI(request *quobyte.WhoAmIRequest) (*quobyte.WhoAmIResponse, error) {
+	return &quobyte.WhoAmIResponse{
+		UserId:         c.username,
+		UserName:       c.username,
+		ManagementRole: string(quobyte.UserRole_SUPER_USER),
+	}, nil
+}
+
+func (c *cluster) getLicense(request *quobyte.GetLicenseRequest) (*quobyte.GetLicenseResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &quobyte.GetLicenseResponse{ProductVersion: c.version}, nil
+}
+
+func (c *cluster) setTenant(request *quobyte.SetTenantRequest) (*quobyte.SetTenantResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenant := request.Tenant
+	if tenant.TenantId == "" {
+		if tenant.Name == "" {
+			return nil, invalidParams("Tenant name is required")
+		}
+		if _, err := c.tenant(tenant.Name); err == nil {
+			return nil, failed("Tenant %s already exists", tenant.Name)
+		}
+		tenant.TenantId = newUUID()
+		for _, label := range request.OnCreateLabel {
+			c.upsertLabel(quobyte.Label_EntityType_TENANT, tenant.TenantId, label)
+		}
+	} else if _, ok := c.tenants[tenant.TenantId]; !ok {
+		return nil, failed("Tenant %s does not exist", tenant.TenantId)
+	}
+	var stored quobyte.TenantDomainConfiguration
+	deepCopy(&stored, &tenant)
+	c.tenants[stored.TenantId] = &stored
+	return &quobyte.SetTenantResponse{TenantId: stored.TenantId}, nil
+}
+
+func (c *cluster) getTenant(request *quobyte.GetTenantRequest) (*quobyte.GetTenantResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var response quobyte.GetTenantResponse
+	for _, tenant := range c.tenants {
+		if len(request.TenantId) == 0 || contains(request.TenantId, tenant.TenantId) {
+			response.Tenant = append(response.Tenant, tenant)
+		}
+	}
+	sort.Slice(response.Tenant, func(i, j int) bool { return response.Tenant[i].Name < response.Tenant[j].Name })
+	var copied quobyte.GetTenantResponse
+	deepCopy(&copied, &response)
+	return &copied, nil
+}
+
+func (c *cluster) deleteTenant(request *quobyte.DeleteTenantRequest) (*quobyte.DeleteTenantResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.tenants[request.TenantId]; !ok {
+		return nil, failed("Tenant %s does not exist", request.TenantId)
+	}
+	for _, volume := range c.volumes {
+		if volume.TenantDomain == request.TenantId {
+			return nil, failed("Tenant %s still has volumes", request.TenantId)
+		}
+	}
+	delete(c.tenants, request.TenantId)
+	c.deleteEntityLabels(quobyte.Label_EntityType_TENANT, request.TenantId)
+	return &quobyte.DeleteTenantResponse{}, nil
+}
+
+func (c *cluster) resolveTenantName(request *quobyte.ResolveTenantNameRequest) (*quobyte.ResolveTenantNameResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := request.TenantName
+	if key == "" {
+		key = request.TenantId
+	}
+	tenant, err := c.tenant(key)
+	if err != nil {
+		return nil, err
+	}
+	return &quobyte.ResolveTenantNameResponse{TenantId: tenant.TenantId, TenantName: tenant.Name}, nil
+}
+
+func (c *cluster) createVolume(request *quobyte.CreateVolumeRequest) (*quobyte.CreateVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if request.Name == "" {
+		return nil, invalidParams("Volume name is required")
+	}
+	tenantID := request.TenantId
+	if tenantID == "" {
+		tenantID = request.TenantDomain
+	}
+	tenant, err := c.tenant(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if c.volumeByName(request.Name, tenant.TenantId) != nil {
+		return nil, failed("Volume %s already exists in tenant %s", request.Name, tenant.Name)
+	}
+	volume := &quobyte.Volume{
+		VolumeUuid:          newUUID(),
+		Name:                request.Name,
+		TenantDomain:        tenant.TenantId,
+		ReplicaDeviceIds:    request.ReplicaDeviceIds,
+		CreationTimestampMs: nowMs(),
+	}
+	c.volumes[volume.VolumeUuid] = volume
+	for _, label := range request.Label {
+		c.upsertLabel(quobyte.Label_EntityType_VOLUME, volume.VolumeUuid, label)
+	}
+	return &quobyte.CreateVolumeResponse{VolumeUuid: volume.VolumeUuid}, nil
+}
+
+func (c *cluster) resolveVolumeName(request *quobyte.ResolveVolumeNameRequest) (*quobyte.ResolveVolumeNameResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenant, err := c.tenant(request.TenantDomain)
+	if err != nil {
+		return nil, err
+	}
+	volume := c.volumeByName(request.VolumeName, tenant.TenantId)
+	if volume == nil {
+		return nil, failed("Volume %s in tenant %s does not exist", request.VolumeName, tenant.Name)
+	}
+	return &quobyte.ResolveVolumeNameResponse{VolumeUuid: volume.VolumeUuid}, nil
+}
+
+func (c *cluster) getVolumeList(request *quobyte.GetVolumeListRequest) (*quobyte.GetVolumeListResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenantID := ""
+	if request.TenantDomain != "" {
+		tenant, err := c.tenant(request.TenantDomain)
+		if err != nil {
+			return nil, err
+		}
+		tenantID = tenant.TenantId
+	}
+	var response quobyte.GetVolumeListResponse
+	for _, volume := range c.volumes {
+		if (len(request.VolumeUuid) == 0 || contains(request.VolumeUuid, volume.VolumeUuid)) &&
+			(tenantID == "" || volume.TenantDomain == tenantID) {
+			response.Volume = append(response.Volume, volume)
+		}
+	}
+	sort.Slice(response.Volume, func(i, j int) bool { return response.Volume[i].Name < response.Volume[j].Name })
+	var copied quobyte.GetVolumeListResponse
+	deepCopy(&copied, &response)
+	return &copied, nil
+}
+
+func (c *cluster) updateVolume(request *quobyte.UpdateVolumeRequest) (*quobyte.UpdateVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	volume, err := c.volume(request.VolumeUuid)
+	if err != nil {
+		return nil, err
+	}
+	if request.Name != "" && request.Name != volume.Name {
+		if c.volumeByName(request.Name, volume.TenantDomain) != nil {
+			return nil, failed("Volume %s already exists", request.Name)
+		}
+		volume.Name = request.Name
+	}
+	if request.PreferredPrimaryReplicaDeviceId != 0 {
+		volume.PreferredPrimaryReplicaDeviceId = request.PreferredPrimaryReplicaDeviceId
+	}
+	if request.RemovePreferredPrimaryReplicaDevice {
+		volume.PreferredPrimaryReplicaDeviceId = 0
+	}
+	return &quobyte.UpdateVolumeResponse{}, nil
+}
+
+func (c *cluster) deleteVolume(request *quobyte.DeleteVolumeRequest) (*quobyte.DeleteVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.volume(request.VolumeUuid); err != nil {
+		return nil, err
+	}
+	delete(c.volumes, request.VolumeUuid)
+	delete(c.snapshots, request.VolumeUuid)
+	c.deleteEntityLabels(quobyte.Label_EntityType_VOLUME, request.VolumeUuid)
+	return &quobyte.DeleteVolumeResponse{}, nil
+}
+
+func (c *cluster) eraseVolume(request *quobyte.EraseVolumeRequest) (*quobyte.EraseVolumeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	volume, err := c.volume(request.VolumeUuid)
+	if err != nil {
+		return nil, err
+	}
+	volume.ScheduledForDeletion = true
+	return &quobyte.EraseVolumeResponse{}, nil
+}
+
+func (c *cluster) cancelVolumeErasure(request *quobyte.CancelVolumeErasureRequest) (*quobyte.CancelVolumeErasureResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	volume, err := c.volume(request.VolumeUuid)
+	if err != nil {
+		return nil, err
+	}
+	volume.ScheduledForDeletion = false
+	return &quobyte.CancelVolumeErasureResponse{}, nil
+}
+
+// upsertLabel sets the label of the entity. Must be called with mu held.
+func (c *cluster) upsertLabel(entityType quobyte.Label_EntityType, entityID string, label *quobyte.Label) {
+	for _, existing := range c.labels {
+		if existing.EntityType == entityType && existing.EntityId == entityID && existing.Name == label.Name {
+			existing.Value = label.Value
+			return
+		}
+	}
+	c.labels = append(c.labels, &quobyte.Label{
+		Namespace:  quobyte.Label_Namespace_SYSTEM,
+		EntityType: entityType,
+		EntityId:   entityID,
+		Name:       label.Name,
+		Value:      label.Value,
+	})
+}
+
+// deleteEntityLabels deletes all labels of the entity. Must be called with mu held.
+func (c *cluster) deleteEntityLabels(entityType quobyte.Label_EntityType, entityID string) {
+	labels := c.labels[:0]
+	for _, label := range c.labels {
+		if label.EntityType != entityType || label.EntityId != entityID {
+			labels = append(labels, label)
+		}
+	}
+	c.labels = labels
+}
+
+func (c *cluster) setLabels(request *quobyte.SetLabelsRequest) (*quobyte.SetLabelsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, label := range request.Label {
+		var err error
+		switch label.EntityType {
+		case quobyte.Label_EntityType_VOLUME:
+			_, err = c.volume(label.EntityId)
+		case quobyte.Label_EntityType_TENANT:
+			if _, ok := c.tenants[label.EntityId]; !ok {
+				err = failed("Tenant %s does not exist", label.EntityId)
+			}
+		default:
+			err = invalidParams("Unsupported label entity type %q", label.EntityType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if label.Name == "" {
+			return nil, invalidParams("Label name is required")
+		}
+	}
+	for _, label := range request.Label {
+		c.upsertLabel(label.EntityType, label.EntityId, label)
+	}
+	return &quobyte.SetLabelsResponse{}, nil
+}
+
+func (c *cluster) getLabels(request *quobyte.GetLabelsRequest) (*quobyte.GetLabelsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var response quobyte.GetLabelsResponse
+	for _, label := range c.labels {
+		if (request.FilterEntityType == "" || label.EntityType == request.FilterEntityType) &&
+			(request.FilterEntityId == "" || label.EntityId == request.FilterEntityId) &&
+			(request.LabelName == "" || label.Name == request.LabelName) {
+			response.Label = append(response.Label, label)
+		}
+	}
+	var copied quobyte.GetLabelsResponse
+	deepCopy(&copied, &response)
+	return &copied, nil
+}
+
+func (c *cluster) deleteLabels(request *quobyte.DeleteLabelsRequest) (*quobyte.DeleteLabelsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	labels := c.labels[:0]
+	for _, label := range c.labels {
+		deleted := false
+		for _, match := range request.Label {
+			if label.EntityType == match.EntityType && label.EntityId == match.EntityId && label.Name == match.Name {
+				deleted = true
+			}
+		}
+		if !deleted {
+			labels = append(labels, label)
+		}
+	}
+	c.labels = labels
+	return &quobyte.DeleteLabelsResponse{}, nil
+}
+
+func sameConsumer(a, b *quobyte.ConsumingEntity) bool {
+	return a.Type == b.Type && a.Identifier == b.Identifier && a.TenantId == b.TenantId
+}
+
+func (c *cluster) setQuota(request *quobyte.SetQuotaRequest) (*quobyte.SetQuotaResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, quota := range request.Quotas {
+		if len(quota.Consumer) == 0 {
+			return nil, invalidParams("Quota has no consumer")
+		}
+		if quota.Consumer[0].Type == quobyte.ConsumingEntity_Type_VOLUME {
+			if _, err := c.volume(quota.Consumer[0].Identifier); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, quota := range request.Quotas {
+		var stored quobyte.Quota
+		deepCopy(&stored, quota)
+		stored.CurrentUsage = nil
+		replaced := false
+		for i, existing := range c.quotas {
+			if sameConsumer(existing.Consumer[0], stored.Consumer[0]) {
+				stored.Id = existing.Id
+				c.quotas[i] = &stored
+				replaced = true
+			}
+		}
+		if !replaced {
+			stored.Id = newUUID()
+			c.quotas = append(c.quotas, &stored)
+		}
+		if stored.Consumer[0].Type == quobyte.ConsumingEntity_Type_VOLUME {
+			for _, limit := range stored.Limits {
+				if limit.Type == quobyte.Resource_Type_LOGICAL_DISK_SPACE {
+					c.volumes[stored.Consumer[0].Identifier].QuotaDiskSpaceBytes = limit.Value
+				}
+			}
+		}
+	}
+	return &quobyte.SetQuotaResponse{}, nil
+}
+
+func (c *cluster) getQuota(request *quobyte.GetQuotaRequest) (*quobyte.GetQuotaResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var response quobyte.GetQuotaResponse
+	for _, quota := range c.quotas {
+		consumer := quota.Consumer[0]
+		if request.TenantDomain != "" && consumer.TenantId != request.TenantDomain &&
+			!(consumer.Type == quobyte.ConsumingEntity_Type_TENANT && consumer.Identifier == request.TenantDomain) {
+			continue
+		}
+		matches := len(request.OnlyEntity) == 0
+		for _, entity := range request.OnlyEntity {
+			matches = matches || (entity.Type == consumer.Type && entity.Identifier == consumer.Identifier)
+		}
+		if !matches {
+			continue
+		}
+		var copied quobyte.Quota
+		deepCopy(&copied, quota)
+		if len(request.OnlyResourceType) > 0 {
+			limits := copied.Limits[:0]
+			for _, limit := range copied.Limits {
+				for _, resourceType := range request.OnlyResourceType {
+					if resourceType != nil && limit.Type == *resourceType {
+						limits = append(limits, limit)
+					}
+				}
+			}
+			copied.Limits = limits
+		}
+		response.Quotas = append(response.Quotas, &copied)
+	}
+	return &response, nil
+}
+
+func (c *cluster) createSnapshot(request *quobyte.CreateSnapshotRequest) (*quobyte.CreateSnapshotResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.volume(request.VolumeUuid); err != nil {
+		return nil, err
+	}
+	snapshots := c.snapshots[request.VolumeUuid]
+	version := int64(1)
+	for _, snapshot := range snapshots {
+		if snapshot.Name == request.Name {
+			return nil, failed("Snapshot %s of volume %s already exists", request.Name, request.VolumeUuid)
+		}
+		if snapshot.Version >= version {
+			version = snapshot.Version + 1
+		}
+	}
+	c.snapshots[request.VolumeUuid] = append(snapshots, &quobyte.VolumeSnapshot{
+		VolumeUuid: request.VolumeUuid,
+		Version:    version,
+		Name:       request.Name,
+		Comment:    request.Comment,
+		Timestamp:  nowMs(),
+		Pinned:     request.Pinned,
+	})
+	return &quobyte.CreateSnapshotResponse{}, nil
+}
+
+func (c *cluster) listSnapshots(request *quobyte.ListSnapshotsRequest) (*quobyte.ListSnapshotsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.volume(request.VolumeUuid); err != nil {
+		return nil, err
+	}
+	var response quobyte.ListSnapshotsResponse
+	deepCopy(&response.Snapshot, c.snapshots[request.VolumeUuid])
+	return &response, nil
+}
+
+// removeSnapshot removes the snapshot of the volume. Must be called with mu held.
+func (c *cluster) removeSnapshot(volumeUUID, name string) error {
+	snapshots := c.snapshots[volumeUUID]
+	for i, snapshot := range snapshots {
+		if snapshot.Name == name {
+			c.snapshots[volumeUUID] = append(snapshots[:i:i], snapshots[i+1:]...)
+			return nil
+		}
+	}
+	return failed("Snapshot %s of volume %s does not exist", name, volumeUUID)
+}
+
+func (c *cluster) deleteSnapshot(request *quobyte.DeleteSnapshotRequest) (*quobyte.DeleteSnapshotResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.removeSnapshot(request.VolumeUuid, request.Name); err != nil {
+		return nil, err
+	}
+	return &quobyte.DeleteSnapshotResponse{}, nil
+}
+
+func (c *cluster) eraseSnapshot(request *quobyte.EraseSnapshotRequest) (*quobyte.EraseSnapshotResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.removeSnapshot(request.VolumeUuid, request.Name); err != nil {
+		return nil, err
+	}
+	return &quobyte.EraseSnapshotResponse{}, nil
+}
+
+func isFinal(state quobyte.TaskState) bool {
+	return state == quobyte.TaskState_FINISHED || state == quobyte.TaskState_CANCELED || state == quobyte.TaskState_FAILED
+}
+
+func (c *cluster) createTask(request *quobyte.CreateTaskRequest) (*quobyte.CreateTaskResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if request.TaskType == "" {
+		return nil, invalidParams("Task type is required")
+	}
+	for _, volumeUUID := range request.RestrictToVolumes {
+		if _, err := c.volume(volumeUUID); err != nil {
+			return nil, err
+		}
+	}
+	task := &quobyte.TaskInfo{
+		TaskId:                newUUID(),
+		TaskType:              request.TaskType,
+		State:                 quobyte.TaskState_RUNNING,
+		TaskPriority:          request.TaskPriority,
+		SubmissionTimestampMs: nowMs(),
+		BeginTimestampMs:      nowMs(),
+		Comment:               request.Comment,
+	}
+	c.tasks[task.TaskId] = task
+	return &quobyte.CreateTaskResponse{TaskId: task.TaskId}, nil
+}
+
+func (c *cluster) getTaskList(request *quobyte.GetTaskListRequest) (*quobyte.GetTaskListResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var response quobyte.GetTaskListResponse
+	for _, task := range c.tasks {
+		if len(request.TaskId) > 0 && !contains(request.TaskId, task.TaskId) ||
+			request.TaskType != "" && task.TaskType != request.TaskType ||
+			request.OnlyProcessing && isFinal(task.State) {
+			continue
+		}
+		matches := len(request.TaskState) == 0
+		for _, state := range request.TaskState {
+			matches = matches || (state != nil && *state == task.State)
+		}
+		if matches {
+			response.Tasks = append(response.Tasks, task)
+		}
+	}
+	sort.Slice(response.Tasks, func(i, j int) bool {
+		if request.OldestTasksFirst {
+			return response.Tasks[i].SubmissionTimestampMs < response.Tasks[j].SubmissionTimestampMs
+		}
+		return response.Tasks[i].SubmissionTimestampMs > response.Tasks[j].SubmissionTimestampMs
+	})
+	if request.TaskCountLimit > 0 && len(response.Tasks) > int(request.TaskCountLimit) {
+		response.Tasks = response.Tasks[:request.TaskCountLimit]
+	}
+	var copied quobyte.GetTaskListResponse
+	deepCopy(&copied, &response)
+	return &copied, nil
+}
+
+func (c *cluster) cancelTask(request *quobyte.CancelTaskRequest) (*quobyte.CancelTaskResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, taskID := range request.TaskId {
+		if _, ok := c.tasks[taskID]; !ok {
+			return nil, failed("Task %s does not exist", taskID)
+		}
+	}
+	for _, taskID := range request.TaskId {
+		task := c.tasks[taskID]
+		if request.Delete {
+			delete(c.tasks, taskID)
+		} else if !isFinal(task.State) {
+			task.State = quobyte.TaskState_CANCELED
+			task.EndTimestampMs = nowMs()
+		}
+	}
+	return &quobyte.CancelTaskResponse{}, nil
+}
+
+func (c *cluster) getDeviceList(request *quobyte.GetDeviceListRequest) (*quobyte.GetDeviceListResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var response quobyte.GetDeviceListResponse
+	for _, device := range c.devices {
+		if len(request.DeviceId) > 0 && !containsID(request.DeviceId, device.DeviceId) {
+			continue
+		}
+		matches := len(request.DeviceType) == 0
+		for _, deviceType := range request.DeviceType {
+			for _, content := range device.Content {
+				matches = matches || (deviceType != nil && content.ContentType == *deviceType)
+			}
+		}
+		if matches {
+			response.DeviceList.Devices = append(response.DeviceList.Devices, device)
+		}
+	}
+	sort.Slice(response.DeviceList.Devices, func(i, j int) bool {
+		return response.DeviceList.Devices[i].DeviceId < response.DeviceList.Devices[j].DeviceId
+	})
+	var copied quobyte.GetDeviceListResponse
+	deepCopy(&copied, &response)
+	return &copied, nil
+}
+
+func (c *cluster) updateDevice(request *quobyte.UpdateDeviceRequest) (*quobyte.UpdateDeviceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	device, ok := c.devices[request.DeviceId]
+	if !ok {
+		return nil, failed("Device %d does not exist", request.DeviceId)
+	}
+	if request.SetDeviceStatus != "" {
+		device.DeviceStatus = request.SetDeviceStatus
+	}
+	if request.UpdateDeviceTags {
+		device.DeviceTags = append([]string(nil), request.DeviceTags...)
+	}
+	if request.Draining || contains(request.Explicit(), "draining") {
+		device.Draining = request.Draining
+	}
+	if request.SetLedStatus != "" {
+		device.LedStatus = request.SetLedStatus
+	}
+	if request.SetDeviceHealth.HealthStatus != "" {
+		health := request.SetDeviceHealth
+		device.DeviceHealth = health
+	}
+	if request.SetMountState != "" {
+		device.MountState = request.SetMountState
+	}
+	return &quobyte.UpdateDeviceResponse{}, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsID(values []int64, value int64) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
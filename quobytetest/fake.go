@@ -0,0 +1,329 @@
+package quobytetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	quobyte "github.com/quobyte/api/quobyte"
+)
+
+// Fault decides if a call of a method fails. A non nil error is returned to the caller instead of
+// executing the call.
+type Fault func(ctx context.Context, request interface{}) error
+
+// FailWith returns a fault that fails every call with err.
+func FailWith(err error) Fault {
+	return func(ctx context.Context, request interface{}) error {
+		return err
+	}
+}
+
+// FailTimes returns a fault that fails the next n calls with err.
+func FailTimes(n int, err error) Fault {
+	var mu sync.Mutex
+	return func(ctx context.Context, request interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if n <= 0 {
+			return nil
+		}
+		n--
+		return err
+	}
+}
+
+// Fake is an in-memory implementation of quobyte.ExtendedQuobyteApi for tests that care about
+// behavior rather than the order of calls. It implements the same methods with the same
+// semantics as Server, other methods fail with an error matching quobyte.ErrMethodNotFound.
+type Fake struct {
+	cluster  *cluster
+	handlers map[string]handler
+
+	mu          sync.Mutex
+	faults      map[string]Fault
+	calls       map[string]int
+	retryPolicy string
+}
+
+// compile time check for interface compatibility
+var _ quobyte.ExtendedQuobyteApi = &Fake{}
+
+// NewFake creates an empty fake cluster.
+func NewFake() *Fake {
+	cluster := newCluster("admin")
+	return &Fake{
+		cluster:     cluster,
+		handlers:    cluster.handlers(),
+		faults:      map[string]Fault{},
+		calls:       map[string]int{},
+		retryPolicy: quobyte.RetryInteractive,
+	}
+}
+
+// InjectFault makes calls of the JSON-RPC method, e.g. "createVolume", fail as decided by fault.
+// A nil fault removes the fault of the method.
+func (fake *Fake) InjectFault(method string, fault Fault) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fault == nil {
+		delete(fake.faults, method)
+		return
+	}
+	fake.faults[method] = fault
+}
+
+// ClearFaults removes all injected faults.
+func (fake *Fake) ClearFaults() {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.faults = map[string]Fault{}
+}
+
+// Calls returns how often the JSON-RPC method was called, including failed calls.
+func (fake *Fake) Calls(method string) int {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return fake.calls[method]
+}
+
+// AddDevice adds a device to the cluster.
+func (fake *Fake) AddDevice(device *quobyte.Device) {
+	fake.cluster.addDevice(device)
+}
+
+// SetVersion sets the product version reported by GetLicense.
+func (fake *Fake) SetVersion(version string) {
+	fake.cluster.setVersion(version)
+}
+
+// SetTaskState changes the state of a task, e.g. to finish it.
+func (fake *Fake) SetTaskState(taskID string, state quobyte.TaskState) error {
+	return fake.cluster.setTaskState(taskID, state)
+}
+
+// call executes the JSON-RPC method on the cluster. request and response are copied, so that
+// the caller does not share memory with the cluster.
+func (fake *Fake) call(ctx context.Context, method string, request, response interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	fake.mu.Lock()
+	fake.calls[method]++
+	fault := fake.faults[method]
+	fake.mu.Unlock()
+	if fault != nil {
+		if err := fault(ctx, request); err != nil {
+			return err
+		}
+	}
+	handler, ok := fake.handlers[method]
+	if !ok {
+		return &quobyte.RPCError{
+			Method:  method,
+			Code:    quobyte.ErrorCodeMethodNotFound,
+			Message: "method is not implemented by quobytetest.Fake",
+		}
+	}
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	params, err := handler.decode(data)
+	if err != nil {
+		return err
+	}
+	result, err := handler.call(params)
+	if err != nil {
+		var rpcErr *quobyte.RPCError
+		if errors.As(err, &rpcErr) {
+			copied := *rpcErr
+			copied.Method = method
+			return &copied
+		}
+		return err
+	}
+	if response != nil {
+		deepCopy(response, result)
+	}
+	return nil
+}
+
+// GetVolumeUUID resolves the volume name to a UUID if it is no UUID.
+func (fake *Fake) GetVolumeUUID(volume, tenant string) (string, error) {
+	return fake.GetVolumeUUIDContext(context.Background(), volume, tenant)
+}
+
+// GetVolumeUUIDContext is like GetVolumeUUID but uses ctx for the underlying requests.
+func (fake *Fake) GetVolumeUUIDContext(ctx context.Context, volume, tenant string) (string, error) {
+	if len(volume) != 0 && !quobyte.IsValidUUID(volume) {
+		tenantUUID, err := fake.GetTenantUUIDContext(ctx, tenant)
+		if err != nil {
+			return "", err
+		}
+		return fake.ResolveVolumeNameToUUIDContext(ctx, volume, tenantUUID)
+	}
+	return volume, nil
+}
+
+// GetTenantUUID resolves the tenant name to a UUID if it is no UUID.
+func (fake *Fake) GetTenantUUID(tenant string) (string, error) {
+	return fake.GetTenantUUIDContext(context.Background(), tenant)
+}
+
+// GetTenantUUIDContext is like GetTenantUUID but uses ctx for the underlying requests.
+func (fake *Fake) GetTenantUUIDContext(ctx context.Context, tenant string) (string, error) {
+	if len(tenant) != 0 && !quobyte.IsValidUUID(tenant) {
+		return fake.ResolveTenantNameToUUIDContext(ctx, tenant)
+	}
+	return tenant, nil
+}
+
+// ResolveVolumeNameToUUID resolves a volume name to a UUID.
+func (fake *Fake) ResolveVolumeNameToUUID(volumeName, tenant string) (string, error) {
+	return fake.ResolveVolumeNameToUUIDContext(context.Background(), volumeName, tenant)
+}
+
+// ResolveVolumeNameToUUIDContext is like ResolveVolumeNameToUUID but uses ctx for the request.
+func (fake *Fake) ResolveVolumeNameToUUIDContext(ctx context.Context, volumeName, tenant string) (string, error) {
+	response, err := fake.ResolveVolumeNameContext(ctx, &quobyte.ResolveVolumeNameRequest{
+		VolumeName:   volumeName,
+		TenantDomain: tenant,
+	})
+	if err != nil {
+		if errors.Is(err, quobyte.ErrNotFound) {
+			return "", fmt.Errorf("volume %s in tenant %s: %w", volumeName, tenant, err)
+		}
+		return "", err
+	}
+	return response.VolumeUuid, nil
+}
+
+// DeleteVolumeByResolvingNamesToUUID deletes the volume given by name or UUID.
+func (fake *Fake) DeleteVolumeByResolvingNamesToUUID(volume, tenant string) error {
+	return fake.DeleteVolumeByResolvingNamesToUUIDContext(context.Background(), volume, tenant)
+}
+
+// DeleteVolumeByResolvingNamesToUUIDContext is like DeleteVolumeByResolvingNamesToUUID but uses ctx
+// for the underlying requests.
+func (fake *Fake) DeleteVolumeByResolvingNamesToUUIDContext(ctx context.Context, volume, tenant string) error {
+	volumeUUID, err := fake.GetVolumeUUIDContext(ctx, volume, tenant)
+	if err != nil {
+		return err
+	}
+	_, err = fake.DeleteVolumeContext(ctx, &quobyte.DeleteVolumeRequest{VolumeUuid: volumeUUID})
+	return err
+}
+
+// DeleteVolumeByName deletes a volume by name.
+func (fake *Fake) DeleteVolumeByName(volumeName, tenant string) error {
+	return fake.DeleteVolumeByNameContext(context.Background(), volumeName, tenant)
+}
+
+// DeleteVolumeByNameContext is like DeleteVolumeByName but uses ctx for the underlying requests.
+func (fake *Fake) DeleteVolumeByNameContext(ctx context.Context, volumeName, tenant string) error {
+	volumeUUID, err := fake.ResolveVolumeNameToUUIDContext(ctx, volumeName, tenant)
+	if err != nil {
+		return err
+	}
+	_, err = fake.DeleteVolumeContext(ctx, &quobyte.DeleteVolumeRequest{VolumeUuid: volumeUUID})
+	return err
+}
+
+// EraseVolumeByResolvingNamesToUUID schedules the volume given by name or UUID for deletion.
+func (fake *Fake) EraseVolumeByResolvingNamesToUUID(volume, tenant string, force bool) error {
+	return fake.EraseVolumeByResolvingNamesToUUIDContext(context.Background(), volume, tenant, force)
+}
+
+// EraseVolumeByResolvingNamesToUUIDContext is like EraseVolumeByResolvingNamesToUUID but uses ctx
+// for the underlying requests.
+func (fake *Fake) EraseVolumeByResolvingNamesToUUIDContext(ctx context.Context, volume, tenant string, force bool) error {
+	volumeUUID, err := fake.GetVolumeUUIDContext(ctx, volume, tenant)
+	if err != nil {
+		return err
+	}
+	_, err = fake.EraseVolumeContext(ctx, &quobyte.EraseVolumeRequest{VolumeUuid: volumeUUID, Force: force})
+	return err
+}
+
+// EraseVolumeByResolvingNamesToUUID_2X is like EraseVolumeByResolvingNamesToUUID without force.
+func (fake *Fake) EraseVolumeByResolvingNamesToUUID_2X(volume, tenant string) error {
+	return fake.EraseVolumeByResolvingNamesToUUID_2XContext(context.Background(), volume, tenant)
+}
+
+// EraseVolumeByResolvingNamesToUUID_2XContext is like EraseVolumeByResolvingNamesToUUID_2X but uses
+// ctx for the underlying requests.
+func (fake *Fake) EraseVolumeByResolvingNamesToUUID_2XContext(ctx context.Context, volume, tenant string) error {
+	return fake.EraseVolumeByResolvingNamesToUUIDContext(ctx, volume, tenant, false)
+}
+
+// SetVolumeQuota sets the logical disk space quota of the volume.
+func (fake *Fake) SetVolumeQuota(volumeUUID string, quotaSize int64) error {
+	return fake.SetVolumeQuotaContext(context.Background(), volumeUUID, quotaSize)
+}
+
+// SetVolumeQuotaContext is like SetVolumeQuota but uses ctx for the request.
+func (fake *Fake) SetVolumeQuotaContext(ctx context.Context, volumeUUID string, quotaSize int64) error {
+	_, err := fake.SetQuotaContext(ctx, &quobyte.SetQuotaRequest{
+		Quotas: []*quobyte.Quota{{
+			Consumer: []*quobyte.ConsumingEntity{{Type: quobyte.ConsumingEntity_Type_VOLUME, Identifier: volumeUUID}},
+			Limits:   []*quobyte.Resource{{Type: quobyte.Resource_Type_LOGICAL_DISK_SPACE, Value: quotaSize}},
+		}},
+	})
+	return err
+}
+
+// GetTenantMap returns the UUIDs of all tenants by name.
+func (fake *Fake) GetTenantMap() (map[string]string, error) {
+	return fake.GetTenantMapContext(context.Background())
+}
+
+// GetTenantMapContext is like GetTenantMap but uses ctx for the request.
+func (fake *Fake) GetTenantMapContext(ctx context.Context) (map[string]string, error) {
+	result := map[string]string{}
+	response, err := fake.GetTenantContext(ctx, &quobyte.GetTenantRequest{})
+	if err != nil {
+		return result, err
+	}
+	for _, tenant := range response.Tenant {
+		result[tenant.Name] = tenant.TenantId
+	}
+	return result, nil
+}
+
+// ResolveTenantNameToUUID returns the UUID of the tenant.
+func (fake *Fake) ResolveTenantNameToUUID(name string) (string, error) {
+	return fake.ResolveTenantNameToUUIDContext(context.Background(), name)
+}
+
+// ResolveTenantNameToUUIDContext is like ResolveTenantNameToUUID but uses ctx for the request.
+func (fake *Fake) ResolveTenantNameToUUIDContext(ctx context.Context, name string) (string, error) {
+	response, err := fake.ResolveTenantNameContext(ctx, &quobyte.ResolveTenantNameRequest{TenantName: name})
+	if err != nil {
+		if errors.Is(err, quobyte.ErrNotFound) {
+			return "", fmt.Errorf("tenant %s: %w", name, err)
+		}
+		return "", err
+	}
+	return response.TenantId, nil
+}
+
+// SetAPIRetryPolicy stores the retry policy, the fake does not retry.
+func (fake *Fake) SetAPIRetryPolicy(retry string) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.retryPolicy = retry
+}
+
+// GetAPIRetryPolicy returns the retry policy set with SetAPIRetryPolicy.
+func (fake *Fake) GetAPIRetryPolicy() string {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return fake.retryPolicy
+}
+
+// SetTransport is a no-op, the fake does not use HTTP.
+func (fake *Fake) SetTransport(t http.RoundTripper) {}
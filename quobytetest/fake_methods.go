@@ -0,0 +1,1641 @@
+// Code generated by quobyte-gen from schema/api.json; DO NOT EDIT.
+
+package quobytetest
+
+import (
+	"context"
+
+	quobyte "github.com/quobyte/api/quobyte"
+)
+
+func (fake *Fake) AcceptTermsAndConditions(request *quobyte.AcceptTermsAndConditionsRequest) (result *quobyte.AcceptTermsAndConditionsResponse, err error) {
+	return fake.AcceptTermsAndConditionsContext(context.Background(), request)
+}
+
+func (fake *Fake) AcceptTermsAndConditionsContext(ctx context.Context, request *quobyte.AcceptTermsAndConditionsRequest) (result *quobyte.AcceptTermsAndConditionsResponse, err error) {
+	var response quobyte.AcceptTermsAndConditionsResponse
+	if err = fake.call(ctx, quobyte.MethodAcceptTermsAndConditions, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) AcknowledgeAlert(request *quobyte.AcknowledgeAlertRequest) (result *quobyte.AcknowledgeAlertResponse, err error) {
+	return fake.AcknowledgeAlertContext(context.Background(), request)
+}
+
+func (fake *Fake) AcknowledgeAlertContext(ctx context.Context, request *quobyte.AcknowledgeAlertRequest) (result *quobyte.AcknowledgeAlertResponse, err error) {
+	var response quobyte.AcknowledgeAlertResponse
+	if err = fake.call(ctx, quobyte.MethodAcknowledgeAlert, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) AddCa(request *quobyte.AddCaRequest) (result *quobyte.AddCaResponse, err error) {
+	return fake.AddCaContext(context.Background(), request)
+}
+
+func (fake *Fake) AddCaContext(ctx context.Context, request *quobyte.AddCaRequest) (result *quobyte.AddCaResponse, err error) {
+	var response quobyte.AddCaResponse
+	if err = fake.call(ctx, quobyte.MethodAddCa, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) AddCertificate(request *quobyte.AddCertificateRequest) (result *quobyte.AddCertificateResponse, err error) {
+	return fake.AddCertificateContext(context.Background(), request)
+}
+
+func (fake *Fake) AddCertificateContext(ctx context.Context, request *quobyte.AddCertificateRequest) (result *quobyte.AddCertificateResponse, err error) {
+	var response quobyte.AddCertificateResponse
+	if err = fake.call(ctx, quobyte.MethodAddCertificate, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) AddCsr(request *quobyte.AddCsrRequest) (result *quobyte.AddCsrResponse, err error) {
+	return fake.AddCsrContext(context.Background(), request)
+}
+
+func (fake *Fake) AddCsrContext(ctx context.Context, request *quobyte.AddCsrRequest) (result *quobyte.AddCsrResponse, err error) {
+	var response quobyte.AddCsrResponse
+	if err = fake.call(ctx, quobyte.MethodAddCsr, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) AddRegistryReplica(request *quobyte.AddRegistryReplicaRequest) (result *quobyte.AddRegistryReplicaResponse, err error) {
+	return fake.AddRegistryReplicaContext(context.Background(), request)
+}
+
+func (fake *Fake) AddRegistryReplicaContext(ctx context.Context, request *quobyte.AddRegistryReplicaRequest) (result *quobyte.AddRegistryReplicaResponse, err error) {
+	var response quobyte.AddRegistryReplicaResponse
+	if err = fake.call(ctx, quobyte.MethodAddRegistryReplica, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) AnalyzeVolumes(request *quobyte.AnalyzeVolumesRequest) (result *quobyte.AnalyzeVolumesResponse, err error) {
+	return fake.AnalyzeVolumesContext(context.Background(), request)
+}
+
+func (fake *Fake) AnalyzeVolumesContext(ctx context.Context, request *quobyte.AnalyzeVolumesRequest) (result *quobyte.AnalyzeVolumesResponse, err error) {
+	var response quobyte.AnalyzeVolumesResponse
+	if err = fake.call(ctx, quobyte.MethodAnalyzeVolumes, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CancelNetworkTest(request *quobyte.CancelNetworkTestRequest) (result *quobyte.CancelNetworkTestResponse, err error) {
+	return fake.CancelNetworkTestContext(context.Background(), request)
+}
+
+func (fake *Fake) CancelNetworkTestContext(ctx context.Context, request *quobyte.CancelNetworkTestRequest) (result *quobyte.CancelNetworkTestResponse, err error) {
+	var response quobyte.CancelNetworkTestResponse
+	if err = fake.call(ctx, quobyte.MethodCancelNetworkTest, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CancelQuery(request *quobyte.CancelQueryRequest) (result *quobyte.CancelQueryResponse, err error) {
+	return fake.CancelQueryContext(context.Background(), request)
+}
+
+func (fake *Fake) CancelQueryContext(ctx context.Context, request *quobyte.CancelQueryRequest) (result *quobyte.CancelQueryResponse, err error) {
+	var response quobyte.CancelQueryResponse
+	if err = fake.call(ctx, quobyte.MethodCancelQuery, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CancelSupportDump(request *quobyte.CancelSupportDumpRequest) (result *quobyte.CancelSupportDumpResponse, err error) {
+	return fake.CancelSupportDumpContext(context.Background(), request)
+}
+
+func (fake *Fake) CancelSupportDumpContext(ctx context.Context, request *quobyte.CancelSupportDumpRequest) (result *quobyte.CancelSupportDumpResponse, err error) {
+	var response quobyte.CancelSupportDumpResponse
+	if err = fake.call(ctx, quobyte.MethodCancelSupportDump, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CancelTask(request *quobyte.CancelTaskRequest) (result *quobyte.CancelTaskResponse, err error) {
+	return fake.CancelTaskContext(context.Background(), request)
+}
+
+func (fake *Fake) CancelTaskContext(ctx context.Context, request *quobyte.CancelTaskRequest) (result *quobyte.CancelTaskResponse, err error) {
+	var response quobyte.CancelTaskResponse
+	if err = fake.call(ctx, quobyte.MethodCancelTask, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CancelVolumeErasure(request *quobyte.CancelVolumeErasureRequest) (result *quobyte.CancelVolumeErasureResponse, err error) {
+	return fake.CancelVolumeErasureContext(context.Background(), request)
+}
+
+func (fake *Fake) CancelVolumeErasureContext(ctx context.Context, request *quobyte.CancelVolumeErasureRequest) (result *quobyte.CancelVolumeErasureResponse, err error) {
+	var response quobyte.CancelVolumeErasureResponse
+	if err = fake.call(ctx, quobyte.MethodCancelVolumeErasure, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ChangePolicyRulePriority(request *quobyte.ChangePolicyRulePriorityRequest) (result *quobyte.ChangePolicyRulePriorityResponse, err error) {
+	return fake.ChangePolicyRulePriorityContext(context.Background(), request)
+}
+
+func (fake *Fake) ChangePolicyRulePriorityContext(ctx context.Context, request *quobyte.ChangePolicyRulePriorityRequest) (result *quobyte.ChangePolicyRulePriorityResponse, err error) {
+	var response quobyte.ChangePolicyRulePriorityResponse
+	if err = fake.call(ctx, quobyte.MethodChangePolicyRulePriority, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ConfigureRule(request *quobyte.ConfigureRuleRequest) (result *quobyte.ConfigureRuleResponse, err error) {
+	return fake.ConfigureRuleContext(context.Background(), request)
+}
+
+func (fake *Fake) ConfigureRuleContext(ctx context.Context, request *quobyte.ConfigureRuleRequest) (result *quobyte.ConfigureRuleResponse, err error) {
+	var response quobyte.ConfigureRuleResponse
+	if err = fake.call(ctx, quobyte.MethodConfigureRule, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateAccessKeyCredentials(request *quobyte.CreateAccessKeyCredentialsRequest) (result *quobyte.CreateAccessKeyCredentialsResponse, err error) {
+	return fake.CreateAccessKeyCredentialsContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateAccessKeyCredentialsContext(ctx context.Context, request *quobyte.CreateAccessKeyCredentialsRequest) (result *quobyte.CreateAccessKeyCredentialsResponse, err error) {
+	var response quobyte.CreateAccessKeyCredentialsResponse
+	if err = fake.call(ctx, quobyte.MethodCreateAccessKeyCredentials, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateMasterKeystoreSlot(request *quobyte.CreateMasterKeystoreSlotRequest) (result *quobyte.CreateMasterKeystoreSlotResponse, err error) {
+	return fake.CreateMasterKeystoreSlotContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateMasterKeystoreSlotContext(ctx context.Context, request *quobyte.CreateMasterKeystoreSlotRequest) (result *quobyte.CreateMasterKeystoreSlotResponse, err error) {
+	var response quobyte.CreateMasterKeystoreSlotResponse
+	if err = fake.call(ctx, quobyte.MethodCreateMasterKeystoreSlot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateMirroredVolume(request *quobyte.CreateMirroredVolumeRequest) (result *quobyte.CreateMirroredVolumeResponse, err error) {
+	return fake.CreateMirroredVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateMirroredVolumeContext(ctx context.Context, request *quobyte.CreateMirroredVolumeRequest) (result *quobyte.CreateMirroredVolumeResponse, err error) {
+	var response quobyte.CreateMirroredVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodCreateMirroredVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateNewUserKeystoreSlot(request *quobyte.CreateNewUserKeystoreSlotRequest) (result *quobyte.CreateNewUserKeystoreSlotResponse, err error) {
+	return fake.CreateNewUserKeystoreSlotContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateNewUserKeystoreSlotContext(ctx context.Context, request *quobyte.CreateNewUserKeystoreSlotRequest) (result *quobyte.CreateNewUserKeystoreSlotResponse, err error) {
+	var response quobyte.CreateNewUserKeystoreSlotResponse
+	if err = fake.call(ctx, quobyte.MethodCreateNewUserKeystoreSlot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateNotificationRule(request *quobyte.CreateNotificationRuleRequest) (result *quobyte.CreateNotificationRuleResponse, err error) {
+	return fake.CreateNotificationRuleContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateNotificationRuleContext(ctx context.Context, request *quobyte.CreateNotificationRuleRequest) (result *quobyte.CreateNotificationRuleResponse, err error) {
+	var response quobyte.CreateNotificationRuleResponse
+	if err = fake.call(ctx, quobyte.MethodCreateNotificationRule, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreatePolicyRule(request *quobyte.CreatePolicyRuleRequest) (result *quobyte.CreatePolicyRuleResponse, err error) {
+	return fake.CreatePolicyRuleContext(context.Background(), request)
+}
+
+func (fake *Fake) CreatePolicyRuleContext(ctx context.Context, request *quobyte.CreatePolicyRuleRequest) (result *quobyte.CreatePolicyRuleResponse, err error) {
+	var response quobyte.CreatePolicyRuleResponse
+	if err = fake.call(ctx, quobyte.MethodCreatePolicyRule, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreatePolicyRuleSet(request *quobyte.CreatePolicyRuleSetRequest) (result *quobyte.CreatePolicyRuleSetResponse, err error) {
+	return fake.CreatePolicyRuleSetContext(context.Background(), request)
+}
+
+func (fake *Fake) CreatePolicyRuleSetContext(ctx context.Context, request *quobyte.CreatePolicyRuleSetRequest) (result *quobyte.CreatePolicyRuleSetResponse, err error) {
+	var response quobyte.CreatePolicyRuleSetResponse
+	if err = fake.call(ctx, quobyte.MethodCreatePolicyRuleSet, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateSnapshot(request *quobyte.CreateSnapshotRequest) (result *quobyte.CreateSnapshotResponse, err error) {
+	return fake.CreateSnapshotContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateSnapshotContext(ctx context.Context, request *quobyte.CreateSnapshotRequest) (result *quobyte.CreateSnapshotResponse, err error) {
+	var response quobyte.CreateSnapshotResponse
+	if err = fake.call(ctx, quobyte.MethodCreateSnapshot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateTask(request *quobyte.CreateTaskRequest) (result *quobyte.CreateTaskResponse, err error) {
+	return fake.CreateTaskContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateTaskContext(ctx context.Context, request *quobyte.CreateTaskRequest) (result *quobyte.CreateTaskResponse, err error) {
+	var response quobyte.CreateTaskResponse
+	if err = fake.call(ctx, quobyte.MethodCreateTask, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateUser(request *quobyte.CreateUserRequest) (result *quobyte.CreateUserResponse, err error) {
+	return fake.CreateUserContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateUserContext(ctx context.Context, request *quobyte.CreateUserRequest) (result *quobyte.CreateUserResponse, err error) {
+	var response quobyte.CreateUserResponse
+	if err = fake.call(ctx, quobyte.MethodCreateUser, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) CreateVolume(request *quobyte.CreateVolumeRequest) (result *quobyte.CreateVolumeResponse, err error) {
+	return fake.CreateVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) CreateVolumeContext(ctx context.Context, request *quobyte.CreateVolumeRequest) (result *quobyte.CreateVolumeResponse, err error) {
+	var response quobyte.CreateVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodCreateVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DecideCsr(request *quobyte.DecideCsrRequest) (result *quobyte.DecideCsrResponse, err error) {
+	return fake.DecideCsrContext(context.Background(), request)
+}
+
+func (fake *Fake) DecideCsrContext(ctx context.Context, request *quobyte.DecideCsrRequest) (result *quobyte.DecideCsrResponse, err error) {
+	var response quobyte.DecideCsrResponse
+	if err = fake.call(ctx, quobyte.MethodDecideCsr, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteAccessKeyCredentials(request *quobyte.DeleteAccessKeyCredentialsRequest) (result *quobyte.DeleteAccessKeyCredentialsResponse, err error) {
+	return fake.DeleteAccessKeyCredentialsContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteAccessKeyCredentialsContext(ctx context.Context, request *quobyte.DeleteAccessKeyCredentialsRequest) (result *quobyte.DeleteAccessKeyCredentialsResponse, err error) {
+	var response quobyte.DeleteAccessKeyCredentialsResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteAccessKeyCredentials, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteCa(request *quobyte.DeleteCaRequest) (result *quobyte.DeleteCaResponse, err error) {
+	return fake.DeleteCaContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteCaContext(ctx context.Context, request *quobyte.DeleteCaRequest) (result *quobyte.DeleteCaResponse, err error) {
+	var response quobyte.DeleteCaResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteCa, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteCertificate(request *quobyte.DeleteCertificateRequest) (result *quobyte.DeleteCertificateResponse, err error) {
+	return fake.DeleteCertificateContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteCertificateContext(ctx context.Context, request *quobyte.DeleteCertificateRequest) (result *quobyte.DeleteCertificateResponse, err error) {
+	var response quobyte.DeleteCertificateResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteCertificate, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteConfiguration(request *quobyte.DeleteConfigurationRequest) (result *quobyte.DeleteConfigurationResponse, err error) {
+	return fake.DeleteConfigurationContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteConfigurationContext(ctx context.Context, request *quobyte.DeleteConfigurationRequest) (result *quobyte.DeleteConfigurationResponse, err error) {
+	var response quobyte.DeleteConfigurationResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteConfiguration, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteCsr(request *quobyte.DeleteCsrRequest) (result *quobyte.DeleteCsrResponse, err error) {
+	return fake.DeleteCsrContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteCsrContext(ctx context.Context, request *quobyte.DeleteCsrRequest) (result *quobyte.DeleteCsrResponse, err error) {
+	var response quobyte.DeleteCsrResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteCsr, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteLabels(request *quobyte.DeleteLabelsRequest) (result *quobyte.DeleteLabelsResponse, err error) {
+	return fake.DeleteLabelsContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteLabelsContext(ctx context.Context, request *quobyte.DeleteLabelsRequest) (result *quobyte.DeleteLabelsResponse, err error) {
+	var response quobyte.DeleteLabelsResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteLabels, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteNotificationRule(request *quobyte.DeleteNotificationRuleRequest) (result *quobyte.DeleteNotificationRuleResponse, err error) {
+	return fake.DeleteNotificationRuleContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteNotificationRuleContext(ctx context.Context, request *quobyte.DeleteNotificationRuleRequest) (result *quobyte.DeleteNotificationRuleResponse, err error) {
+	var response quobyte.DeleteNotificationRuleResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteNotificationRule, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeletePolicyRules(request *quobyte.DeletePolicyRulesRequest) (result *quobyte.DeletePolicyRulesResponse, err error) {
+	return fake.DeletePolicyRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) DeletePolicyRulesContext(ctx context.Context, request *quobyte.DeletePolicyRulesRequest) (result *quobyte.DeletePolicyRulesResponse, err error) {
+	var response quobyte.DeletePolicyRulesResponse
+	if err = fake.call(ctx, quobyte.MethodDeletePolicyRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteSnapshot(request *quobyte.DeleteSnapshotRequest) (result *quobyte.DeleteSnapshotResponse, err error) {
+	return fake.DeleteSnapshotContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteSnapshotContext(ctx context.Context, request *quobyte.DeleteSnapshotRequest) (result *quobyte.DeleteSnapshotResponse, err error) {
+	var response quobyte.DeleteSnapshotResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteSnapshot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteTenant(request *quobyte.DeleteTenantRequest) (result *quobyte.DeleteTenantResponse, err error) {
+	return fake.DeleteTenantContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteTenantContext(ctx context.Context, request *quobyte.DeleteTenantRequest) (result *quobyte.DeleteTenantResponse, err error) {
+	var response quobyte.DeleteTenantResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteTenant, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteUser(request *quobyte.DeleteUserRequest) (result *quobyte.DeleteUserResponse, err error) {
+	return fake.DeleteUserContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteUserContext(ctx context.Context, request *quobyte.DeleteUserRequest) (result *quobyte.DeleteUserResponse, err error) {
+	var response quobyte.DeleteUserResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteUser, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeleteVolume(request *quobyte.DeleteVolumeRequest) (result *quobyte.DeleteVolumeResponse, err error) {
+	return fake.DeleteVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) DeleteVolumeContext(ctx context.Context, request *quobyte.DeleteVolumeRequest) (result *quobyte.DeleteVolumeResponse, err error) {
+	var response quobyte.DeleteVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodDeleteVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DeregisterService(request *quobyte.DeregisterServiceRequest) (result *quobyte.DeregisterServiceResponse, err error) {
+	return fake.DeregisterServiceContext(context.Background(), request)
+}
+
+func (fake *Fake) DeregisterServiceContext(ctx context.Context, request *quobyte.DeregisterServiceRequest) (result *quobyte.DeregisterServiceResponse, err error) {
+	var response quobyte.DeregisterServiceResponse
+	if err = fake.call(ctx, quobyte.MethodDeregisterService, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DisconnectMirroredVolume(request *quobyte.DisconnectMirroredVolumeRequest) (result *quobyte.DisconnectMirroredVolumeResponse, err error) {
+	return fake.DisconnectMirroredVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) DisconnectMirroredVolumeContext(ctx context.Context, request *quobyte.DisconnectMirroredVolumeRequest) (result *quobyte.DisconnectMirroredVolumeResponse, err error) {
+	var response quobyte.DisconnectMirroredVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodDisconnectMirroredVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DumpEffectivePolicyRules(request *quobyte.DumpEffectivePolicyRulesRequest) (result *quobyte.DumpEffectivePolicyRulesResponse, err error) {
+	return fake.DumpEffectivePolicyRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) DumpEffectivePolicyRulesContext(ctx context.Context, request *quobyte.DumpEffectivePolicyRulesRequest) (result *quobyte.DumpEffectivePolicyRulesResponse, err error) {
+	var response quobyte.DumpEffectivePolicyRulesResponse
+	if err = fake.call(ctx, quobyte.MethodDumpEffectivePolicyRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) DumpPolicyPresets(request *quobyte.DumpPolicyPresetsRequest) (result *quobyte.DumpPolicyPresetsResponse, err error) {
+	return fake.DumpPolicyPresetsContext(context.Background(), request)
+}
+
+func (fake *Fake) DumpPolicyPresetsContext(ctx context.Context, request *quobyte.DumpPolicyPresetsRequest) (result *quobyte.DumpPolicyPresetsResponse, err error) {
+	var response quobyte.DumpPolicyPresetsResponse
+	if err = fake.call(ctx, quobyte.MethodDumpPolicyPresets, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) EraseSnapshot(request *quobyte.EraseSnapshotRequest) (result *quobyte.EraseSnapshotResponse, err error) {
+	return fake.EraseSnapshotContext(context.Background(), request)
+}
+
+func (fake *Fake) EraseSnapshotContext(ctx context.Context, request *quobyte.EraseSnapshotRequest) (result *quobyte.EraseSnapshotResponse, err error) {
+	var response quobyte.EraseSnapshotResponse
+	if err = fake.call(ctx, quobyte.MethodEraseSnapshot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) EraseVolume(request *quobyte.EraseVolumeRequest) (result *quobyte.EraseVolumeResponse, err error) {
+	return fake.EraseVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) EraseVolumeContext(ctx context.Context, request *quobyte.EraseVolumeRequest) (result *quobyte.EraseVolumeResponse, err error) {
+	var response quobyte.EraseVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodEraseVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ExportCertificate(request *quobyte.ExportCertificateRequest) (result *quobyte.ExportCertificateResponse, err error) {
+	return fake.ExportCertificateContext(context.Background(), request)
+}
+
+func (fake *Fake) ExportCertificateContext(ctx context.Context, request *quobyte.ExportCertificateRequest) (result *quobyte.ExportCertificateResponse, err error) {
+	var response quobyte.ExportCertificateResponse
+	if err = fake.call(ctx, quobyte.MethodExportCertificate, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ExportConfiguration(request *quobyte.ExportConfigurationRequest) (result *quobyte.ExportConfigurationResponse, err error) {
+	return fake.ExportConfigurationContext(context.Background(), request)
+}
+
+func (fake *Fake) ExportConfigurationContext(ctx context.Context, request *quobyte.ExportConfigurationRequest) (result *quobyte.ExportConfigurationResponse, err error) {
+	var response quobyte.ExportConfigurationResponse
+	if err = fake.call(ctx, quobyte.MethodExportConfiguration, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ExportPolicyRules(request *quobyte.ExportPolicyRulesRequest) (result *quobyte.ExportPolicyRulesResponse, err error) {
+	return fake.ExportPolicyRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) ExportPolicyRulesContext(ctx context.Context, request *quobyte.ExportPolicyRulesRequest) (result *quobyte.ExportPolicyRulesResponse, err error) {
+	var response quobyte.ExportPolicyRulesResponse
+	if err = fake.call(ctx, quobyte.MethodExportPolicyRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ExportVolume(request *quobyte.ExportVolumeRequest) (result *quobyte.ExportVolumeResponse, err error) {
+	return fake.ExportVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) ExportVolumeContext(ctx context.Context, request *quobyte.ExportVolumeRequest) (result *quobyte.ExportVolumeResponse, err error) {
+	var response quobyte.ExportVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodExportVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) FilterPolicyRules(request *quobyte.FilterPolicyRulesRequest) (result *quobyte.FilterPolicyRulesResponse, err error) {
+	return fake.FilterPolicyRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) FilterPolicyRulesContext(ctx context.Context, request *quobyte.FilterPolicyRulesRequest) (result *quobyte.FilterPolicyRulesResponse, err error) {
+	var response quobyte.FilterPolicyRulesResponse
+	if err = fake.call(ctx, quobyte.MethodFilterPolicyRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GenerateAsyncSupportDump(request *quobyte.GenerateAsyncSupportDumpRequest) (result *quobyte.GenerateAsyncSupportDumpResponse, err error) {
+	return fake.GenerateAsyncSupportDumpContext(context.Background(), request)
+}
+
+func (fake *Fake) GenerateAsyncSupportDumpContext(ctx context.Context, request *quobyte.GenerateAsyncSupportDumpRequest) (result *quobyte.GenerateAsyncSupportDumpResponse, err error) {
+	var response quobyte.GenerateAsyncSupportDumpResponse
+	if err = fake.call(ctx, quobyte.MethodGenerateAsyncSupportDump, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetAccounting(request *quobyte.GetAccountingRequest) (result *quobyte.GetAccountingResponse, err error) {
+	return fake.GetAccountingContext(context.Background(), request)
+}
+
+func (fake *Fake) GetAccountingContext(ctx context.Context, request *quobyte.GetAccountingRequest) (result *quobyte.GetAccountingResponse, err error) {
+	var response quobyte.GetAccountingResponse
+	if err = fake.call(ctx, quobyte.MethodGetAccounting, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetAddKeySlotData(request *quobyte.GetAddKeySlotDataRequest) (result *quobyte.GetAddKeySlotDataResponse, err error) {
+	return fake.GetAddKeySlotDataContext(context.Background(), request)
+}
+
+func (fake *Fake) GetAddKeySlotDataContext(ctx context.Context, request *quobyte.GetAddKeySlotDataRequest) (result *quobyte.GetAddKeySlotDataResponse, err error) {
+	var response quobyte.GetAddKeySlotDataResponse
+	if err = fake.call(ctx, quobyte.MethodGetAddKeySlotData, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetAnalyzeReports(request *quobyte.GetAnalyzeReportsRequest) (result *quobyte.GetAnalyzeReportsResponse, err error) {
+	return fake.GetAnalyzeReportsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetAnalyzeReportsContext(ctx context.Context, request *quobyte.GetAnalyzeReportsRequest) (result *quobyte.GetAnalyzeReportsResponse, err error) {
+	var response quobyte.GetAnalyzeReportsResponse
+	if err = fake.call(ctx, quobyte.MethodGetAnalyzeReports, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetAuditLog(request *quobyte.GetAuditLogRequest) (result *quobyte.GetAuditLogResponse, err error) {
+	return fake.GetAuditLogContext(context.Background(), request)
+}
+
+func (fake *Fake) GetAuditLogContext(ctx context.Context, request *quobyte.GetAuditLogRequest) (result *quobyte.GetAuditLogResponse, err error) {
+	var response quobyte.GetAuditLogResponse
+	if err = fake.call(ctx, quobyte.MethodGetAuditLog, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetCertificateSubject(request *quobyte.GetCertificateSubjectRequest) (result *quobyte.GetCertificateSubjectResponse, err error) {
+	return fake.GetCertificateSubjectContext(context.Background(), request)
+}
+
+func (fake *Fake) GetCertificateSubjectContext(ctx context.Context, request *quobyte.GetCertificateSubjectRequest) (result *quobyte.GetCertificateSubjectResponse, err error) {
+	var response quobyte.GetCertificateSubjectResponse
+	if err = fake.call(ctx, quobyte.MethodGetCertificateSubject, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetClientList(request *quobyte.GetClientListRequest) (result *quobyte.GetClientListResponse, err error) {
+	return fake.GetClientListContext(context.Background(), request)
+}
+
+func (fake *Fake) GetClientListContext(ctx context.Context, request *quobyte.GetClientListRequest) (result *quobyte.GetClientListResponse, err error) {
+	var response quobyte.GetClientListResponse
+	if err = fake.call(ctx, quobyte.MethodGetClientList, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetConfiguration(request *quobyte.GetConfigurationRequest) (result *quobyte.GetConfigurationResponse, err error) {
+	return fake.GetConfigurationContext(context.Background(), request)
+}
+
+func (fake *Fake) GetConfigurationContext(ctx context.Context, request *quobyte.GetConfigurationRequest) (result *quobyte.GetConfigurationResponse, err error) {
+	var response quobyte.GetConfigurationResponse
+	if err = fake.call(ctx, quobyte.MethodGetConfiguration, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetDefaultKeyStoreSlotParams(request *quobyte.GetDefaultKeyStoreSlotParamsRequest) (result *quobyte.GetDefaultKeyStoreSlotParamsResponse, err error) {
+	return fake.GetDefaultKeyStoreSlotParamsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetDefaultKeyStoreSlotParamsContext(ctx context.Context, request *quobyte.GetDefaultKeyStoreSlotParamsRequest) (result *quobyte.GetDefaultKeyStoreSlotParamsResponse, err error) {
+	var response quobyte.GetDefaultKeyStoreSlotParamsResponse
+	if err = fake.call(ctx, quobyte.MethodGetDefaultKeyStoreSlotParams, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetDeviceGroups(request *quobyte.GetDeviceGroupsRequest) (result *quobyte.GetDeviceGroupsResponse, err error) {
+	return fake.GetDeviceGroupsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetDeviceGroupsContext(ctx context.Context, request *quobyte.GetDeviceGroupsRequest) (result *quobyte.GetDeviceGroupsResponse, err error) {
+	var response quobyte.GetDeviceGroupsResponse
+	if err = fake.call(ctx, quobyte.MethodGetDeviceGroups, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetDeviceIds(request *quobyte.GetDeviceIdsRequest) (result *quobyte.GetDeviceIdsResponse, err error) {
+	return fake.GetDeviceIdsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetDeviceIdsContext(ctx context.Context, request *quobyte.GetDeviceIdsRequest) (result *quobyte.GetDeviceIdsResponse, err error) {
+	var response quobyte.GetDeviceIdsResponse
+	if err = fake.call(ctx, quobyte.MethodGetDeviceIds, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetDeviceList(request *quobyte.GetDeviceListRequest) (result *quobyte.GetDeviceListResponse, err error) {
+	return fake.GetDeviceListContext(context.Background(), request)
+}
+
+func (fake *Fake) GetDeviceListContext(ctx context.Context, request *quobyte.GetDeviceListRequest) (result *quobyte.GetDeviceListResponse, err error) {
+	var response quobyte.GetDeviceListResponse
+	if err = fake.call(ctx, quobyte.MethodGetDeviceList, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetDeviceNetworkEndpoints(request *quobyte.GetDeviceNetworkEndpointsRequest) (result *quobyte.GetDeviceNetworkEndpointsResponse, err error) {
+	return fake.GetDeviceNetworkEndpointsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetDeviceNetworkEndpointsContext(ctx context.Context, request *quobyte.GetDeviceNetworkEndpointsRequest) (result *quobyte.GetDeviceNetworkEndpointsResponse, err error) {
+	var response quobyte.GetDeviceNetworkEndpointsResponse
+	if err = fake.call(ctx, quobyte.MethodGetDeviceNetworkEndpoints, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetDeviceTags(request *quobyte.GetDeviceTagsRequest) (result *quobyte.GetDeviceTagsResponse, err error) {
+	return fake.GetDeviceTagsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetDeviceTagsContext(ctx context.Context, request *quobyte.GetDeviceTagsRequest) (result *quobyte.GetDeviceTagsResponse, err error) {
+	var response quobyte.GetDeviceTagsResponse
+	if err = fake.call(ctx, quobyte.MethodGetDeviceTags, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetEffectiveVolumeConfiguration(request *quobyte.GetEffectiveVolumeConfigurationRequest) (result *quobyte.GetEffectiveVolumeConfigurationResponse, err error) {
+	return fake.GetEffectiveVolumeConfigurationContext(context.Background(), request)
+}
+
+func (fake *Fake) GetEffectiveVolumeConfigurationContext(ctx context.Context, request *quobyte.GetEffectiveVolumeConfigurationRequest) (result *quobyte.GetEffectiveVolumeConfigurationResponse, err error) {
+	var response quobyte.GetEffectiveVolumeConfigurationResponse
+	if err = fake.call(ctx, quobyte.MethodGetEffectiveVolumeConfiguration, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetEncryptStatus(request *quobyte.GetEncryptStatusRequest) (result *quobyte.GetEncryptStatusResponse, err error) {
+	return fake.GetEncryptStatusContext(context.Background(), request)
+}
+
+func (fake *Fake) GetEncryptStatusContext(ctx context.Context, request *quobyte.GetEncryptStatusRequest) (result *quobyte.GetEncryptStatusResponse, err error) {
+	var response quobyte.GetEncryptStatusResponse
+	if err = fake.call(ctx, quobyte.MethodGetEncryptStatus, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetEncryptedVolumeKey(request *quobyte.GetEncryptedVolumeKeyRequest) (result *quobyte.GetEncryptedVolumeKeyResponse, err error) {
+	return fake.GetEncryptedVolumeKeyContext(context.Background(), request)
+}
+
+func (fake *Fake) GetEncryptedVolumeKeyContext(ctx context.Context, request *quobyte.GetEncryptedVolumeKeyRequest) (result *quobyte.GetEncryptedVolumeKeyResponse, err error) {
+	var response quobyte.GetEncryptedVolumeKeyResponse
+	if err = fake.call(ctx, quobyte.MethodGetEncryptedVolumeKey, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetFileMetadataDump(request *quobyte.GetFileMetadataDumpRequest) (result *quobyte.GetFileMetadataDumpResponse, err error) {
+	return fake.GetFileMetadataDumpContext(context.Background(), request)
+}
+
+func (fake *Fake) GetFileMetadataDumpContext(ctx context.Context, request *quobyte.GetFileMetadataDumpRequest) (result *quobyte.GetFileMetadataDumpResponse, err error) {
+	var response quobyte.GetFileMetadataDumpResponse
+	if err = fake.call(ctx, quobyte.MethodGetFileMetadataDump, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetFiringRules(request *quobyte.GetFiringRulesRequest) (result *quobyte.GetFiringRulesResponse, err error) {
+	return fake.GetFiringRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) GetFiringRulesContext(ctx context.Context, request *quobyte.GetFiringRulesRequest) (result *quobyte.GetFiringRulesResponse, err error) {
+	var response quobyte.GetFiringRulesResponse
+	if err = fake.call(ctx, quobyte.MethodGetFiringRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetHealthManagerStatus(request *quobyte.GetHealthManagerStatusRequest) (result *quobyte.GetHealthManagerStatusResponse, err error) {
+	return fake.GetHealthManagerStatusContext(context.Background(), request)
+}
+
+func (fake *Fake) GetHealthManagerStatusContext(ctx context.Context, request *quobyte.GetHealthManagerStatusRequest) (result *quobyte.GetHealthManagerStatusResponse, err error) {
+	var response quobyte.GetHealthManagerStatusResponse
+	if err = fake.call(ctx, quobyte.MethodGetHealthManagerStatus, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetInformation(request *quobyte.GetInformationRequest) (result *quobyte.GetInformationResponse, err error) {
+	return fake.GetInformationContext(context.Background(), request)
+}
+
+func (fake *Fake) GetInformationContext(ctx context.Context, request *quobyte.GetInformationRequest) (result *quobyte.GetInformationResponse, err error) {
+	var response quobyte.GetInformationResponse
+	if err = fake.call(ctx, quobyte.MethodGetInformation, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetKeyStoreSlotWithoutHash(request *quobyte.GetKeyStoreSlotWithoutHashRequest) (result *quobyte.GetKeyStoreSlotWithoutHashResponse, err error) {
+	return fake.GetKeyStoreSlotWithoutHashContext(context.Background(), request)
+}
+
+func (fake *Fake) GetKeyStoreSlotWithoutHashContext(ctx context.Context, request *quobyte.GetKeyStoreSlotWithoutHashRequest) (result *quobyte.GetKeyStoreSlotWithoutHashResponse, err error) {
+	var response quobyte.GetKeyStoreSlotWithoutHashResponse
+	if err = fake.call(ctx, quobyte.MethodGetKeyStoreSlotWithoutHash, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetLabels(request *quobyte.GetLabelsRequest) (result *quobyte.GetLabelsResponse, err error) {
+	return fake.GetLabelsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetLabelsContext(ctx context.Context, request *quobyte.GetLabelsRequest) (result *quobyte.GetLabelsResponse, err error) {
+	var response quobyte.GetLabelsResponse
+	if err = fake.call(ctx, quobyte.MethodGetLabels, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetLatestEvent(request *quobyte.GetLatestEventRequest) (result *quobyte.GetLatestEventResponse, err error) {
+	return fake.GetLatestEventContext(context.Background(), request)
+}
+
+func (fake *Fake) GetLatestEventContext(ctx context.Context, request *quobyte.GetLatestEventRequest) (result *quobyte.GetLatestEventResponse, err error) {
+	var response quobyte.GetLatestEventResponse
+	if err = fake.call(ctx, quobyte.MethodGetLatestEvent, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetLicense(request *quobyte.GetLicenseRequest) (result *quobyte.GetLicenseResponse, err error) {
+	return fake.GetLicenseContext(context.Background(), request)
+}
+
+func (fake *Fake) GetLicenseContext(ctx context.Context, request *quobyte.GetLicenseRequest) (result *quobyte.GetLicenseResponse, err error) {
+	var response quobyte.GetLicenseResponse
+	if err = fake.call(ctx, quobyte.MethodGetLicense, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetMasterKeystoreSlots(request *quobyte.GetMasterKeystoreSlotsRequest) (result *quobyte.GetMasterKeystoreSlotsResponse, err error) {
+	return fake.GetMasterKeystoreSlotsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetMasterKeystoreSlotsContext(ctx context.Context, request *quobyte.GetMasterKeystoreSlotsRequest) (result *quobyte.GetMasterKeystoreSlotsResponse, err error) {
+	var response quobyte.GetMasterKeystoreSlotsResponse
+	if err = fake.call(ctx, quobyte.MethodGetMasterKeystoreSlots, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetNetworkTestResult(request *quobyte.GetNetworkTestResultRequest) (result *quobyte.GetNetworkTestResultResponse, err error) {
+	return fake.GetNetworkTestResultContext(context.Background(), request)
+}
+
+func (fake *Fake) GetNetworkTestResultContext(ctx context.Context, request *quobyte.GetNetworkTestResultRequest) (result *quobyte.GetNetworkTestResultResponse, err error) {
+	var response quobyte.GetNetworkTestResultResponse
+	if err = fake.call(ctx, quobyte.MethodGetNetworkTestResult, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetNotificationRules(request *quobyte.GetNotificationRulesRequest) (result *quobyte.GetNotificationRulesResponse, err error) {
+	return fake.GetNotificationRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) GetNotificationRulesContext(ctx context.Context, request *quobyte.GetNotificationRulesRequest) (result *quobyte.GetNotificationRulesResponse, err error) {
+	var response quobyte.GetNotificationRulesResponse
+	if err = fake.call(ctx, quobyte.MethodGetNotificationRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetPolicyPresets(request *quobyte.GetPolicyPresetsRequest) (result *quobyte.GetPolicyPresetsResponse, err error) {
+	return fake.GetPolicyPresetsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetPolicyPresetsContext(ctx context.Context, request *quobyte.GetPolicyPresetsRequest) (result *quobyte.GetPolicyPresetsResponse, err error) {
+	var response quobyte.GetPolicyPresetsResponse
+	if err = fake.call(ctx, quobyte.MethodGetPolicyPresets, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetPolicyRuleSets(request *quobyte.GetPolicyRuleSetsRequest) (result *quobyte.GetPolicyRuleSetsResponse, err error) {
+	return fake.GetPolicyRuleSetsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetPolicyRuleSetsContext(ctx context.Context, request *quobyte.GetPolicyRuleSetsRequest) (result *quobyte.GetPolicyRuleSetsResponse, err error) {
+	var response quobyte.GetPolicyRuleSetsResponse
+	if err = fake.call(ctx, quobyte.MethodGetPolicyRuleSets, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetPolicyRules(request *quobyte.GetPolicyRulesRequest) (result *quobyte.GetPolicyRulesResponse, err error) {
+	return fake.GetPolicyRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) GetPolicyRulesContext(ctx context.Context, request *quobyte.GetPolicyRulesRequest) (result *quobyte.GetPolicyRulesResponse, err error) {
+	var response quobyte.GetPolicyRulesResponse
+	if err = fake.call(ctx, quobyte.MethodGetPolicyRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetQueryProgress(request *quobyte.GetQueryProgressRequest) (result *quobyte.GetQueryProgressResponse, err error) {
+	return fake.GetQueryProgressContext(context.Background(), request)
+}
+
+func (fake *Fake) GetQueryProgressContext(ctx context.Context, request *quobyte.GetQueryProgressRequest) (result *quobyte.GetQueryProgressResponse, err error) {
+	var response quobyte.GetQueryProgressResponse
+	if err = fake.call(ctx, quobyte.MethodGetQueryProgress, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetQuota(request *quobyte.GetQuotaRequest) (result *quobyte.GetQuotaResponse, err error) {
+	return fake.GetQuotaContext(context.Background(), request)
+}
+
+func (fake *Fake) GetQuotaContext(ctx context.Context, request *quobyte.GetQuotaRequest) (result *quobyte.GetQuotaResponse, err error) {
+	var response quobyte.GetQuotaResponse
+	if err = fake.call(ctx, quobyte.MethodGetQuota, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetRules(request *quobyte.GetRulesRequest) (result *quobyte.GetRulesResponse, err error) {
+	return fake.GetRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) GetRulesContext(ctx context.Context, request *quobyte.GetRulesRequest) (result *quobyte.GetRulesResponse, err error) {
+	var response quobyte.GetRulesResponse
+	if err = fake.call(ctx, quobyte.MethodGetRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetServiceDump(request *quobyte.GetServiceDumpRequest) (result *quobyte.GetServiceDumpResponse, err error) {
+	return fake.GetServiceDumpContext(context.Background(), request)
+}
+
+func (fake *Fake) GetServiceDumpContext(ctx context.Context, request *quobyte.GetServiceDumpRequest) (result *quobyte.GetServiceDumpResponse, err error) {
+	var response quobyte.GetServiceDumpResponse
+	if err = fake.call(ctx, quobyte.MethodGetServiceDump, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetServices(request *quobyte.GetServicesRequest) (result *quobyte.GetServicesResponse, err error) {
+	return fake.GetServicesContext(context.Background(), request)
+}
+
+func (fake *Fake) GetServicesContext(ctx context.Context, request *quobyte.GetServicesRequest) (result *quobyte.GetServicesResponse, err error) {
+	var response quobyte.GetServicesResponse
+	if err = fake.call(ctx, quobyte.MethodGetServices, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetSupportDump(request *quobyte.GetSupportDumpRequest) (result *quobyte.GetSupportDumpResponse, err error) {
+	return fake.GetSupportDumpContext(context.Background(), request)
+}
+
+func (fake *Fake) GetSupportDumpContext(ctx context.Context, request *quobyte.GetSupportDumpRequest) (result *quobyte.GetSupportDumpResponse, err error) {
+	var response quobyte.GetSupportDumpResponse
+	if err = fake.call(ctx, quobyte.MethodGetSupportDump, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetSupportDumpStatus(request *quobyte.GetSupportDumpStatusRequest) (result *quobyte.GetSupportDumpStatusResponse, err error) {
+	return fake.GetSupportDumpStatusContext(context.Background(), request)
+}
+
+func (fake *Fake) GetSupportDumpStatusContext(ctx context.Context, request *quobyte.GetSupportDumpStatusRequest) (result *quobyte.GetSupportDumpStatusResponse, err error) {
+	var response quobyte.GetSupportDumpStatusResponse
+	if err = fake.call(ctx, quobyte.MethodGetSupportDumpStatus, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetSystemStatistics(request *quobyte.GetSystemStatisticsRequest) (result *quobyte.GetSystemStatisticsResponse, err error) {
+	return fake.GetSystemStatisticsContext(context.Background(), request)
+}
+
+func (fake *Fake) GetSystemStatisticsContext(ctx context.Context, request *quobyte.GetSystemStatisticsRequest) (result *quobyte.GetSystemStatisticsResponse, err error) {
+	var response quobyte.GetSystemStatisticsResponse
+	if err = fake.call(ctx, quobyte.MethodGetSystemStatistics, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetTaskList(request *quobyte.GetTaskListRequest) (result *quobyte.GetTaskListResponse, err error) {
+	return fake.GetTaskListContext(context.Background(), request)
+}
+
+func (fake *Fake) GetTaskListContext(ctx context.Context, request *quobyte.GetTaskListRequest) (result *quobyte.GetTaskListResponse, err error) {
+	var response quobyte.GetTaskListResponse
+	if err = fake.call(ctx, quobyte.MethodGetTaskList, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetTenant(request *quobyte.GetTenantRequest) (result *quobyte.GetTenantResponse, err error) {
+	return fake.GetTenantContext(context.Background(), request)
+}
+
+func (fake *Fake) GetTenantContext(ctx context.Context, request *quobyte.GetTenantRequest) (result *quobyte.GetTenantResponse, err error) {
+	var response quobyte.GetTenantResponse
+	if err = fake.call(ctx, quobyte.MethodGetTenant, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetTopCapacityConsumer(request *quobyte.GetTopCapacityConsumerRequest) (result *quobyte.GetTopCapacityConsumerResponse, err error) {
+	return fake.GetTopCapacityConsumerContext(context.Background(), request)
+}
+
+func (fake *Fake) GetTopCapacityConsumerContext(ctx context.Context, request *quobyte.GetTopCapacityConsumerRequest) (result *quobyte.GetTopCapacityConsumerResponse, err error) {
+	var response quobyte.GetTopCapacityConsumerResponse
+	if err = fake.call(ctx, quobyte.MethodGetTopCapacityConsumer, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetUnformattedDevices(request *quobyte.GetUnformattedDevicesRequest) (result *quobyte.GetUnformattedDevicesResponse, err error) {
+	return fake.GetUnformattedDevicesContext(context.Background(), request)
+}
+
+func (fake *Fake) GetUnformattedDevicesContext(ctx context.Context, request *quobyte.GetUnformattedDevicesRequest) (result *quobyte.GetUnformattedDevicesResponse, err error) {
+	var response quobyte.GetUnformattedDevicesResponse
+	if err = fake.call(ctx, quobyte.MethodGetUnformattedDevices, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetUsers(request *quobyte.GetUsersRequest) (result *quobyte.GetUsersResponse, err error) {
+	return fake.GetUsersContext(context.Background(), request)
+}
+
+func (fake *Fake) GetUsersContext(ctx context.Context, request *quobyte.GetUsersRequest) (result *quobyte.GetUsersResponse, err error) {
+	var response quobyte.GetUsersResponse
+	if err = fake.call(ctx, quobyte.MethodGetUsers, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) GetVolumeList(request *quobyte.GetVolumeListRequest) (result *quobyte.GetVolumeListResponse, err error) {
+	return fake.GetVolumeListContext(context.Background(), request)
+}
+
+func (fake *Fake) GetVolumeListContext(ctx context.Context, request *quobyte.GetVolumeListRequest) (result *quobyte.GetVolumeListResponse, err error) {
+	var response quobyte.GetVolumeListResponse
+	if err = fake.call(ctx, quobyte.MethodGetVolumeList, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ImportAccessKeys(request *quobyte.ImportAccessKeysRequest) (result *quobyte.ImportAccessKeysResponse, err error) {
+	return fake.ImportAccessKeysContext(context.Background(), request)
+}
+
+func (fake *Fake) ImportAccessKeysContext(ctx context.Context, request *quobyte.ImportAccessKeysRequest) (result *quobyte.ImportAccessKeysResponse, err error) {
+	var response quobyte.ImportAccessKeysResponse
+	if err = fake.call(ctx, quobyte.MethodImportAccessKeys, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ImportConfiguration(request *quobyte.ImportConfigurationRequest) (result *quobyte.ImportConfigurationResponse, err error) {
+	return fake.ImportConfigurationContext(context.Background(), request)
+}
+
+func (fake *Fake) ImportConfigurationContext(ctx context.Context, request *quobyte.ImportConfigurationRequest) (result *quobyte.ImportConfigurationResponse, err error) {
+	var response quobyte.ImportConfigurationResponse
+	if err = fake.call(ctx, quobyte.MethodImportConfiguration, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ImportPolicyRules(request *quobyte.ImportPolicyRulesRequest) (result *quobyte.ImportPolicyRulesResponse, err error) {
+	return fake.ImportPolicyRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) ImportPolicyRulesContext(ctx context.Context, request *quobyte.ImportPolicyRulesRequest) (result *quobyte.ImportPolicyRulesResponse, err error) {
+	var response quobyte.ImportPolicyRulesResponse
+	if err = fake.call(ctx, quobyte.MethodImportPolicyRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ListCa(request *quobyte.ListCaRequest) (result *quobyte.ListCaResponse, err error) {
+	return fake.ListCaContext(context.Background(), request)
+}
+
+func (fake *Fake) ListCaContext(ctx context.Context, request *quobyte.ListCaRequest) (result *quobyte.ListCaResponse, err error) {
+	var response quobyte.ListCaResponse
+	if err = fake.call(ctx, quobyte.MethodListCa, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ListCertificates(request *quobyte.ListCertificatesRequest) (result *quobyte.ListCertificatesResponse, err error) {
+	return fake.ListCertificatesContext(context.Background(), request)
+}
+
+func (fake *Fake) ListCertificatesContext(ctx context.Context, request *quobyte.ListCertificatesRequest) (result *quobyte.ListCertificatesResponse, err error) {
+	var response quobyte.ListCertificatesResponse
+	if err = fake.call(ctx, quobyte.MethodListCertificates, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ListCsr(request *quobyte.ListCsrRequest) (result *quobyte.ListCsrResponse, err error) {
+	return fake.ListCsrContext(context.Background(), request)
+}
+
+func (fake *Fake) ListCsrContext(ctx context.Context, request *quobyte.ListCsrRequest) (result *quobyte.ListCsrResponse, err error) {
+	var response quobyte.ListCsrResponse
+	if err = fake.call(ctx, quobyte.MethodListCsr, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ListRegistryReplicas(request *quobyte.ListRegistryReplicasRequest) (result *quobyte.ListRegistryReplicasResponse, err error) {
+	return fake.ListRegistryReplicasContext(context.Background(), request)
+}
+
+func (fake *Fake) ListRegistryReplicasContext(ctx context.Context, request *quobyte.ListRegistryReplicasRequest) (result *quobyte.ListRegistryReplicasResponse, err error) {
+	var response quobyte.ListRegistryReplicasResponse
+	if err = fake.call(ctx, quobyte.MethodListRegistryReplicas, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ListSnapshots(request *quobyte.ListSnapshotsRequest) (result *quobyte.ListSnapshotsResponse, err error) {
+	return fake.ListSnapshotsContext(context.Background(), request)
+}
+
+func (fake *Fake) ListSnapshotsContext(ctx context.Context, request *quobyte.ListSnapshotsRequest) (result *quobyte.ListSnapshotsResponse, err error) {
+	var response quobyte.ListSnapshotsResponse
+	if err = fake.call(ctx, quobyte.MethodListSnapshots, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) MakeDevice(request *quobyte.MakeDeviceRequest) (result *quobyte.MakeDeviceResponse, err error) {
+	return fake.MakeDeviceContext(context.Background(), request)
+}
+
+func (fake *Fake) MakeDeviceContext(ctx context.Context, request *quobyte.MakeDeviceRequest) (result *quobyte.MakeDeviceResponse, err error) {
+	var response quobyte.MakeDeviceResponse
+	if err = fake.call(ctx, quobyte.MethodMakeDevice, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) PublishBucketVolume(request *quobyte.PublishBucketVolumeRequest) (result *quobyte.PublishBucketVolumeResponse, err error) {
+	return fake.PublishBucketVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) PublishBucketVolumeContext(ctx context.Context, request *quobyte.PublishBucketVolumeRequest) (result *quobyte.PublishBucketVolumeResponse, err error) {
+	var response quobyte.PublishBucketVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodPublishBucketVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) QueryFiles(request *quobyte.QueryFilesRequest) (result *quobyte.QueryFilesResponse, err error) {
+	return fake.QueryFilesContext(context.Background(), request)
+}
+
+func (fake *Fake) QueryFilesContext(ctx context.Context, request *quobyte.QueryFilesRequest) (result *quobyte.QueryFilesResponse, err error) {
+	var response quobyte.QueryFilesResponse
+	if err = fake.call(ctx, quobyte.MethodQueryFiles, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) RegenerateDatabase(request *quobyte.RegenerateDatabaseRequest) (result *quobyte.RegenerateDatabaseResponse, err error) {
+	return fake.RegenerateDatabaseContext(context.Background(), request)
+}
+
+func (fake *Fake) RegenerateDatabaseContext(ctx context.Context, request *quobyte.RegenerateDatabaseRequest) (result *quobyte.RegenerateDatabaseResponse, err error) {
+	var response quobyte.RegenerateDatabaseResponse
+	if err = fake.call(ctx, quobyte.MethodRegenerateDatabase, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) RemoveKeystoreSlot(request *quobyte.RemoveKeystoreSlotRequest) (result *quobyte.RemoveKeystoreSlotResponse, err error) {
+	return fake.RemoveKeystoreSlotContext(context.Background(), request)
+}
+
+func (fake *Fake) RemoveKeystoreSlotContext(ctx context.Context, request *quobyte.RemoveKeystoreSlotRequest) (result *quobyte.RemoveKeystoreSlotResponse, err error) {
+	var response quobyte.RemoveKeystoreSlotResponse
+	if err = fake.call(ctx, quobyte.MethodRemoveKeystoreSlot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) RemoveMasterKeystoreSlot(request *quobyte.RemoveMasterKeystoreSlotRequest) (result *quobyte.RemoveMasterKeystoreSlotResponse, err error) {
+	return fake.RemoveMasterKeystoreSlotContext(context.Background(), request)
+}
+
+func (fake *Fake) RemoveMasterKeystoreSlotContext(ctx context.Context, request *quobyte.RemoveMasterKeystoreSlotRequest) (result *quobyte.RemoveMasterKeystoreSlotResponse, err error) {
+	var response quobyte.RemoveMasterKeystoreSlotResponse
+	if err = fake.call(ctx, quobyte.MethodRemoveMasterKeystoreSlot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) RemoveRegistryReplica(request *quobyte.RemoveRegistryReplicaRequest) (result *quobyte.RemoveRegistryReplicaResponse, err error) {
+	return fake.RemoveRegistryReplicaContext(context.Background(), request)
+}
+
+func (fake *Fake) RemoveRegistryReplicaContext(ctx context.Context, request *quobyte.RemoveRegistryReplicaRequest) (result *quobyte.RemoveRegistryReplicaResponse, err error) {
+	var response quobyte.RemoveRegistryReplicaResponse
+	if err = fake.call(ctx, quobyte.MethodRemoveRegistryReplica, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ResolveGlobalFileId(request *quobyte.ResolveGlobalFileIdRequest) (result *quobyte.ResolveGlobalFileIdResponse, err error) {
+	return fake.ResolveGlobalFileIdContext(context.Background(), request)
+}
+
+func (fake *Fake) ResolveGlobalFileIdContext(ctx context.Context, request *quobyte.ResolveGlobalFileIdRequest) (result *quobyte.ResolveGlobalFileIdResponse, err error) {
+	var response quobyte.ResolveGlobalFileIdResponse
+	if err = fake.call(ctx, quobyte.MethodResolveGlobalFileId, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ResolvePolicyRuleName(request *quobyte.ResolvePolicyRuleNameRequest) (result *quobyte.ResolvePolicyRuleNameResponse, err error) {
+	return fake.ResolvePolicyRuleNameContext(context.Background(), request)
+}
+
+func (fake *Fake) ResolvePolicyRuleNameContext(ctx context.Context, request *quobyte.ResolvePolicyRuleNameRequest) (result *quobyte.ResolvePolicyRuleNameResponse, err error) {
+	var response quobyte.ResolvePolicyRuleNameResponse
+	if err = fake.call(ctx, quobyte.MethodResolvePolicyRuleName, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ResolveTenantName(request *quobyte.ResolveTenantNameRequest) (result *quobyte.ResolveTenantNameResponse, err error) {
+	return fake.ResolveTenantNameContext(context.Background(), request)
+}
+
+func (fake *Fake) ResolveTenantNameContext(ctx context.Context, request *quobyte.ResolveTenantNameRequest) (result *quobyte.ResolveTenantNameResponse, err error) {
+	var response quobyte.ResolveTenantNameResponse
+	if err = fake.call(ctx, quobyte.MethodResolveTenantName, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ResolveVolumeName(request *quobyte.ResolveVolumeNameRequest) (result *quobyte.ResolveVolumeNameResponse, err error) {
+	return fake.ResolveVolumeNameContext(context.Background(), request)
+}
+
+func (fake *Fake) ResolveVolumeNameContext(ctx context.Context, request *quobyte.ResolveVolumeNameRequest) (result *quobyte.ResolveVolumeNameResponse, err error) {
+	var response quobyte.ResolveVolumeNameResponse
+	if err = fake.call(ctx, quobyte.MethodResolveVolumeName, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) ResumeTask(request *quobyte.ResumeTaskRequest) (result *quobyte.ResumeTaskResponse, err error) {
+	return fake.ResumeTaskContext(context.Background(), request)
+}
+
+func (fake *Fake) ResumeTaskContext(ctx context.Context, request *quobyte.ResumeTaskRequest) (result *quobyte.ResumeTaskResponse, err error) {
+	var response quobyte.ResumeTaskResponse
+	if err = fake.call(ctx, quobyte.MethodResumeTask, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) RetryTask(request *quobyte.RetryTaskRequest) (result *quobyte.RetryTaskResponse, err error) {
+	return fake.RetryTaskContext(context.Background(), request)
+}
+
+func (fake *Fake) RetryTaskContext(ctx context.Context, request *quobyte.RetryTaskRequest) (result *quobyte.RetryTaskResponse, err error) {
+	var response quobyte.RetryTaskResponse
+	if err = fake.call(ctx, quobyte.MethodRetryTask, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) RevokeCertificate(request *quobyte.RevokeCertificateRequest) (result *quobyte.RevokeCertificateResponse, err error) {
+	return fake.RevokeCertificateContext(context.Background(), request)
+}
+
+func (fake *Fake) RevokeCertificateContext(ctx context.Context, request *quobyte.RevokeCertificateRequest) (result *quobyte.RevokeCertificateResponse, err error) {
+	var response quobyte.RevokeCertificateResponse
+	if err = fake.call(ctx, quobyte.MethodRevokeCertificate, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetCertificateOwner(request *quobyte.SetCertificateOwnerRequest) (result *quobyte.SetCertificateOwnerResponse, err error) {
+	return fake.SetCertificateOwnerContext(context.Background(), request)
+}
+
+func (fake *Fake) SetCertificateOwnerContext(ctx context.Context, request *quobyte.SetCertificateOwnerRequest) (result *quobyte.SetCertificateOwnerResponse, err error) {
+	var response quobyte.SetCertificateOwnerResponse
+	if err = fake.call(ctx, quobyte.MethodSetCertificateOwner, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetCertificateSubject(request *quobyte.SetCertificateSubjectRequest) (result *quobyte.SetCertificateSubjectResponse, err error) {
+	return fake.SetCertificateSubjectContext(context.Background(), request)
+}
+
+func (fake *Fake) SetCertificateSubjectContext(ctx context.Context, request *quobyte.SetCertificateSubjectRequest) (result *quobyte.SetCertificateSubjectResponse, err error) {
+	var response quobyte.SetCertificateSubjectResponse
+	if err = fake.call(ctx, quobyte.MethodSetCertificateSubject, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetConfiguration(request *quobyte.SetConfigurationRequest) (result *quobyte.SetConfigurationResponse, err error) {
+	return fake.SetConfigurationContext(context.Background(), request)
+}
+
+func (fake *Fake) SetConfigurationContext(ctx context.Context, request *quobyte.SetConfigurationRequest) (result *quobyte.SetConfigurationResponse, err error) {
+	var response quobyte.SetConfigurationResponse
+	if err = fake.call(ctx, quobyte.MethodSetConfiguration, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetEncryptedVolumeKey(request *quobyte.SetEncryptedVolumeKeyRequest) (result *quobyte.SetEncryptedVolumeKeyResponse, err error) {
+	return fake.SetEncryptedVolumeKeyContext(context.Background(), request)
+}
+
+func (fake *Fake) SetEncryptedVolumeKeyContext(ctx context.Context, request *quobyte.SetEncryptedVolumeKeyRequest) (result *quobyte.SetEncryptedVolumeKeyResponse, err error) {
+	var response quobyte.SetEncryptedVolumeKeyResponse
+	if err = fake.call(ctx, quobyte.MethodSetEncryptedVolumeKey, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetLabels(request *quobyte.SetLabelsRequest) (result *quobyte.SetLabelsResponse, err error) {
+	return fake.SetLabelsContext(context.Background(), request)
+}
+
+func (fake *Fake) SetLabelsContext(ctx context.Context, request *quobyte.SetLabelsRequest) (result *quobyte.SetLabelsResponse, err error) {
+	var response quobyte.SetLabelsResponse
+	if err = fake.call(ctx, quobyte.MethodSetLabels, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetLicenseKey(request *quobyte.SetLicenseKeyRequest) (result *quobyte.SetLicenseKeyResponse, err error) {
+	return fake.SetLicenseKeyContext(context.Background(), request)
+}
+
+func (fake *Fake) SetLicenseKeyContext(ctx context.Context, request *quobyte.SetLicenseKeyRequest) (result *quobyte.SetLicenseKeyResponse, err error) {
+	var response quobyte.SetLicenseKeyResponse
+	if err = fake.call(ctx, quobyte.MethodSetLicenseKey, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetNotificationRule(request *quobyte.SetNotificationRuleRequest) (result *quobyte.SetNotificationRuleResponse, err error) {
+	return fake.SetNotificationRuleContext(context.Background(), request)
+}
+
+func (fake *Fake) SetNotificationRuleContext(ctx context.Context, request *quobyte.SetNotificationRuleRequest) (result *quobyte.SetNotificationRuleResponse, err error) {
+	var response quobyte.SetNotificationRuleResponse
+	if err = fake.call(ctx, quobyte.MethodSetNotificationRule, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetQuota(request *quobyte.SetQuotaRequest) (result *quobyte.SetQuotaResponse, err error) {
+	return fake.SetQuotaContext(context.Background(), request)
+}
+
+func (fake *Fake) SetQuotaContext(ctx context.Context, request *quobyte.SetQuotaRequest) (result *quobyte.SetQuotaResponse, err error) {
+	var response quobyte.SetQuotaResponse
+	if err = fake.call(ctx, quobyte.MethodSetQuota, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SetTenant(request *quobyte.SetTenantRequest) (result *quobyte.SetTenantResponse, err error) {
+	return fake.SetTenantContext(context.Background(), request)
+}
+
+func (fake *Fake) SetTenantContext(ctx context.Context, request *quobyte.SetTenantRequest) (result *quobyte.SetTenantResponse, err error) {
+	var response quobyte.SetTenantResponse
+	if err = fake.call(ctx, quobyte.MethodSetTenant, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) SilenceAlert(request *quobyte.SilenceAlertRequest) (result *quobyte.SilenceAlertResponse, err error) {
+	return fake.SilenceAlertContext(context.Background(), request)
+}
+
+func (fake *Fake) SilenceAlertContext(ctx context.Context, request *quobyte.SilenceAlertRequest) (result *quobyte.SilenceAlertResponse, err error) {
+	var response quobyte.SilenceAlertResponse
+	if err = fake.call(ctx, quobyte.MethodSilenceAlert, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) StartNetworkTest(request *quobyte.StartNetworkTestRequest) (result *quobyte.StartNetworkTestResponse, err error) {
+	return fake.StartNetworkTestContext(context.Background(), request)
+}
+
+func (fake *Fake) StartNetworkTestContext(ctx context.Context, request *quobyte.StartNetworkTestRequest) (result *quobyte.StartNetworkTestResponse, err error) {
+	var response quobyte.StartNetworkTestResponse
+	if err = fake.call(ctx, quobyte.MethodStartNetworkTest, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) TriggerVolumeCheckpoint(request *quobyte.TriggerVolumeCheckpointRequest) (result *quobyte.TriggerVolumeCheckpointResponse, err error) {
+	return fake.TriggerVolumeCheckpointContext(context.Background(), request)
+}
+
+func (fake *Fake) TriggerVolumeCheckpointContext(ctx context.Context, request *quobyte.TriggerVolumeCheckpointRequest) (result *quobyte.TriggerVolumeCheckpointResponse, err error) {
+	var response quobyte.TriggerVolumeCheckpointResponse
+	if err = fake.call(ctx, quobyte.MethodTriggerVolumeCheckpoint, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) UnlockMasterKeystoreSlot(request *quobyte.UnlockMasterKeystoreSlotRequest) (result *quobyte.UnlockMasterKeystoreSlotResponse, err error) {
+	return fake.UnlockMasterKeystoreSlotContext(context.Background(), request)
+}
+
+func (fake *Fake) UnlockMasterKeystoreSlotContext(ctx context.Context, request *quobyte.UnlockMasterKeystoreSlotRequest) (result *quobyte.UnlockMasterKeystoreSlotResponse, err error) {
+	var response quobyte.UnlockMasterKeystoreSlotResponse
+	if err = fake.call(ctx, quobyte.MethodUnlockMasterKeystoreSlot, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) UnpublishBucketVolume(request *quobyte.UnpublishBucketVolumeRequest) (result *quobyte.UnpublishBucketVolumeResponse, err error) {
+	return fake.UnpublishBucketVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) UnpublishBucketVolumeContext(ctx context.Context, request *quobyte.UnpublishBucketVolumeRequest) (result *quobyte.UnpublishBucketVolumeResponse, err error) {
+	var response quobyte.UnpublishBucketVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodUnpublishBucketVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) UpdateDevice(request *quobyte.UpdateDeviceRequest) (result *quobyte.UpdateDeviceResponse, err error) {
+	return fake.UpdateDeviceContext(context.Background(), request)
+}
+
+func (fake *Fake) UpdateDeviceContext(ctx context.Context, request *quobyte.UpdateDeviceRequest) (result *quobyte.UpdateDeviceResponse, err error) {
+	var response quobyte.UpdateDeviceResponse
+	if err = fake.call(ctx, quobyte.MethodUpdateDevice, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) UpdatePolicyRules(request *quobyte.UpdatePolicyRulesRequest) (result *quobyte.UpdatePolicyRulesResponse, err error) {
+	return fake.UpdatePolicyRulesContext(context.Background(), request)
+}
+
+func (fake *Fake) UpdatePolicyRulesContext(ctx context.Context, request *quobyte.UpdatePolicyRulesRequest) (result *quobyte.UpdatePolicyRulesResponse, err error) {
+	var response quobyte.UpdatePolicyRulesResponse
+	if err = fake.call(ctx, quobyte.MethodUpdatePolicyRules, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) UpdateUser(request *quobyte.UpdateUserRequest) (result *quobyte.UpdateUserResponse, err error) {
+	return fake.UpdateUserContext(context.Background(), request)
+}
+
+func (fake *Fake) UpdateUserContext(ctx context.Context, request *quobyte.UpdateUserRequest) (result *quobyte.UpdateUserResponse, err error) {
+	var response quobyte.UpdateUserResponse
+	if err = fake.call(ctx, quobyte.MethodUpdateUser, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) UpdateVolume(request *quobyte.UpdateVolumeRequest) (result *quobyte.UpdateVolumeResponse, err error) {
+	return fake.UpdateVolumeContext(context.Background(), request)
+}
+
+func (fake *Fake) UpdateVolumeContext(ctx context.Context, request *quobyte.UpdateVolumeRequest) (result *quobyte.UpdateVolumeResponse, err error) {
+	var response quobyte.UpdateVolumeResponse
+	if err = fake.call(ctx, quobyte.MethodUpdateVolume, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) VerifyLicense(request *quobyte.VerifyLicenseRequest) (result *quobyte.VerifyLicenseResponse, err error) {
+	return fake.VerifyLicenseContext(context.Background(), request)
+}
+
+func (fake *Fake) VerifyLicenseContext(ctx context.Context, request *quobyte.VerifyLicenseRequest) (result *quobyte.VerifyLicenseResponse, err error) {
+	var response quobyte.VerifyLicenseResponse
+	if err = fake.call(ctx, quobyte.MethodVerifyLicense, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (fake *Fake) WhoAmI(request *quobyte.WhoAmIRequest) (result *quobyte.WhoAmIResponse, err error) {
+	return fake.WhoAmIContext(context.Background(), request)
+}
+
+func (fake *Fake) WhoAmIContext(ctx context.Context, request *quobyte.WhoAmIRequest) (result *quobyte.WhoAmIResponse, err error) {
+	var response quobyte.WhoAmIResponse
+	if err = fake.call(ctx, quobyte.MethodWhoAmI, request, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
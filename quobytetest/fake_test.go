@@ -0,0 +1,84 @@
+package quobytetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	quobyte "github.com/quobyte/api/quobyte"
+)
+
+func TestFake(t *testing.T) {
+	fake := NewFake()
+	tenant, err := fake.SetTenant(&quobyte.SetTenantRequest{Tenant: quobyte.TenantDomainConfiguration{Name: "team"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created, err := fake.CreateVolume(&quobyte.CreateVolumeRequest{Name: "data", TenantId: tenant.TenantId})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if uuid, err := fake.GetVolumeUUID("data", "team"); err != nil || uuid != created.VolumeUuid {
+		t.Errorf("Unexpected volume: %s, %v", uuid, err)
+	}
+	if _, err := fake.ResolveVolumeNameToUUID("missing", tenant.TenantId); !errors.Is(err, quobyte.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	if err := fake.SetVolumeQuota(created.VolumeUuid, 100); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	quotas, err := fake.GetQuota(&quobyte.GetQuotaRequest{})
+	if err != nil || len(quotas.Quotas) != 1 || quotas.Quotas[0].Limits[0].Value != 100 {
+		t.Errorf("Unexpected quotas: %+v, %v", quotas, err)
+	}
+
+	if _, err := fake.EraseVolume(&quobyte.EraseVolumeRequest{VolumeUuid: created.VolumeUuid}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	volumes, err := fake.GetVolumeList(&quobyte.GetVolumeListRequest{})
+	if err != nil || len(volumes.Volume) != 1 || !volumes.Volume[0].ScheduledForDeletion {
+		t.Errorf("Expected volume scheduled for deletion: %+v, %v", volumes, err)
+	}
+	// returned values are copies
+	volumes.Volume[0].Name = "changed"
+	if uuid, err := fake.ResolveVolumeNameToUUID("data", tenant.TenantId); err != nil || uuid != created.VolumeUuid {
+		t.Errorf("Unexpected volume: %s, %v", uuid, err)
+	}
+
+	if _, err := fake.GetConfiguration(&quobyte.GetConfigurationRequest{}); !errors.Is(err, quobyte.ErrMethodNotFound) {
+		t.Errorf("Expected ErrMethodNotFound, got %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := fake.GetVolumeListContext(ctx, &quobyte.GetVolumeListRequest{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestFakeFaults(t *testing.T) {
+	fake := NewFake()
+	unavailable := errors.New("unavailable")
+	fake.InjectFault("setTenant", FailTimes(2, unavailable))
+	request := &quobyte.SetTenantRequest{Tenant: quobyte.TenantDomainConfiguration{Name: "team"}}
+	for i := 0; i < 2; i++ {
+		if _, err := fake.SetTenant(request); !errors.Is(err, unavailable) {
+			t.Fatalf("Expected injected error, got %v", err)
+		}
+	}
+	if _, err := fake.SetTenant(request); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls := fake.Calls("setTenant"); calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+
+	fake.InjectFault("resolveTenantName", FailWith(quobyte.ErrPermissionDenied))
+	if _, err := fake.GetTenantUUID("team"); !errors.Is(err, quobyte.ErrPermissionDenied) {
+		t.Errorf("Expected ErrPermissionDenied, got %v", err)
+	}
+	fake.ClearFaults()
+	if _, err := fake.GetTenantUUID("team"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
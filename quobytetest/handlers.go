@@ -0,0 +1,83 @@
+package quobytetest
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// handler decodes the params of a JSON-RPC method and calls the cluster.
+type handler struct {
+	newRequest func() interface{}
+	call       func(request interface{}) (interface{}, error)
+}
+
+func handle[Req, Resp any](fn func(*Req) (*Resp, error)) handler {
+	return handler{
+		newRequest: func() interface{} { return new(Req) },
+		call: func(request interface{}) (interface{}, error) {
+			response, err := fn(request.(*Req))
+			if err != nil {
+				return nil, err
+			}
+			return response, nil
+		},
+	}
+}
+
+// decode unmarshals the params of a call. Like the API service, it knows which fields were sent:
+// they are marked with SetExplicit, so that handlers can apply false and 0.
+func (h handler) decode(data []byte) (interface{}, error) {
+	request := h.newRequest()
+	if len(data) == 0 {
+		return request, nil
+	}
+	if err := json.Unmarshal(data, request); err != nil {
+		return nil, err
+	}
+	if explicit, ok := request.(interface{ SetExplicit(...string) }); ok {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		explicit.SetExplicit(names...)
+	}
+	return request, nil
+}
+
+// handlers returns the JSON-RPC methods implemented by the cluster.
+func (c *cluster) handlers() map[string]handler {
+	return map[string]handler{
+		"whoAmI":              handle(c.whoAmI),
+		"getLicense":          handle(c.getLicense),
+		"setTenant":           handle(c.setTenant),
+		"getTenant":           handle(c.getTenant),
+		"deleteTenant":        handle(c.deleteTenant),
+		"resolveTenantName":   handle(c.resolveTenantName),
+		"createVolume":        handle(c.createVolume),
+		"resolveVolumeName":   handle(c.resolveVolumeName),
+		"getVolumeList":       handle(c.getVolumeList),
+		"updateVolume":        handle(c.updateVolume),
+		"deleteVolume":        handle(c.deleteVolume),
+		"eraseVolume":         handle(c.eraseVolume),
+		"cancelVolumeErasure": handle(c.cancelVolumeErasure),
+		"setLabels":           handle(c.setLabels),
+		"getLabels":           handle(c.getLabels),
+		"deleteLabels":        handle(c.deleteLabels),
+		"setQuota":            handle(c.setQuota),
+		"getQuota":            handle(c.getQuota),
+		"createSnapshot":      handle(c.createSnapshot),
+		"listSnapshots":       handle(c.listSnapshots),
+		"deleteSnapshot":      handle(c.deleteSnapshot),
+		"eraseSnapshot":       handle(c.eraseSnapshot),
+		"createTask":          handle(c.createTask),
+		"getTaskList":         handle(c.getTaskList),
+		"cancelTask":          handle(c.cancelTask),
+		"getDeviceList":       handle(c.getDeviceList),
+		"updateDevice":        handle(c.updateDevice),
+	}
+}
@@ -0,0 +1,236 @@
+// Package quobytetest provides an in-memory Quobyte API server for tests of code that uses the
+// quobyte package.
+package quobytetest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	quobyte "github.com/quobyte/api/quobyte"
+)
+
+// SessionCookie is the name of the session cookie set by Server.
+const SessionCookie = "quobyte-session"
+
+// DefaultSessionLifetime is the lifetime of sessions of a new Server.
+const DefaultSessionLifetime = time.Hour
+
+// Server is a fake Quobyte API service speaking JSON-RPC 2.0 over HTTP. It keeps tenants,
+// volumes, labels, quotas, snapshots, tasks and devices in memory and authenticates like the
+// real service: requests with basic auth credentials create a session cookie, requests without
+// credentials need a valid session and are rejected with 401 otherwise.
+type Server struct {
+	*httptest.Server
+	username string
+	password string
+	cluster  *cluster
+	handlers map[string]handler
+
+	mu       sync.Mutex
+	lifetime time.Duration
+	sessions map[string]time.Time
+	logins   int
+}
+
+// NewServer starts a server that accepts the given credentials. Stop it with Close.
+func NewServer(username, password string) *Server {
+	server := &Server{
+		username: username,
+		password: password,
+		cluster:  newCluster(username),
+		lifetime: DefaultSessionLifetime,
+		sessions: map[string]time.Time{},
+	}
+	server.handlers = server.cluster.handlers()
+	server.Server = httptest.NewServer(server)
+	return server
+}
+
+// Client returns a client for the server that logs in with the credentials of the server.
+func (server *Server) Client() *quobyte.QuobyteClient {
+	client, err := quobyte.NewClient(server.URL, quobyte.WithCredentials(server.username, server.password))
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// SetSessionLifetime sets the lifetime of new sessions.
+func (server *Server) SetSessionLifetime(lifetime time.Duration) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.lifetime = lifetime
+}
+
+// ExpireSessions expires all sessions, the next requests of clients are rejected with 401.
+func (server *Server) ExpireSessions() {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.sessions = map[string]time.Time{}
+}
+
+// Logins returns the number of requests that authenticated with credentials.
+func (server *Server) Logins() int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.logins
+}
+
+// AddDevice adds a device to the cluster.
+func (server *Server) AddDevice(device *quobyte.Device) {
+	server.cluster.addDevice(device)
+}
+
+// SetVersion sets the product version reported by getLicense, e.g. to test the client against an
+// older release. It is empty by default, which clients treat as unknown version.
+func (server *Server) SetVersion(version string) {
+	server.cluster.setVersion(version)
+}
+
+// SetTaskState changes the state of a task, e.g. to finish it.
+func (server *Server) SetTaskState(taskID string, state quobyte.TaskState) error {
+	return server.cluster.setTaskState(taskID, state)
+}
+
+// authenticate checks the credentials or the session of the request.
+func (server *Server) authenticate(w http.ResponseWriter, req *http.Request) bool {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if username, password, ok := req.BasicAuth(); ok {
+		if username != server.username || password != server.password {
+			return false
+		}
+		var id [16]byte
+		if _, err := rand.Read(id[:]); err != nil {
+			panic(err)
+		}
+		session := hex.EncodeToString(id[:])
+		server.sessions[session] = time.Now().Add(server.lifetime)
+		server.logins++
+		http.SetCookie(w, &http.Cookie{Name: SessionCookie, Value: session, Path: "/", HttpOnly: true})
+		return true
+	}
+	cookie, err := req.Cookie(SessionCookie)
+	if err != nil {
+		return false
+	}
+	expires, ok := server.sessions[cookie.Value]
+	if !ok {
+		return false
+	}
+	if time.Now().After(expires) {
+		delete(server.sessions, cookie.Value)
+		return false
+	}
+	return true
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *responseError  `json:"error,omitempty"`
+}
+
+type responseError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+// ServeHTTP answers a JSON-RPC call or batch.
+func (server *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "Only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	if !server.authenticate(w, req) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	var body json.RawMessage
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		server.write(w, response{
+			JSONRPC: "2.0",
+			ID:      json.RawMessage("null"),
+			Error:   &responseError{Code: quobyte.ErrorCodeParseError, Message: err.Error()},
+		})
+		return
+	}
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		var calls []request
+		if err := json.Unmarshal(body, &calls); err != nil {
+			server.write(w, response{
+				JSONRPC: "2.0",
+				ID:      json.RawMessage("null"),
+				Error:   &responseError{Code: quobyte.ErrorCodeInvalidRequest, Message: err.Error()},
+			})
+			return
+		}
+		responses := make([]response, len(calls))
+		for i, call := range calls {
+			responses[i] = server.call(call)
+		}
+		server.write(w, responses)
+		return
+	}
+	var call request
+	if err := json.Unmarshal(body, &call); err != nil {
+		server.write(w, response{
+			JSONRPC: "2.0",
+			ID:      json.RawMessage("null"),
+			Error:   &responseError{Code: quobyte.ErrorCodeInvalidRequest, Message: err.Error()},
+		})
+		return
+	}
+	server.write(w, server.call(call))
+}
+
+// call executes a single JSON-RPC call.
+func (server *Server) call(call request) response {
+	resp := response{JSONRPC: "2.0", ID: call.ID}
+	if call.JSONRPC != "2.0" || call.Method == "" {
+		resp.Error = &responseError{Code: quobyte.ErrorCodeInvalidRequest, Message: "Invalid JSON-RPC 2.0 request"}
+		return resp
+	}
+	handler, ok := server.handlers[call.Method]
+	if !ok {
+		resp.Error = &responseError{Code: quobyte.ErrorCodeMethodNotFound, Message: "Method " + call.Method + " not found"}
+		return resp
+	}
+	params, err := handler.decode(call.Params)
+	if err != nil {
+		resp.Error = &responseError{Code: quobyte.ErrorCodeInvalidParams, Message: err.Error()}
+		return resp
+	}
+	result, err := handler.call(params)
+	if err != nil {
+		var rpcErr *quobyte.RPCError
+		if errors.As(err, &rpcErr) {
+			resp.Error = &responseError{Code: rpcErr.Code, Message: rpcErr.Message}
+		} else {
+			resp.Error = &responseError{Code: errorCodeFailed, Message: err.Error()}
+		}
+		return resp
+	}
+	resp.Result = result
+	return resp
+}
+
+func (server *Server) write(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,230 @@
+package quobytetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	quobyte "github.com/quobyte/api/quobyte"
+)
+
+func TestVolumeLifecycle(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+	client := server.Client()
+
+	tenant, err := client.SetTenant(&quobyte.SetTenantRequest{Tenant: quobyte.TenantDomainConfiguration{Name: "team"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !quobyte.IsValidUUID(tenant.TenantId) {
+		t.Errorf("Tenant id %q is no UUID", tenant.TenantId)
+	}
+	if tenantUUID, err := client.ResolveTenantNameToUUID("team"); err != nil || tenantUUID != tenant.TenantId {
+		t.Errorf("Unexpected tenant: %s, %v", tenantUUID, err)
+	}
+
+	created, err := client.CreateVolume(&quobyte.CreateVolumeRequest{Name: "data", TenantId: "team"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.CreateVolume(&quobyte.CreateVolumeRequest{Name: "data", TenantId: tenant.TenantId}); err == nil {
+		t.Errorf("Duplicate volume was created")
+	}
+	if uuid, err := client.ResolveVolumeNameToUUID("data", tenant.TenantId); err != nil || uuid != created.VolumeUuid {
+		t.Errorf("Unexpected volume: %s, %v", uuid, err)
+	}
+	if _, err := client.ResolveVolumeNameToUUID("missing", tenant.TenantId); !errors.Is(err, quobyte.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	if err := client.SetVolumeQuota(created.VolumeUuid, 1<<30); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	quotas, err := client.GetQuota(&quobyte.GetQuotaRequest{
+		OnlyEntity: []*quobyte.ConsumingEntity{{Type: quobyte.ConsumingEntity_Type_VOLUME, Identifier: created.VolumeUuid}},
+	})
+	if err != nil || len(quotas.Quotas) != 1 || quotas.Quotas[0].Limits[0].Value != 1<<30 {
+		t.Errorf("Unexpected quotas: %+v, %v", quotas, err)
+	}
+
+	if _, err := client.SetLabels(&quobyte.SetLabelsRequest{Label: []*quobyte.Label{
+		{EntityType: quobyte.Label_EntityType_VOLUME, EntityId: created.VolumeUuid, Name: "owner", Value: "alice"},
+	}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	labels, err := client.GetLabels(&quobyte.GetLabelsRequest{FilterEntityId: created.VolumeUuid})
+	if err != nil || len(labels.Label) != 1 || labels.Label[0].Value != "alice" {
+		t.Errorf("Unexpected labels: %+v, %v", labels, err)
+	}
+
+	if _, err := client.CreateSnapshot(&quobyte.CreateSnapshotRequest{VolumeUuid: created.VolumeUuid, Name: "daily", Pinned: true}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	snapshots, err := client.ListSnapshots(&quobyte.ListSnapshotsRequest{VolumeUuid: created.VolumeUuid})
+	if err != nil || len(snapshots.Snapshot) != 1 || !snapshots.Snapshot[0].Pinned || snapshots.Snapshot[0].Version != 1 {
+		t.Errorf("Unexpected snapshots: %+v, %v", snapshots, err)
+	}
+
+	if err := client.EraseVolumeByResolvingNamesToUUID("data", "team", false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	volumes, err := client.GetVolumeList(&quobyte.GetVolumeListRequest{VolumeUuid: []string{created.VolumeUuid}})
+	if err != nil || len(volumes.Volume) != 1 || !volumes.Volume[0].ScheduledForDeletion {
+		t.Errorf("Expected volume scheduled for deletion: %+v, %v", volumes, err)
+	}
+
+	if _, err := client.DeleteVolume(&quobyte.DeleteVolumeRequest{VolumeUuid: created.VolumeUuid}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if labels, err := client.GetLabels(&quobyte.GetLabelsRequest{}); err != nil || len(labels.Label) != 0 {
+		t.Errorf("Labels of deleted volume remained: %+v, %v", labels, err)
+	}
+	if _, err := client.DeleteTenant(&quobyte.DeleteTenantRequest{TenantId: tenant.TenantId}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestTasksAndDevices(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+	server.AddDevice(&quobyte.Device{DeviceId: 1, DeviceStatus: quobyte.Device_Status_ONLINE})
+	client := server.Client()
+
+	task, err := client.CreateTask(&quobyte.CreateTaskRequest{TaskType: quobyte.TaskType_SCRUB})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.CancelTask(&quobyte.CancelTaskRequest{TaskId: []string{task.TaskId}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	tasks, err := client.GetTaskList(&quobyte.GetTaskListRequest{TaskId: []string{task.TaskId}})
+	if err != nil || len(tasks.Tasks) != 1 || tasks.Tasks[0].State != quobyte.TaskState_CANCELED {
+		t.Errorf("Unexpected tasks: %+v, %v", tasks, err)
+	}
+
+	if _, err := client.UpdateDevice(&quobyte.UpdateDeviceRequest{
+		DeviceId:         1,
+		UpdateDeviceTags: true,
+		DeviceTags:       []string{"ssd"},
+		SetDeviceStatus:  quobyte.Device_Status_DECOMMISSIONED,
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	devices, err := client.GetDeviceList(&quobyte.GetDeviceListRequest{DeviceId: []int64{1}})
+	if err != nil || len(devices.DeviceList.Devices) != 1 {
+		t.Fatalf("Unexpected devices: %+v, %v", devices, err)
+	}
+	if device := devices.DeviceList.Devices[0]; device.DeviceStatus != quobyte.Device_Status_DECOMMISSIONED ||
+		len(device.DeviceTags) != 1 || device.DeviceTags[0] != "ssd" {
+		t.Errorf("Unexpected device: %+v", device)
+	}
+	if _, err := client.UpdateDevice(&quobyte.UpdateDeviceRequest{DeviceId: 2}); !errors.Is(err, quobyte.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+	client := server.Client()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.WhoAmI(&quobyte.WhoAmIRequest{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if logins := server.Logins(); logins != 1 {
+		t.Errorf("Expected 1 login, got %d", logins)
+	}
+
+	// the client logs in again when its session is rejected
+	server.SetSessionLifetime(time.Nanosecond)
+	server.ExpireSessions()
+	for i := 0; i < 2; i++ {
+		if _, err := client.WhoAmI(&quobyte.WhoAmIRequest{}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if logins := server.Logins(); logins != 3 {
+		t.Errorf("Expected 3 logins, got %d", logins)
+	}
+
+	wrong, err := quobyte.NewClient(server.URL, quobyte.WithCredentials("admin", "wrong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wrong.WhoAmI(&quobyte.WhoAmIRequest{}); !errors.Is(err, quobyte.ErrAuthentication) {
+		t.Errorf("Expected ErrAuthentication, got %v", err)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+	client := server.Client()
+	if _, err := client.SetTenant(&quobyte.SetTenantRequest{Tenant: quobyte.TenantDomainConfiguration{Name: "team"}}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	batch := client.NewBatch()
+	created := batch.Add("createVolume", &quobyte.CreateVolumeRequest{Name: "a", TenantId: "team"}, &quobyte.CreateVolumeResponse{})
+	unknown := batch.Add("noSuchMethod", &quobyte.WhoAmIRequest{}, &quobyte.WhoAmIResponse{})
+	if err := batch.Send(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if created.Err != nil || created.Response.(*quobyte.CreateVolumeResponse).VolumeUuid == "" {
+		t.Errorf("Unexpected result: %+v, %v", created.Response, created.Err)
+	}
+	if !errors.Is(unknown.Err, quobyte.ErrMethodNotFound) {
+		t.Errorf("Expected ErrMethodNotFound, got %v", unknown.Err)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+	server.SetVersion("3.14.2")
+	client := server.Client()
+	info, err := client.ServerInfo()
+	if err != nil || info.Version != (quobyte.Version{Major: 3, Minor: 14, Patch: 2}) {
+		t.Errorf("Unexpected server info %+v, %v", info, err)
+	}
+	if _, err := client.GetConfiguration(&quobyte.GetConfigurationRequest{}); !errors.Is(err, quobyte.ErrUnsupportedByServer) {
+		t.Errorf("Expected ErrUnsupportedByServer, got %v", err)
+	}
+	if client.Supports(quobyte.MethodGetConfiguration) {
+		t.Error("getConfiguration is not implemented by Server")
+	}
+}
+
+func TestUpdateDeviceDraining(t *testing.T) {
+	server := NewServer("admin", "secret")
+	defer server.Close()
+	server.AddDevice(&quobyte.Device{DeviceId: 1})
+	fake := NewFake()
+	fake.AddDevice(&quobyte.Device{DeviceId: 1})
+	for _, client := range []quobyte.DeviceAPI{server.Client(), fake} {
+		if _, err := client.UpdateDevice(&quobyte.UpdateDeviceRequest{DeviceId: 1, Draining: true}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		// draining is only stopped if false is sent explicitly
+		for _, explicit := range []bool{false, true} {
+			request := &quobyte.UpdateDeviceRequest{DeviceId: 1}
+			if explicit {
+				request.SetExplicit("draining")
+			}
+			if _, err := client.UpdateDevice(request); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			devices, err := client.GetDeviceList(&quobyte.GetDeviceListRequest{DeviceId: []int64{1}})
+			if err != nil || len(devices.DeviceList.Devices) != 1 {
+				t.Fatalf("Unexpected devices: %+v, %v", devices, err)
+			}
+			if draining := devices.DeviceList.Devices[0].Draining; draining == explicit {
+				t.Errorf("%T: unexpected draining %v after explicit %v", client, draining, explicit)
+			}
+		}
+	}
+}